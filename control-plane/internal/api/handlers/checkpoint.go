@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/conduix/conduix/control-plane/internal/api/middleware"
+	"github.com/conduix/conduix/control-plane/pkg/models"
+	"github.com/conduix/conduix/shared/types"
+)
+
+// CheckpointOffsetsResponse 소스별 오프셋 조회 응답
+type CheckpointOffsetsResponse struct {
+	PipelineID string                `json:"pipeline_id"`
+	Version    int64                 `json:"version"`
+	Timestamp  time.Time             `json:"timestamp"`
+	Sources    []types.SourceOffsets `json:"sources"`
+}
+
+// CheckpointRewindResponse 되감기 요청 응답
+type CheckpointRewindResponse struct {
+	Dispatched bool                     `json:"dispatched"` // 에이전트로 명령 전송 여부
+	AgentID    string                   `json:"agent_id,omitempty"`
+	Target     *types.Checkpoint        `json:"target"`
+	Restore    *types.CheckpointRestore `json:"restore,omitempty"` // 직접 적용한 경우 결과
+}
+
+// ListCheckpoints 파이프라인 체크포인트 이력 조회 (최신 순)
+func (h *PipelineHandler) ListCheckpoints(c *gin.Context) {
+	id := c.Param("id")
+
+	if !h.checkpointStoreAvailable(c) {
+		return
+	}
+
+	history, err := h.redisService.ListPipelineCheckpoints(id)
+	if err != nil {
+		history = nil
+	}
+	// 이력이 비어 있어도 현재 체크포인트는 노출
+	if len(history) == 0 {
+		if current, err := h.redisService.GetPipelineCheckpoint(id); err == nil {
+			history = []types.Checkpoint{*current}
+		}
+	}
+
+	checkpoints := make([]types.Checkpoint, 0, len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		checkpoints = append(checkpoints, history[i])
+	}
+
+	c.JSON(http.StatusOK, types.APIResponse[[]types.Checkpoint]{
+		Success: true,
+		Data:    checkpoints,
+	})
+}
+
+// GetCheckpointOffsets 현재 체크포인트의 소스별 오프셋 조회
+func (h *PipelineHandler) GetCheckpointOffsets(c *gin.Context) {
+	id := c.Param("id")
+
+	if !h.checkpointStoreAvailable(c) {
+		return
+	}
+
+	checkpoint, err := h.redisService.GetPipelineCheckpoint(id)
+	if err != nil {
+		middleware.ErrorResponseWithCode(c, http.StatusNotFound, types.ErrCodeNotFound, "Checkpoint not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, types.APIResponse[CheckpointOffsetsResponse]{
+		Success: true,
+		Data: CheckpointOffsetsResponse{
+			PipelineID: id,
+			Version:    checkpoint.Version,
+			Timestamp:  checkpoint.Timestamp,
+			Sources:    checkpoint.SourceOffsets(),
+		},
+	})
+}
+
+// RewindCheckpoint 체크포인트 되감기
+// 실행 중인 에이전트가 있으면 명령을 전송하고, 없으면 체크포인트를 직접 교체
+func (h *PipelineHandler) RewindCheckpoint(c *gin.Context) {
+	id := c.Param("id")
+
+	var pipeline models.Pipeline
+	if err := h.db.First(&pipeline, "id = ?", id).Error; err != nil {
+		middleware.ErrorResponseWithCode(c, http.StatusNotFound, types.ErrCodeNotFound, "Pipeline not found")
+		return
+	}
+
+	var req types.CheckpointRewindRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.ErrorResponseWithCode(c, http.StatusBadRequest, types.ErrCodeInvalidJSON, err.Error())
+		return
+	}
+
+	if !h.checkpointStoreAvailable(c) {
+		return
+	}
+
+	current, err := h.redisService.GetPipelineCheckpoint(id)
+	if err != nil {
+		current = nil
+	}
+	history, _ := h.redisService.ListPipelineCheckpoints(id)
+
+	target, err := types.ResolveRewind(id, current, history, &req)
+	if err != nil {
+		middleware.ErrorResponseWithCode(c, http.StatusBadRequest, types.ErrCodeValidationFailed, err.Error())
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	requestedBy, _ := userID.(string)
+
+	// 실행 중인 에이전트 확인
+	var run models.PipelineRun
+	if err := h.db.Where("pipeline_id = ?", id).Order("created_at DESC").First(&run).Error; err == nil &&
+		run.AgentID != "" && run.Status == string(types.PipelineStatusRunning) {
+		cmd := types.CheckpointRewindCommand{
+			PipelineID:  id,
+			Request:     req,
+			Target:      target,
+			RequestedBy: requestedBy,
+			RequestedAt: time.Now(),
+		}
+		if err := h.redisService.SendCommandToAgent(run.AgentID, types.CommandRewindCheckpoint, id, cmd); err != nil {
+			middleware.ErrorResponseWithCode(c, http.StatusServiceUnavailable, types.ErrCodeExternalService,
+				fmt.Sprintf("failed to send rewind command: %v", err))
+			return
+		}
+
+		c.JSON(http.StatusAccepted, types.APIResponse[CheckpointRewindResponse]{
+			Success: true,
+			Data: CheckpointRewindResponse{
+				Dispatched: true,
+				AgentID:    run.AgentID,
+				Target:     target,
+			},
+		})
+		return
+	}
+
+	// 실행 중이 아니면 다음 시작 시 적용되도록 직접 저장
+	restore := &types.CheckpointRestore{
+		PipelineID:    id,
+		RestoredFrom:  target.Timestamp,
+		RestoredAt:    time.Now(),
+		SkippedEvents: types.SkippedEvents(current, target),
+		Success:       true,
+	}
+	if err := h.redisService.SetPipelineCheckpoint(id, target); err != nil {
+		restore.Success = false
+		restore.Error = err.Error()
+	}
+	_ = h.redisService.SetCheckpointRestore(restore)
+
+	if !restore.Success {
+		middleware.ErrorResponseWithCode(c, http.StatusInternalServerError, types.ErrCodeExternalService, restore.Error)
+		return
+	}
+
+	c.JSON(http.StatusOK, types.APIResponse[CheckpointRewindResponse]{
+		Success: true,
+		Data: CheckpointRewindResponse{
+			Target:  target,
+			Restore: restore,
+		},
+	})
+}
+
+// GetCheckpointRestore 마지막 되감기 결과 조회
+func (h *PipelineHandler) GetCheckpointRestore(c *gin.Context) {
+	id := c.Param("id")
+
+	if !h.checkpointStoreAvailable(c) {
+		return
+	}
+
+	restore, err := h.redisService.GetCheckpointRestore(id)
+	if err != nil {
+		middleware.ErrorResponseWithCode(c, http.StatusNotFound, types.ErrCodeNotFound, "Checkpoint restore not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, types.APIResponse[types.CheckpointRestore]{
+		Success: true,
+		Data:    *restore,
+	})
+}
+
+// checkpointStoreAvailable 체크포인트 저장소(Redis) 사용 가능 여부 확인
+func (h *PipelineHandler) checkpointStoreAvailable(c *gin.Context) bool {
+	if h.redisService == nil || !h.redisService.IsHealthy() {
+		middleware.ErrorResponseWithCode(c, http.StatusServiceUnavailable, types.ErrCodeExternalService, "Checkpoint storage unavailable")
+		return false
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"os"
@@ -13,6 +14,9 @@ import (
 	"github.com/conduix/conduix/control-plane/internal/api/middleware"
 	"github.com/conduix/conduix/control-plane/pkg/database"
 	"github.com/conduix/conduix/control-plane/pkg/models"
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
 	"github.com/conduix/conduix/shared/types"
 )
 
@@ -140,6 +144,7 @@ type UpdateDataTypeRequest struct {
 	IDFields       []string               `json:"id_fields,omitempty"`
 	Schema         *types.DataTypeSchema  `json:"schema,omitempty"`
 	Storage        *types.DataTypeStorage `json:"storage,omitempty"`
+	Force          bool                   `json:"force,omitempty"` // 호환되지 않는 스키마 변경도 적용
 }
 
 // PreworkRequest 사전작업 요청
@@ -185,6 +190,11 @@ func (h *DataTypeHandler) CreateDataType(c *gin.Context) {
 		return
 	}
 
+	if err := validateDataTypeSchema(req.Name, req.Schema); err != nil {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeValidationFailed, "잘못된 스키마: "+err.Error(), "Invalid schema: "+err.Error())
+		return
+	}
+
 	// 프로젝트 존재 여부 확인 (ID 또는 Alias)
 	var project models.Project
 	if err := h.db.Where("id = ? OR alias = ?", req.ProjectID, req.ProjectID).First(&project).Error; err != nil {
@@ -238,12 +248,11 @@ func (h *DataTypeHandler) CreateDataType(c *gin.Context) {
 		}
 	}
 
-	// 사용자 ID 추출
-	userID := ""
-	if user, exists := c.Get("user"); exists {
-		if u, ok := user.(*models.User); ok {
-			userID = u.ID
-		}
+	userID := currentUserID(c)
+
+	schemaVersion := 0
+	if schemaJSON != "" {
+		schemaVersion = 1
 	}
 
 	dataType := models.DataType{
@@ -257,6 +266,7 @@ func (h *DataTypeHandler) CreateDataType(c *gin.Context) {
 		DeleteStrategy: deleteStrategyJSON,
 		IDFields:       idFieldsJSON,
 		Schema:         schemaJSON,
+		SchemaVersion:  schemaVersion,
 		Storage:        storageJSON,
 		CreatedBy:      userID,
 		CreatedAt:      time.Now(),
@@ -272,6 +282,14 @@ func (h *DataTypeHandler) CreateDataType(c *gin.Context) {
 		return
 	}
 
+	if schemaJSON != "" {
+		if err := tx.Create(newSchemaVersion(dataType.ID, schemaVersion, schemaJSON, false, userID)).Error; err != nil {
+			tx.Rollback()
+			errorResponse(c, http.StatusInternalServerError, types.ErrCodeDatabaseError, "스키마 버전 저장 실패", "Failed to save schema version")
+			return
+		}
+	}
+
 	// 사전작업 생성
 	for i, pw := range req.Preworks {
 		configJSON, _ := json.Marshal(pw.Config)
@@ -337,6 +355,11 @@ func (h *DataTypeHandler) UpdateDataType(c *gin.Context) {
 		return
 	}
 
+	if err := validateDataTypeSchema(dataType.Name, req.Schema); err != nil {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeValidationFailed, "잘못된 스키마: "+err.Error(), "Invalid schema: "+err.Error())
+		return
+	}
+
 	// 부모 변경 시 depth 제한 검사
 	if req.ParentID != nil && *req.ParentID != "" {
 		maxDepth := getMaxDataTypeDepth()
@@ -379,9 +402,18 @@ func (h *DataTypeHandler) UpdateDataType(c *gin.Context) {
 		}
 	}
 
+	// 스키마가 바뀌면 호환성 검사 후 버전 증가
+	var schemaVersion *models.DataTypeSchemaVersion
 	if req.Schema != nil {
-		if b, err := json.Marshal(req.Schema); err == nil {
+		if b, err := json.Marshal(req.Schema); err == nil && string(b) != dataType.Schema {
+			issues := schemaChangeIssues(dataType.Name, dataType.Schema, req.Schema)
+			if len(issues) > 0 && !req.Force {
+				incompatibleSchemaResponse(c, issues)
+				return
+			}
 			updates["schema"] = string(b)
+			updates["schema_version"] = dataType.SchemaVersion + 1
+			schemaVersion = newSchemaVersion(dataType.ID, dataType.SchemaVersion+1, string(b), len(issues) > 0, currentUserID(c))
 		}
 	}
 
@@ -391,11 +423,24 @@ func (h *DataTypeHandler) UpdateDataType(c *gin.Context) {
 		}
 	}
 
-	if err := h.db.Model(&dataType).Updates(updates).Error; err != nil {
+	tx := h.db.Begin()
+
+	if err := tx.Model(&dataType).Updates(updates).Error; err != nil {
+		tx.Rollback()
 		errorResponse(c, http.StatusInternalServerError, types.ErrCodeDatabaseError, "데이터 유형 수정 실패", "Failed to update data type")
 		return
 	}
 
+	if schemaVersion != nil {
+		if err := tx.Create(schemaVersion).Error; err != nil {
+			tx.Rollback()
+			errorResponse(c, http.StatusInternalServerError, types.ErrCodeDatabaseError, "스키마 버전 저장 실패", "Failed to save schema version")
+			return
+		}
+	}
+
+	tx.Commit()
+
 	// 결과 조회
 	h.db.Preload("Preworks").Preload("Parent").First(&dataType, "id = ?", id)
 
@@ -405,6 +450,319 @@ func (h *DataTypeHandler) UpdateDataType(c *gin.Context) {
 	})
 }
 
+// validateDataTypeSchema 스키마 정의를 변환해 보고 오류 반환
+// 아직 추론되지 않은 infer 스키마는 허용
+func validateDataTypeSchema(name string, dts *types.DataTypeSchema) error {
+	if dts == nil {
+		return nil
+	}
+	if _, err := schema.ParseCompatibilityMode(dts.Compatibility); err != nil {
+		return err
+	}
+	if dts.Type == schema.DefinitionInfer && dts.Definition == "" {
+		return nil
+	}
+	_, err := schema.NewDataSchemaFromDataType(name, dts)
+	return err
+}
+
+// schemaChangeIssues 저장된 스키마(current)에서 next로의 변경이 호환성 규칙을 위반하는 내용
+// 규칙은 저장된 스키마의 compatibility를 따르며, 비교할 수 있는 이전 스키마가 없으면 검사하지 않음
+func schemaChangeIssues(name, current string, next *types.DataTypeSchema) []schema.CompatibilityIssue {
+	if current == "" {
+		return nil
+	}
+	var dts types.DataTypeSchema
+	if err := json.Unmarshal([]byte(current), &dts); err != nil {
+		return nil
+	}
+	mode, err := schema.ParseCompatibilityMode(dts.Compatibility)
+	if err != nil {
+		mode = schema.CompatibilityBackward
+	}
+	if mode == schema.CompatibilityNone || (dts.Type == schema.DefinitionInfer && dts.Definition == "") {
+		return nil
+	}
+
+	oldSchema, err := schema.NewDataSchemaFromDataType(name, &dts)
+	if err != nil {
+		return nil
+	}
+	// 정의가 없는 infer 스키마로 되돌리는 경우는 비교 대상이 없음
+	nextSchema, err := schema.NewDataSchemaFromDataType(name, next)
+	if err != nil {
+		return nil
+	}
+	return schema.CheckCompatibility(oldSchema, nextSchema, mode)
+}
+
+// incompatibleSchemaResponse 호환되지 않는 스키마 변경 응답 (details: "방향:필드" → 사유)
+func incompatibleSchemaResponse(c *gin.Context, issues []schema.CompatibilityIssue) {
+	details := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		key := string(issue.Direction) + ":" + issue.Field
+		if prev, ok := details[key]; ok {
+			details[key] = prev + "; " + issue.Message
+		} else {
+			details[key] = issue.Message
+		}
+	}
+	middleware.ErrorResponseWithDetails(c, http.StatusConflict, types.ErrCodeConflict,
+		getErrorMessage(c, "호환되지 않는 스키마 변경입니다 (force로 강제 적용 가능)", "Incompatible schema change (set force to apply anyway)"),
+		details)
+}
+
+// newSchemaVersion 스키마 버전 이력 생성
+func newSchemaVersion(dataTypeID string, version int, schemaJSON string, forced bool, userID string) *models.DataTypeSchemaVersion {
+	return &models.DataTypeSchemaVersion{
+		ID:         uuid.New().String(),
+		DataTypeID: dataTypeID,
+		Version:    version,
+		Schema:     schemaJSON,
+		Forced:     forced,
+		CreatedBy:  userID,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// currentUserID 인증된 사용자 ID (없으면 빈 문자열)
+func currentUserID(c *gin.Context) string {
+	if user, exists := c.Get("user"); exists {
+		if u, ok := user.(*models.User); ok {
+			return u.ID
+		}
+	}
+	return ""
+}
+
+// ListSchemaVersions 데이터 유형 스키마 버전 이력 조회 (최신순)
+// @Summary 데이터 유형 스키마 버전 목록
+// @Tags DataTypes
+// @Produce json
+// @Param id path string true "데이터 유형 ID"
+// @Success 200 {object} types.APIResponse{data=[]models.DataTypeSchemaVersion}
+// @Router /data-types/{id}/schema-versions [get]
+func (h *DataTypeHandler) ListSchemaVersions(c *gin.Context) {
+	id := c.Param("id")
+
+	var dataType models.DataType
+	if err := h.db.First(&dataType, "id = ?", id).Error; err != nil {
+		errorResponse(c, http.StatusNotFound, types.ErrCodeNotFound, "데이터 유형을 찾을 수 없습니다", "Data type not found")
+		return
+	}
+
+	var versions []models.DataTypeSchemaVersion
+	if err := h.db.Where("data_type_id = ?", id).Order("version DESC").Find(&versions).Error; err != nil {
+		errorResponse(c, http.StatusInternalServerError, types.ErrCodeDatabaseError, "스키마 버전 조회 실패", "Failed to list schema versions")
+		return
+	}
+
+	c.JSON(http.StatusOK, types.APIResponse[[]models.DataTypeSchemaVersion]{
+		Success: true,
+		Data:    versions,
+	})
+}
+
+// GetDataTypeJSONSchema 데이터 유형 스키마를 JSON Schema로 조회 (UI 폼 생성용)
+// @Summary 데이터 유형 JSON Schema 조회
+// @Tags DataTypes
+// @Produce json
+// @Param id path string true "데이터 유형 ID"
+// @Success 200 {object} types.APIResponse{data=object}
+// @Router /data-types/{id}/json-schema [get]
+func (h *DataTypeHandler) GetDataTypeJSONSchema(c *gin.Context) {
+	id := c.Param("id")
+
+	var dataType models.DataType
+	if err := h.db.First(&dataType, "id = ?", id).Error; err != nil {
+		errorResponse(c, http.StatusNotFound, types.ErrCodeNotFound, "데이터 유형을 찾을 수 없습니다", "Data type not found")
+		return
+	}
+
+	if dataType.Schema == "" {
+		errorResponse(c, http.StatusNotFound, types.ErrCodeNotFound, "스키마가 정의되지 않았습니다", "Schema is not defined")
+		return
+	}
+	var dts types.DataTypeSchema
+	if err := json.Unmarshal([]byte(dataType.Schema), &dts); err != nil {
+		errorResponse(c, http.StatusInternalServerError, types.ErrCodeInternalError, "저장된 스키마를 읽을 수 없습니다", "Failed to parse stored schema")
+		return
+	}
+
+	dataSchema, err := schema.NewDataSchemaFromDataType(dataType.Name, &dts)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeValidationFailed, "스키마 변환 실패: "+err.Error(), "Failed to convert schema: "+err.Error())
+		return
+	}
+	if dataSchema.Description == "" {
+		dataSchema.Description = dataType.DisplayName
+	}
+
+	jsonSchema, err := dataSchema.ToJSONSchema()
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, types.ErrCodeInternalError, "JSON Schema 생성 실패", "Failed to export JSON Schema")
+		return
+	}
+
+	c.JSON(http.StatusOK, types.APIResponse[json.RawMessage]{
+		Success: true,
+		Data:    jsonSchema,
+	})
+}
+
+// InferSchemaRequest 스키마 추론 요청
+type InferSchemaRequest struct {
+	PipelineID     string `json:"pipeline_id" binding:"required"` // 샘플을 읽을 파이프라인 (v2 설정의 source 사용)
+	SampleSize     int    `json:"sample_size,omitempty"`          // 읽을 레코드 수 (default: 100, 최대 10000)
+	TimeoutSeconds int    `json:"timeout_seconds,omitempty"`      // 미리보기 제한 시간 (default: 30, 최대 300)
+	MaxEnumValues  int    `json:"max_enum_values,omitempty"`      // enum 후보 최대 고유 값 수 (default: 10, 음수: 추론 안 함)
+	Save           bool   `json:"save,omitempty"`                 // true면 추론 결과를 데이터 유형 스키마로 저장
+	Force          bool   `json:"force,omitempty"`                // 저장 시 호환되지 않는 스키마 변경도 적용
+}
+
+// InferSchemaResponse 스키마 추론 결과
+type InferSchemaResponse struct {
+	SampleCount int                `json:"sample_count"`
+	Schema      *schema.DataSchema `json:"schema"`
+	JSONSchema  json.RawMessage    `json:"json_schema"`
+	Saved       bool               `json:"saved"`
+}
+
+// previewSourceTypes control-plane에서 직접 샘플을 읽을 수 있는 소스 타입
+// 원격 저장소만 허용한다 (file, sqlite처럼 control-plane 파일 시스템을 읽는 소스는 제외)
+var previewSourceTypes = map[string]bool{
+	"kafka":         true,
+	"sql":           true,
+	"sql_event":     true,
+	"http":          true,
+	"rest_api":      true,
+	"elasticsearch": true,
+	"mongodb":       true,
+	"s3":            true,
+	"generate":      true,
+}
+
+// previewAllowed control-plane에서 미리보기를 실행해도 되는 소스인지 확인
+func previewAllowed(src config.SourceV2) bool {
+	return previewSourceTypes[src.Type] && src.Driver != "sqlite"
+}
+
+// InferDataTypeSchema 파이프라인 소스의 샘플 레코드로 스키마 추론
+// @Summary 데이터 유형 스키마 추론
+// @Tags DataTypes
+// @Accept json
+// @Produce json
+// @Param id path string true "데이터 유형 ID"
+// @Param body body InferSchemaRequest true "추론 옵션"
+// @Success 200 {object} types.APIResponse{data=InferSchemaResponse}
+// @Router /data-types/{id}/infer-schema [post]
+func (h *DataTypeHandler) InferDataTypeSchema(c *gin.Context) {
+	id := c.Param("id")
+
+	var dataType models.DataType
+	if err := h.db.First(&dataType, "id = ?", id).Error; err != nil {
+		errorResponse(c, http.StatusNotFound, types.ErrCodeNotFound, "데이터 유형을 찾을 수 없습니다", "Data type not found")
+		return
+	}
+
+	var req InferSchemaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeInvalidJSON, "잘못된 요청: "+err.Error(), "Invalid request: "+err.Error())
+		return
+	}
+	if req.SampleSize <= 0 {
+		req.SampleSize = 100
+	} else if req.SampleSize > 10000 {
+		req.SampleSize = 10000
+	}
+	if req.TimeoutSeconds <= 0 {
+		req.TimeoutSeconds = 30
+	} else if req.TimeoutSeconds > 300 {
+		req.TimeoutSeconds = 300
+	}
+
+	var pipeline models.Pipeline
+	if err := h.db.First(&pipeline, "id = ?", req.PipelineID).Error; err != nil {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeNotFound, "파이프라인을 찾을 수 없습니다", "Pipeline not found")
+		return
+	}
+	pipelineConfig, err := config.ParseConfigV2([]byte(pipeline.ConfigYAML))
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeValidationFailed, "파이프라인 설정 오류: "+err.Error(), "Invalid pipeline config: "+err.Error())
+		return
+	}
+	if !previewAllowed(pipelineConfig.Source) {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeValidationFailed, "control-plane에서 미리보기를 지원하지 않는 소스입니다: "+pipelineConfig.Source.Type, "Preview is not supported on the control plane for source: "+pipelineConfig.Source.Type)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(req.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	records, err := source.Preview(ctx, pipelineConfig.Source, req.SampleSize)
+	if err != nil {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeExternalService, "샘플 읽기 실패: "+err.Error(), "Failed to read samples: "+err.Error())
+		return
+	}
+	if len(records) == 0 {
+		errorResponse(c, http.StatusBadRequest, types.ErrCodeValidationFailed, "샘플 레코드가 없습니다", "No sample records")
+		return
+	}
+
+	inferrer := schema.NewInferrer(schema.InferOptions{MaxEnumValues: req.MaxEnumValues})
+	for _, r := range records {
+		inferrer.Add(r.Data)
+	}
+	inferred := inferrer.Schema(dataType.Name)
+	inferred.Description = dataType.DisplayName
+
+	jsonSchema, err := inferred.ToJSONSchema()
+	if err != nil {
+		errorResponse(c, http.StatusInternalServerError, types.ErrCodeInternalError, "JSON Schema 생성 실패", "Failed to export JSON Schema")
+		return
+	}
+
+	if req.Save {
+		// 추론 결과는 JSON Schema 정의로 저장 (type은 infer 유지, 호환성 규칙은 기존 값 유지)
+		next := types.DataTypeSchema{Type: schema.DefinitionInfer, Definition: string(jsonSchema)}
+		var current types.DataTypeSchema
+		if dataType.Schema != "" && json.Unmarshal([]byte(dataType.Schema), &current) == nil {
+			next.Compatibility = current.Compatibility
+		}
+
+		issues := schemaChangeIssues(dataType.Name, dataType.Schema, &next)
+		if len(issues) > 0 && !req.Force {
+			incompatibleSchemaResponse(c, issues)
+			return
+		}
+
+		b, _ := json.Marshal(next)
+		version := dataType.SchemaVersion + 1
+		tx := h.db.Begin()
+		if err := tx.Model(&dataType).Updates(map[string]any{"schema": string(b), "schema_version": version, "updated_at": time.Now()}).Error; err != nil {
+			tx.Rollback()
+			errorResponse(c, http.StatusInternalServerError, types.ErrCodeDatabaseError, "스키마 저장 실패", "Failed to save schema")
+			return
+		}
+		if err := tx.Create(newSchemaVersion(dataType.ID, version, string(b), len(issues) > 0, currentUserID(c))).Error; err != nil {
+			tx.Rollback()
+			errorResponse(c, http.StatusInternalServerError, types.ErrCodeDatabaseError, "스키마 버전 저장 실패", "Failed to save schema version")
+			return
+		}
+		tx.Commit()
+	}
+
+	c.JSON(http.StatusOK, types.APIResponse[InferSchemaResponse]{
+		Success: true,
+		Data: InferSchemaResponse{
+			SampleCount: len(records),
+			Schema:      inferred,
+			JSONSchema:  jsonSchema,
+			Saved:       req.Save,
+		},
+	})
+}
+
 // DeleteDataType 데이터 유형 삭제
 // @Summary 데이터 유형 삭제
 // @Tags DataTypes
@@ -432,6 +790,13 @@ func (h *DataTypeHandler) DeleteDataType(c *gin.Context) {
 		return
 	}
 
+	// 스키마 버전 이력 삭제
+	if err := tx.Where("data_type_id = ?", id).Delete(&models.DataTypeSchemaVersion{}).Error; err != nil {
+		tx.Rollback()
+		errorResponse(c, http.StatusInternalServerError, types.ErrCodeDatabaseError, "스키마 버전 삭제 실패", "Failed to delete schema versions")
+		return
+	}
+
 	// 데이터 유형 삭제 (hard delete - 이름 재사용 가능하도록)
 	if err := tx.Unscoped().Delete(&models.DataType{}, "id = ?", id).Error; err != nil {
 		tx.Rollback()
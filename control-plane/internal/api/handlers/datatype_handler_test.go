@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/shared/types"
+)
+
+func TestSchemaChangeIssues(t *testing.T) {
+	current := `{"type":"","fields":[{"name":"id","type":"string","required":true},{"name":"note","type":"string"}]}`
+	currentFull := `{"type":"","fields":[{"name":"id","type":"string","required":true},{"name":"note","type":"string"}],"compatibility":"full"}`
+
+	tests := []struct {
+		name    string
+		current string
+		next    types.DataTypeSchema
+		issues  int
+	}{
+		{"no previous schema", "", types.DataTypeSchema{Fields: []types.DataTypeField{{Name: "x", Type: "int", Required: true}}}, 0},
+		{"add optional field", current, types.DataTypeSchema{Fields: []types.DataTypeField{
+			{Name: "id", Type: "string", Required: true}, {Name: "note", Type: "string"}, {Name: "tag", Type: "string"},
+		}}, 0},
+		{"add required field", current, types.DataTypeSchema{Fields: []types.DataTypeField{
+			{Name: "id", Type: "string", Required: true}, {Name: "note", Type: "string"}, {Name: "tag", Type: "string", Required: true},
+		}}, 1},
+		{"remove required field under full", currentFull, types.DataTypeSchema{Fields: []types.DataTypeField{
+			{Name: "note", Type: "string"},
+		}}, 1},
+		{"change type", current, types.DataTypeSchema{Fields: []types.DataTypeField{
+			{Name: "id", Type: "int", Required: true}, {Name: "note", Type: "string"},
+		}}, 1},
+		{"none mode", `{"type":"","fields":[{"name":"id","type":"string"}],"compatibility":"none"}`, types.DataTypeSchema{Fields: []types.DataTypeField{
+			{Name: "id", Type: "int", Required: true},
+		}}, 0},
+		{"pending infer schema", `{"type":"infer"}`, types.DataTypeSchema{Fields: []types.DataTypeField{
+			{Name: "id", Type: "int", Required: true},
+		}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := schemaChangeIssues("order", tt.current, &tt.next)
+			if len(issues) != tt.issues {
+				t.Errorf("issues = %v, want %d", issues, tt.issues)
+			}
+		})
+	}
+}
+
+func TestValidateDataTypeSchemaCompatibility(t *testing.T) {
+	if err := validateDataTypeSchema("order", &types.DataTypeSchema{Type: "infer", Compatibility: "sideways"}); err == nil {
+		t.Error("expected error for unknown compatibility mode")
+	}
+	if err := validateDataTypeSchema("order", &types.DataTypeSchema{Type: "infer", Compatibility: "forward"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPreviewAllowed(t *testing.T) {
+	tests := []struct {
+		src  config.SourceV2
+		want bool
+	}{
+		{config.SourceV2{Type: "kafka"}, true},
+		{config.SourceV2{Type: "sql", Driver: "postgres"}, true},
+		{config.SourceV2{Type: "sql", Driver: "sqlite"}, false},
+		{config.SourceV2{Type: "sql_event", Driver: "sqlite"}, false},
+		{config.SourceV2{Type: "file"}, false},
+		{config.SourceV2{Type: "cdc"}, false},
+	}
+	for _, tt := range tests {
+		if got := previewAllowed(tt.src); got != tt.want {
+			t.Errorf("previewAllowed(%s/%s) = %v, want %v", tt.src.Type, tt.src.Driver, got, tt.want)
+		}
+	}
+}
@@ -100,6 +100,11 @@ func (s *Server) setupRoutes() {
 				pipelines.GET("/:id/graph", s.graphHandler.GetPipelineGraph)
 				pipelines.PUT("/:id/graph", middleware.RoleMiddleware(string(types.UserRoleAdmin), string(types.UserRoleOperator)), s.graphHandler.UpdatePipelineGraph)
 				pipelines.GET("/:id/actor-metrics", s.graphHandler.GetActorMetrics)
+				// 체크포인트
+				pipelines.GET("/:id/checkpoints", s.pipelineHandler.ListCheckpoints)
+				pipelines.GET("/:id/checkpoints/offsets", s.pipelineHandler.GetCheckpointOffsets)
+				pipelines.GET("/:id/checkpoints/restore", s.pipelineHandler.GetCheckpointRestore)
+				pipelines.POST("/:id/checkpoints/rewind", middleware.RoleMiddleware(string(types.UserRoleAdmin), string(types.UserRoleOperator)), s.pipelineHandler.RewindCheckpoint)
 			}
 
 			// 워크플로우
@@ -146,6 +151,9 @@ func (s *Server) setupRoutes() {
 				dataTypes.GET("/categories", s.dataTypeHandler.GetCategories)
 				dataTypes.POST("", middleware.RoleMiddleware(string(types.UserRoleAdmin), string(types.UserRoleOperator)), s.dataTypeHandler.CreateDataType)
 				dataTypes.GET("/:id", s.dataTypeHandler.GetDataType)
+				dataTypes.GET("/:id/json-schema", s.dataTypeHandler.GetDataTypeJSONSchema)
+				dataTypes.GET("/:id/schema-versions", s.dataTypeHandler.ListSchemaVersions)
+				dataTypes.POST("/:id/infer-schema", middleware.RoleMiddleware(string(types.UserRoleAdmin), string(types.UserRoleOperator)), s.dataTypeHandler.InferDataTypeSchema)
 				dataTypes.PUT("/:id", middleware.RoleMiddleware(string(types.UserRoleAdmin), string(types.UserRoleOperator)), s.dataTypeHandler.UpdateDataType)
 				dataTypes.DELETE("/:id", middleware.RoleMiddleware(string(types.UserRoleAdmin)), s.dataTypeHandler.DeleteDataType)
 				// 사전작업
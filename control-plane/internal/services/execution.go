@@ -36,6 +36,15 @@ type WorkflowExecution struct {
 
 	// 실시간용 오프셋 (Kafka, DB 등)
 	Offsets map[string]int64 `json:"offsets,omitempty"`
+
+	// 파이프라인별 소스 체크포인트 (HTTP 증분 상태, SQL high-water mark 등)
+	Checkpoints map[string]map[string]any `json:"checkpoints,omitempty"`
+}
+
+// savedOffsets 실행 메타데이터에 저장되는 오프셋과 체크포인트
+type savedOffsets struct {
+	Offsets     map[string]int64          `json:"offsets,omitempty"`
+	Checkpoints map[string]map[string]any `json:"checkpoints,omitempty"`
 }
 
 // NewExecutionService 새 서비스 생성
@@ -98,6 +107,7 @@ func (s *ExecutionService) StartExecution(ctx context.Context, workflowID, userI
 		StartedAt:   execution.StartedAt,
 		Cancel:      cancel,
 		Offsets:     make(map[string]int64),
+		Checkpoints: make(map[string]map[string]any),
 	}
 	s.runningWorkflows[workflowID] = workflowExec
 
@@ -127,7 +137,7 @@ func (s *ExecutionService) StopExecution(ctx context.Context, workflowID string)
 
 	// 실시간 파이프라인의 경우 오프셋 저장
 	if exec.Type == types.WorkflowTypeRealtime && len(exec.Offsets) > 0 {
-		s.saveOffsets(workflowID, exec.ExecutionID, exec.Offsets)
+		s.saveOffsets(workflowID, exec.ExecutionID, exec.Offsets, exec.Checkpoints)
 	}
 
 	// 실행 기록 업데이트
@@ -226,6 +236,7 @@ func (s *ExecutionService) runBatchExecution(ctx context.Context, exec *Workflow
 	if workflow.PipelinesConfig != "" {
 		_ = json.Unmarshal([]byte(workflow.PipelinesConfig), &pipelines)
 	}
+	s.resolveTargetDataTypes(pipelines)
 
 	// 파이프라인 계층 정렬 (부모 먼저 실행)
 	sortedPipelines := s.sortPipelinesByHierarchy(pipelines)
@@ -237,11 +248,15 @@ func (s *ExecutionService) runBatchExecution(ctx context.Context, exec *Workflow
 	// 부모 파이프라인 출력 저장 (자식 확장용)
 	parentOutputs := make(map[string][]map[string]any)
 
+	// 이전 실행에서 저장된 소스 체크포인트 로드 (증분 수집용)
+	exec.Offsets, exec.Checkpoints = s.loadOffsets(workflow.ID)
+
 	// 각 파이프라인 실행
 	for _, p := range sortedPipelines {
 		select {
 		case <-ctx.Done():
-			// 중지 요청
+			// 중지 요청 - 완료된 파이프라인의 체크포인트는 유지
+			s.saveOffsets(workflow.ID, exec.ExecutionID, exec.Offsets, exec.Checkpoints)
 			s.logger.Info("Batch execution canceled",
 				"workflow_id", workflow.ID,
 				"pipeline_id", p.ID)
@@ -263,7 +278,7 @@ func (s *ExecutionService) runBatchExecution(ctx context.Context, exec *Workflow
 					// 파라미터 바인딩 적용
 					expandedPipeline := s.applyParameterBindings(p, record, i)
 
-					result := s.executePipeline(ctx, &expandedPipeline, workflow)
+					result := s.runPipelineWithCheckpoint(ctx, exec, expandedPipeline, workflow)
 					result.PipelineName = fmt.Sprintf("%s[%d]", p.Name, i)
 					pipelineResults = append(pipelineResults, result)
 					totalRecords += result.RecordsProcessed
@@ -271,7 +286,7 @@ func (s *ExecutionService) runBatchExecution(ctx context.Context, exec *Workflow
 				}
 			} else {
 				// 일반 파이프라인 실행
-				result := s.executePipeline(ctx, &p, workflow)
+				result := s.runPipelineWithCheckpoint(ctx, exec, p, workflow)
 				pipelineResults = append(pipelineResults, result)
 				totalRecords += result.RecordsProcessed
 				failedRecords += result.RecordsFailed
@@ -286,6 +301,8 @@ func (s *ExecutionService) runBatchExecution(ctx context.Context, exec *Workflow
 	}
 
 	// 실행 완료
+	s.saveOffsets(workflow.ID, exec.ExecutionID, exec.Offsets, exec.Checkpoints)
+
 	now := time.Now()
 	resultsJSON, _ := json.Marshal(pipelineResults)
 
@@ -379,6 +396,71 @@ func (s *ExecutionService) getExpansionRecords(pipeline types.WorkflowPipeline,
 	return nil
 }
 
+// resolveTargetDataTypes 대상 DataType의 현재 스키마 버전과 삭제 전략을 파이프라인에 반영
+// 스키마 버전은 실행 결과에 남겨 어떤 스키마로 데이터를 만들었는지 추적하고,
+// 삭제 전략과 ID 필드는 싱크 설정에 값이 없을 때만 채워 파이프라인 설정이 우선하도록 함
+func (s *ExecutionService) resolveTargetDataTypes(pipelines []types.WorkflowPipeline) {
+	for i := range pipelines {
+		p := &pipelines[i]
+		if p.TargetDataTypeID == nil {
+			continue
+		}
+		var dataType models.DataType
+		if err := s.db.Select("schema_version", "delete_strategy", "id_fields").First(&dataType, "id = ?", *p.TargetDataTypeID).Error; err != nil {
+			s.logger.Warn("Failed to resolve data type", "data_type_id", *p.TargetDataTypeID, "error", err)
+			continue
+		}
+		p.SchemaVersion = dataType.SchemaVersion
+		if err := inheritDeleteStrategy(p, &dataType); err != nil {
+			s.logger.Warn("Failed to inherit delete strategy", "data_type_id", *p.TargetDataTypeID, "error", err)
+		}
+	}
+}
+
+// deleteStrategySinkTypes 삭제 전략을 적용하는 싱크 유형
+var deleteStrategySinkTypes = map[string]bool{
+	"sql":           true,
+	"elasticsearch": true,
+	"mongodb":       true,
+}
+
+// inheritDeleteStrategy DataType의 delete_strategy, id_fields를 싱크 설정에 복사
+// 싱크 설정 키는 파이프라인 출력 설정(id_fields, delete_strategy)과 같음
+func inheritDeleteStrategy(p *types.WorkflowPipeline, dataType *models.DataType) error {
+	var strategy map[string]any
+	if dataType.DeleteStrategy != "" {
+		if err := json.Unmarshal([]byte(dataType.DeleteStrategy), &strategy); err != nil {
+			return fmt.Errorf("invalid delete_strategy: %w", err)
+		}
+	}
+	var idFields []string
+	if dataType.IDFields != "" {
+		if err := json.Unmarshal([]byte(dataType.IDFields), &idFields); err != nil {
+			return fmt.Errorf("invalid id_fields: %w", err)
+		}
+	}
+	if strategy == nil && len(idFields) == 0 {
+		return nil
+	}
+
+	for i := range p.Sinks {
+		sink := &p.Sinks[i]
+		if !deleteStrategySinkTypes[sink.Type] {
+			continue
+		}
+		if sink.Config == nil {
+			sink.Config = make(map[string]any)
+		}
+		if _, ok := sink.Config["id_fields"]; !ok && len(idFields) > 0 {
+			sink.Config["id_fields"] = idFields
+		}
+		if _, ok := sink.Config["delete_strategy"]; !ok && strategy != nil {
+			sink.Config["delete_strategy"] = strategy
+		}
+	}
+	return nil
+}
+
 // queryDataTypeRecords DataType의 레코드 조회
 func (s *ExecutionService) queryDataTypeRecords(dataTypeID string) []map[string]any {
 	// DataType 조회
@@ -440,9 +522,10 @@ func (s *ExecutionService) runRealtimeExecution(ctx context.Context, exec *Workf
 	if workflow.PipelinesConfig != "" {
 		_ = json.Unmarshal([]byte(workflow.PipelinesConfig), &pipelines)
 	}
+	s.resolveTargetDataTypes(pipelines)
 
 	// 마지막 저장된 오프셋 로드
-	exec.Offsets = s.loadOffsets(workflow.ID)
+	exec.Offsets, exec.Checkpoints = s.loadOffsets(workflow.ID)
 
 	// 무한 폴링 루프
 	ticker := time.NewTicker(time.Second) // 1초마다 폴링 (설정 가능)
@@ -452,7 +535,7 @@ func (s *ExecutionService) runRealtimeExecution(ctx context.Context, exec *Workf
 		select {
 		case <-ctx.Done():
 			// 중지 요청 - 오프셋 저장
-			s.saveOffsets(workflow.ID, exec.ExecutionID, exec.Offsets)
+			s.saveOffsets(workflow.ID, exec.ExecutionID, exec.Offsets, exec.Checkpoints)
 			s.logger.Info("Realtime execution stopped, offsets saved",
 				"workflow_id", workflow.ID,
 				"offsets", exec.Offsets)
@@ -462,7 +545,7 @@ func (s *ExecutionService) runRealtimeExecution(ctx context.Context, exec *Workf
 			// 폴링 주기마다 실행
 			for _, p := range pipelines {
 				// 파이프라인 실행 (실제로는 에이전트에 위임)
-				result := s.executePipeline(ctx, &p, workflow)
+				result := s.runPipelineWithCheckpoint(ctx, exec, p, workflow)
 
 				// 오프셋 업데이트
 				if result.Offset > 0 {
@@ -476,6 +559,33 @@ func (s *ExecutionService) runRealtimeExecution(ctx context.Context, exec *Workf
 	}
 }
 
+// runPipelineWithCheckpoint 저장된 체크포인트를 소스 설정(checkpoint)에 넣어 실행하고
+// 완료된 경우 결과 체크포인트를 실행 상태에 반영
+func (s *ExecutionService) runPipelineWithCheckpoint(ctx context.Context, exec *WorkflowExecution, pipeline types.WorkflowPipeline, workflow *models.Workflow) types.PipelineExecutionResult {
+	if checkpoint, ok := exec.Checkpoints[pipeline.ID]; ok {
+		// 워크플로우 설정의 map을 변경하지 않도록 복사
+		sourceConfig := make(map[string]any, len(pipeline.Source.Config)+1)
+		for k, v := range pipeline.Source.Config {
+			sourceConfig[k] = v
+		}
+		sourceConfig["checkpoint"] = checkpoint
+		pipeline.Source.Config = sourceConfig
+	}
+
+	result := s.executePipeline(ctx, &pipeline, workflow)
+
+	// 실패한 실행의 체크포인트는 반영하지 않음 (다음 실행에서 같은 구간을 다시 읽음)
+	// 완료 후 빈 체크포인트는 소스가 진행 상태를 초기화한 것이므로 저장된 값도 제거
+	if result.Status == "completed" {
+		if len(result.Checkpoint) > 0 {
+			exec.Checkpoints[pipeline.ID] = result.Checkpoint
+		} else {
+			delete(exec.Checkpoints, pipeline.ID)
+		}
+	}
+	return result
+}
+
 // executePipeline 단일 파이프라인 실행
 func (s *ExecutionService) executePipeline(ctx context.Context, pipeline *types.WorkflowPipeline, workflow *models.Workflow) types.PipelineExecutionResult {
 	// TODO: 실제 실행은 에이전트에 위임
@@ -488,27 +598,36 @@ func (s *ExecutionService) executePipeline(ctx context.Context, pipeline *types.
 		RecordsFailed:    0,
 		StartedAt:        time.Now(),
 		CompletedAt:      time.Now(),
+		SchemaVersion:    pipeline.SchemaVersion,
 	}
 }
 
-// saveOffsets 오프셋 저장 (Redis 또는 DB)
-func (s *ExecutionService) saveOffsets(workflowID, executionID string, offsets map[string]int64) {
+// saveOffsets 오프셋과 소스 체크포인트 저장 (Redis 또는 DB)
+func (s *ExecutionService) saveOffsets(workflowID, executionID string, offsets map[string]int64, checkpoints map[string]map[string]any) {
 	if s.redis != nil {
 		key := fmt.Sprintf("workflow:%s:offsets", workflowID)
 		data, _ := json.Marshal(offsets)
 		_ = s.redis.Set(context.Background(), key, string(data), 0)
+
+		if checkpoints != nil {
+			key = fmt.Sprintf("workflow:%s:checkpoints", workflowID)
+			data, _ = json.Marshal(checkpoints)
+			_ = s.redis.Set(context.Background(), key, string(data), 0)
+		}
 	}
 
 	// DB에도 저장 (메타데이터로)
-	offsetsJSON, _ := json.Marshal(offsets)
+	metadataJSON, _ := json.Marshal(savedOffsets{Offsets: offsets, Checkpoints: checkpoints})
 	s.db.Model(&models.WorkflowExecution{}).
 		Where("id = ?", executionID).
-		Update("metadata", string(offsetsJSON))
+		Update("metadata", string(metadataJSON))
 }
 
-// loadOffsets 오프셋 로드
-func (s *ExecutionService) loadOffsets(workflowID string) map[string]int64 {
+// loadOffsets 오프셋과 소스 체크포인트 로드
+// Redis에 없으면 메타데이터가 저장된 마지막 실행 기록에서 복구
+func (s *ExecutionService) loadOffsets(workflowID string) (map[string]int64, map[string]map[string]any) {
 	offsets := make(map[string]int64)
+	checkpoints := make(map[string]map[string]any)
 
 	if s.redis != nil {
 		key := fmt.Sprintf("workflow:%s:offsets", workflowID)
@@ -516,9 +635,43 @@ func (s *ExecutionService) loadOffsets(workflowID string) map[string]int64 {
 		if err == nil && data != "" {
 			_ = json.Unmarshal([]byte(data), &offsets)
 		}
+
+		key = fmt.Sprintf("workflow:%s:checkpoints", workflowID)
+		data, err = s.redis.Get(context.Background(), key)
+		if err == nil && data != "" {
+			_ = json.Unmarshal([]byte(data), &checkpoints)
+			return offsets, checkpoints
+		}
+	}
+
+	if s.db == nil {
+		return offsets, checkpoints
+	}
+
+	var execution models.WorkflowExecution
+	err := s.db.Where("workflow_id = ? AND metadata IS NOT NULL AND metadata <> ''", workflowID).
+		Order("started_at DESC").
+		First(&execution).Error
+	if err != nil {
+		return offsets, checkpoints
+	}
+
+	var saved savedOffsets
+	if json.Unmarshal([]byte(execution.Metadata), &saved) == nil && (saved.Offsets != nil || saved.Checkpoints != nil) {
+		if len(offsets) == 0 {
+			for k, v := range saved.Offsets {
+				offsets[k] = v
+			}
+		}
+		for k, v := range saved.Checkpoints {
+			checkpoints[k] = v
+		}
+	} else if len(offsets) == 0 {
+		// 이전 형식: 오프셋 map만 저장
+		_ = json.Unmarshal([]byte(execution.Metadata), &offsets)
 	}
 
-	return offsets
+	return offsets, checkpoints
 }
 
 // updateHourlyStats 시간별 통계 업데이트
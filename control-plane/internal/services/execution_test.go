@@ -0,0 +1,61 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/conduix/conduix/control-plane/pkg/models"
+	"github.com/conduix/conduix/shared/types"
+)
+
+func TestInheritDeleteStrategy(t *testing.T) {
+	dataType := &models.DataType{
+		DeleteStrategy: `{"mode":"soft","soft_delete":{"field_name":"deleted_at","field_type":"timestamp"}}`,
+		IDFields:       `["board_id","post_id"]`,
+	}
+	strategy := map[string]any{
+		"mode":        "soft",
+		"soft_delete": map[string]any{"field_name": "deleted_at", "field_type": "timestamp"},
+	}
+
+	tests := []struct {
+		name string
+		sink types.WorkflowSink
+		want map[string]any
+	}{
+		{
+			name: "inherits when unset",
+			sink: types.WorkflowSink{Type: "sql"},
+			want: map[string]any{"id_fields": []string{"board_id", "post_id"}, "delete_strategy": strategy},
+		},
+		{
+			name: "keeps sink settings",
+			sink: types.WorkflowSink{Type: "elasticsearch", Config: map[string]any{"id_fields": []any{"id"}, "index": "posts"}},
+			want: map[string]any{"id_fields": []any{"id"}, "index": "posts", "delete_strategy": strategy},
+		},
+		{
+			name: "ignores sinks without delete support",
+			sink: types.WorkflowSink{Type: "kafka", Config: map[string]any{"topic": "posts"}},
+			want: map[string]any{"topic": "posts"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &types.WorkflowPipeline{Sinks: []types.WorkflowSink{tt.sink}}
+			if err := inheritDeleteStrategy(p, dataType); err != nil {
+				t.Fatal(err)
+			}
+			if got := p.Sinks[0].Config; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("config = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInheritDeleteStrategyInvalid(t *testing.T) {
+	p := &types.WorkflowPipeline{Sinks: []types.WorkflowSink{{Type: "sql"}}}
+	if err := inheritDeleteStrategy(p, &models.DataType{IDFields: "board_id"}); err == nil {
+		t.Error("expected error for invalid id_fields")
+	}
+}
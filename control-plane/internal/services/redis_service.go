@@ -16,6 +16,7 @@ import (
 // RedisService Redis 기반 서비스
 type RedisService struct {
 	client          *redisclient.ResilientClient
+	checkpoints     *redisclient.CheckpointStore
 	ctx             context.Context
 	cancel          context.CancelFunc
 	mu              sync.RWMutex
@@ -81,6 +82,7 @@ func NewRedisService(cfg *RedisServiceConfig) (*RedisService, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create redis client: %w", err)
 	}
+	svc.checkpoints = redisclient.NewCheckpointStore(svc.client)
 
 	return svc, nil
 }
@@ -215,26 +217,29 @@ func (s *RedisService) GetAllAgentHeartbeats() (map[string]*types.AgentHeartbeat
 }
 
 // SetPipelineCheckpoint 파이프라인 체크포인트 저장
+// 현재 체크포인트와 함께 이력에도 추가 (되감기 대상 조회용)
 func (s *RedisService) SetPipelineCheckpoint(pipelineID string, checkpoint *types.Checkpoint) error {
-	key := fmt.Sprintf("pipeline:%s:checkpoint", pipelineID)
-	return s.client.Set(s.ctx, key, checkpoint, 0) // TTL 없음
+	return s.checkpoints.Save(s.ctx, pipelineID, checkpoint)
 }
 
-// GetPipelineCheckpoint 파이프라인 체크포인트 조회
-func (s *RedisService) GetPipelineCheckpoint(pipelineID string) (*types.Checkpoint, error) {
-	key := fmt.Sprintf("pipeline:%s:checkpoint", pipelineID)
+// ListPipelineCheckpoints 파이프라인 체크포인트 이력 조회 (오래된 순)
+func (s *RedisService) ListPipelineCheckpoints(pipelineID string) ([]types.Checkpoint, error) {
+	return s.checkpoints.History(s.ctx, pipelineID)
+}
 
-	data, err := s.client.Get(s.ctx, key)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get checkpoint: %w", err)
-	}
+// SetCheckpointRestore 체크포인트 복원 결과 저장
+func (s *RedisService) SetCheckpointRestore(restore *types.CheckpointRestore) error {
+	return s.checkpoints.SaveRestore(s.ctx, restore)
+}
 
-	var checkpoint types.Checkpoint
-	if err := json.Unmarshal([]byte(data), &checkpoint); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
-	}
+// GetCheckpointRestore 마지막 체크포인트 복원 결과 조회
+func (s *RedisService) GetCheckpointRestore(pipelineID string) (*types.CheckpointRestore, error) {
+	return s.checkpoints.LoadRestore(s.ctx, pipelineID)
+}
 
-	return &checkpoint, nil
+// GetPipelineCheckpoint 파이프라인 체크포인트 조회
+func (s *RedisService) GetPipelineCheckpoint(pipelineID string) (*types.Checkpoint, error) {
+	return s.checkpoints.Load(s.ctx, pipelineID)
 }
 
 // SetPipelineMetrics 파이프라인 메트릭 저장
@@ -81,6 +81,7 @@ func (db *DB) Migrate() error {
 		&models.PipelineHourlyStats{},
 		// 데이터 유형 및 삭제 전략
 		&models.DataType{},
+		&models.DataTypeSchemaVersion{},
 		&models.DataTypePrework{},
 		&models.DeleteStrategyPreset{},
 		&models.Connection{},
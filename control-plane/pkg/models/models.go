@@ -386,7 +386,8 @@ type DataType struct {
 	IDFields string `gorm:"type:text" json:"id_fields,omitempty"`
 
 	// 스키마 정보 (JSON)
-	Schema string `gorm:"type:text" json:"schema,omitempty"`
+	Schema        string `gorm:"type:text" json:"schema,omitempty"`
+	SchemaVersion int    `gorm:"default:0" json:"schema_version"` // 스키마가 바뀔 때마다 증가 (스키마 없음: 0)
 
 	// 저장소 설정 (JSON)
 	Storage string `gorm:"type:text" json:"storage,omitempty"`
@@ -408,6 +409,23 @@ func (DataType) TableName() string {
 	return "data_types"
 }
 
+// DataTypeSchemaVersion 데이터 유형 스키마 버전 이력
+type DataTypeSchemaVersion struct {
+	ID         string `gorm:"primaryKey;size:36" json:"id"`
+	DataTypeID string `gorm:"size:36;not null;uniqueIndex:idx_datatype_schema_version" json:"data_type_id"`
+	Version    int    `gorm:"not null;uniqueIndex:idx_datatype_schema_version" json:"version"`
+	Schema     string `gorm:"type:text;not null" json:"schema"` // 스키마 정보 (JSON)
+	Forced     bool   `gorm:"default:false" json:"forced"`      // 호환성 검사를 무시하고 변경됨
+
+	CreatedBy string    `gorm:"size:36" json:"created_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName 테이블 이름
+func (DataTypeSchemaVersion) TableName() string {
+	return "data_type_schema_versions"
+}
+
 // DataTypePrework 데이터 유형별 사전작업 모델
 type DataTypePrework struct {
 	ID          string `gorm:"primaryKey;size:36" json:"id"`
@@ -39,6 +39,7 @@ type Agent struct {
 	ctx             context.Context
 	cancel          context.CancelFunc
 	redisClient     *redisclient.ResilientClient
+	checkpoints     *redisclient.CheckpointStore
 	httpClient      *http.Client
 	controlPlaneURL string
 	commMode        CommunicationMode
@@ -112,6 +113,8 @@ func NewAgent(cfg *Config) (*Agent, error) {
 			if cfg.EnableRESTFallback {
 				agent.commMode = ModeREST
 			}
+		} else {
+			agent.checkpoints = redisclient.NewCheckpointStore(agent.redisClient)
 		}
 	} else if cfg.EnableRESTFallback {
 		agent.commMode = ModeREST
@@ -189,7 +192,13 @@ func (a *Agent) StartPipeline(pipelineID string, cfg *config.PipelineConfig) err
 		return fmt.Errorf("pipeline %s is already running", pipelineID)
 	}
 
-	runner, err := pipeline.NewRunner(cfg)
+	// 체크포인트 저장소가 있으면 저장된(또는 되감은) 체크포인트에서 재개
+	opts := []pipeline.RunnerOption{pipeline.WithRunnerPipelineID(pipelineID)}
+	if a.checkpoints != nil {
+		opts = append(opts, pipeline.WithRunnerCheckpointer(newPipelineCheckpointer(a.ctx, pipelineID, a.checkpoints)))
+	}
+
+	runner, err := pipeline.NewRunner(cfg, opts...)
 	if err != nil {
 		return fmt.Errorf("failed to create runner: %w", err)
 	}
@@ -274,6 +283,69 @@ func (a *Agent) ResumePipeline(pipelineID string) error {
 	return nil
 }
 
+// RewindCheckpoint 파이프라인 체크포인트 교체
+// 실행 중인 파이프라인은 중지 후 새 체크포인트로 재시작
+func (a *Agent) RewindCheckpoint(cmd *types.CheckpointRewindCommand) *types.CheckpointRestore {
+	restore := &types.CheckpointRestore{
+		PipelineID: cmd.PipelineID,
+		RestoredAt: time.Now(),
+	}
+
+	if cmd.Target == nil {
+		restore.Error = "rewind target checkpoint is missing"
+		return restore
+	}
+	restore.RestoredFrom = cmd.Target.Timestamp
+
+	if a.checkpoints == nil || !a.IsRedisHealthy() {
+		restore.Error = "checkpoint storage unavailable"
+		return restore
+	}
+
+	previous, _ := a.checkpoints.Load(a.ctx, cmd.PipelineID) // 없으면 nil
+
+	// 실행 중이면 중지 후 체크포인트 교체
+	a.mu.RLock()
+	instance, running := a.pipelines[cmd.PipelineID]
+	a.mu.RUnlock()
+	if running {
+		if err := a.StopPipeline(cmd.PipelineID); err != nil {
+			restore.Error = err.Error()
+			return restore
+		}
+	}
+
+	// 재시작한 파이프라인의 체크포인터가 이 체크포인트를 읽어 소스에 전달
+	if err := a.checkpoints.Save(a.ctx, cmd.PipelineID, cmd.Target); err != nil {
+		restore.Error = fmt.Sprintf("failed to save checkpoint: %v", err)
+	} else {
+		restore.Success = true
+		restore.SkippedEvents = types.SkippedEvents(previous, cmd.Target)
+	}
+
+	// 체크포인트 저장 실패 시에도 기존 파이프라인은 재시작
+	if running {
+		if err := a.StartPipeline(cmd.PipelineID, instance.Config); err != nil {
+			restore.Success = false
+			restore.Error = fmt.Sprintf("failed to restart pipeline: %v", err)
+		}
+	}
+
+	return restore
+}
+
+// reportCheckpointRestore 체크포인트 복원 결과 저장 및 발행
+func (a *Agent) reportCheckpointRestore(restore *types.CheckpointRestore) error {
+	if a.checkpoints == nil || !a.IsRedisHealthy() {
+		return fmt.Errorf("redis unavailable")
+	}
+
+	if err := a.checkpoints.SaveRestore(a.ctx, restore); err != nil {
+		return fmt.Errorf("failed to save restore result: %w", err)
+	}
+	return a.redisClient.Publish(a.ctx, "pipeline:events", restore)
+}
+
 // GetPipelineStatus 파이프라인 상태 조회
 func (a *Agent) GetPipelineStatus(pipelineID string) (*PipelineInstance, error) {
 	a.mu.RLock()
@@ -539,6 +611,20 @@ func (a *Agent) handleCommand(message string) {
 		if err := a.ResumePipeline(cmd.PipelineID); err != nil {
 			fmt.Printf("Failed to resume pipeline: %v\n", err)
 		}
+	case types.CommandRewindCheckpoint:
+		var rewind types.CheckpointRewindCommand
+		data, _ := json.Marshal(cmd.Payload)
+		if err := json.Unmarshal(data, &rewind); err != nil {
+			fmt.Printf("Failed to parse rewind command: %v\n", err)
+			return
+		}
+		if rewind.PipelineID == "" {
+			rewind.PipelineID = cmd.PipelineID
+		}
+		restore := a.RewindCheckpoint(&rewind)
+		if err := a.reportCheckpointRestore(restore); err != nil {
+			fmt.Printf("Failed to report checkpoint restore: %v\n", err)
+		}
 	default:
 		fmt.Printf("Unknown command type: %s\n", cmd.Type)
 	}
@@ -596,6 +682,7 @@ func (a *Agent) executeGroup(cmd *types.GroupExecutionCommand) {
 			RecordsRead:    0,
 			RecordsWritten: 0,
 			ErrorCount:     0,
+			SchemaVersion:  pipeline.SchemaVersion,
 		}
 		completedAt := time.Now()
 		result.CompletedAt = completedAt
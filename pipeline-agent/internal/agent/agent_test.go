@@ -1,6 +1,9 @@
 package agent
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -293,3 +296,89 @@ func BenchmarkAgentListPipelines(b *testing.B) {
 		agent.ListPipelines()
 	}
 }
+
+func TestAgentRewindCheckpointWithoutRedis(t *testing.T) {
+	cfg := &Config{
+		ID:                 "test-agent",
+		EnableRESTFallback: true,
+	}
+
+	agent, _ := NewAgent(cfg)
+
+	restore := agent.RewindCheckpoint(&types.CheckpointRewindCommand{
+		PipelineID: "p1",
+		Target:     &types.Checkpoint{PipelineID: "p1", Timestamp: time.Now()},
+	})
+	if restore.Success {
+		t.Error("expected rewind to fail without checkpoint storage")
+	}
+	if restore.PipelineID != "p1" || restore.Error == "" {
+		t.Errorf("restore result mismatch: %+v", restore)
+	}
+
+	restore = agent.RewindCheckpoint(&types.CheckpointRewindCommand{PipelineID: "p1"})
+	if restore.Success {
+		t.Error("expected rewind to fail without target")
+	}
+}
+
+// memoryCheckpointStore 테스트용 체크포인트 저장소 (저장할 때마다 이력에 추가)
+type memoryCheckpointStore struct {
+	current map[string]types.Checkpoint
+	history []types.Checkpoint
+}
+
+func (s *memoryCheckpointStore) Save(_ context.Context, pipelineID string, checkpoint *types.Checkpoint) error {
+	s.current[pipelineID] = *checkpoint
+	s.history = append(s.history, *checkpoint)
+	return nil
+}
+
+func (s *memoryCheckpointStore) Load(_ context.Context, pipelineID string) (*types.Checkpoint, error) {
+	cp, ok := s.current[pipelineID]
+	if !ok {
+		return nil, fmt.Errorf("checkpoint not found")
+	}
+	// JSON 왕복처럼 오프셋을 map으로 바꿔 반환
+	data, _ := json.Marshal(cp)
+	var decoded types.Checkpoint
+	_ = json.Unmarshal(data, &decoded)
+	return &decoded, nil
+}
+
+func TestPipelineCheckpointerRestoresRewind(t *testing.T) {
+	store := &memoryCheckpointStore{current: make(map[string]types.Checkpoint)}
+	cp := newPipelineCheckpointer(context.Background(), "p1", store)
+
+	if data, err := cp.Load("/pipeline/orders"); err != nil || data != nil {
+		t.Fatalf("Load without checkpoint = %v, %v", data, err)
+	}
+
+	// 파이프라인이 만든 체크포인트도 이력에 남음
+	if err := cp.Save("/pipeline/orders", map[string]any{"offset": 10}); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := cp.Load("/pipeline/orders"); data["offset"] != float64(10) {
+		t.Errorf("restored checkpoint = %v", data)
+	}
+
+	// 되감기 대상(Reset)은 재시작 시 소스에 전달
+	current, _ := store.Load(context.Background(), "p1")
+	target, err := types.ResolveRewind("p1", current, nil, &types.CheckpointRewindRequest{Mode: types.RewindToEarliest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = store.Save(context.Background(), "p1", target)
+	if data, _ := cp.Load("/pipeline/orders"); len(data) != 1 || data["reset"] != "earliest" {
+		t.Errorf("reset checkpoint = %v", data)
+	}
+
+	// 소스가 새 위치를 저장하면 Reset 해제
+	_ = cp.Save("/pipeline/orders", map[string]any{"offset": 3})
+	if data, _ := cp.Load("/pipeline/orders"); data["reset"] != nil || data["offset"] != float64(3) {
+		t.Errorf("checkpoint after save = %v", data)
+	}
+	if len(store.history) != 3 || store.history[2].Version != target.Version+1 {
+		t.Errorf("history = %d entries, last version %d", len(store.history), store.history[len(store.history)-1].Version)
+	}
+}
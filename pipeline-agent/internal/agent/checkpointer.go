@@ -0,0 +1,57 @@
+package agent
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/conduix/conduix/shared/types"
+)
+
+// checkpointStore 파이프라인 체크포인트 저장소 (redisclient.CheckpointStore)
+type checkpointStore interface {
+	Save(ctx context.Context, pipelineID string, checkpoint *types.Checkpoint) error
+	Load(ctx context.Context, pipelineID string) (*types.Checkpoint, error)
+}
+
+// pipelineCheckpointer 파이프라인 하나의 소스 체크포인트를 저장소에 기록하는 actor.Checkpointer
+// 액터 경로의 마지막 이름을 소스 이름으로 사용하고, 저장할 때마다 저장소 이력에도 남는다.
+// 되감기로 저장된 체크포인트는 재시작 시 Load로 소스에 전달된다 (Reset이면 {"reset": ...})
+type pipelineCheckpointer struct {
+	ctx        context.Context
+	pipelineID string
+	store      checkpointStore
+	mu         sync.Mutex // 소스별 Save가 같은 체크포인트를 읽고 쓰므로 직렬화
+}
+
+func newPipelineCheckpointer(ctx context.Context, pipelineID string, store checkpointStore) *pipelineCheckpointer {
+	return &pipelineCheckpointer{ctx: ctx, pipelineID: pipelineID, store: store}
+}
+
+// Save 소스 체크포인트를 현재 체크포인트에 반영해 새 버전으로 저장
+func (c *pipelineCheckpointer) Save(actorPath string, data map[string]any) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	checkpoint, err := c.store.Load(c.ctx, c.pipelineID)
+	if err != nil {
+		checkpoint = &types.Checkpoint{PipelineID: c.pipelineID}
+	}
+	checkpoint.SetSourceCheckpoint(path.Base(actorPath), data)
+	checkpoint.Version++
+	checkpoint.Timestamp = time.Now()
+	checkpoint.Metadata = nil // 되감기 정보는 되감기 대상 체크포인트에만 남김
+
+	return c.store.Save(c.ctx, c.pipelineID, checkpoint)
+}
+
+// Load 저장된 소스 체크포인트 조회
+// 저장소는 키 없음과 조회 실패를 구분하지 않으므로 둘 다 체크포인트 없이 시작한다
+func (c *pipelineCheckpointer) Load(actorPath string) (map[string]any, error) {
+	checkpoint, err := c.store.Load(c.ctx, c.pipelineID)
+	if err != nil {
+		return nil, nil
+	}
+	return checkpoint.SourceCheckpoint(path.Base(actorPath)), nil
+}
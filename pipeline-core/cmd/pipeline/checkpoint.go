@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileCheckpointer 체크포인트를 디렉터리 아래 JSON 파일로 저장 (경로 하나당 파일 하나)
+type fileCheckpointer struct {
+	dir string
+}
+
+func newFileCheckpointer(dir string) (*fileCheckpointer, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint dir: %w", err)
+	}
+	return &fileCheckpointer{dir: dir}, nil
+}
+
+// file 체크포인트 경로(/<파이프라인>/<소스>)에 해당하는 파일
+func (c *fileCheckpointer) file(path string) string {
+	name := strings.ReplaceAll(strings.Trim(path, "/"), "/", "_")
+	return filepath.Join(c.dir, name+".json")
+}
+
+// Save 임시 파일에 쓴 뒤 교체하여 중간에 종료되어도 이전 체크포인트가 남도록 함
+func (c *fileCheckpointer) Save(path string, data map[string]any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint: %w", err)
+	}
+	file := c.file(path)
+	if err := os.WriteFile(file+".tmp", raw, 0o644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(file+".tmp", file); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Load 저장된 체크포인트 조회 (없으면 nil)
+func (c *fileCheckpointer) Load(path string) (map[string]any, error) {
+	raw, err := os.ReadFile(c.file(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+	return data, nil
+}
@@ -24,6 +24,7 @@ func main() {
 	configFile := flag.String("config", "", "파이프라인 설정 파일 경로 (-c 별칭)")
 	showVersion := flag.Bool("version", false, "버전 출력")
 	validate := flag.Bool("validate", false, "설정 검증 후 종료")
+	checkpointDir := flag.String("checkpoint-dir", "", "소스 체크포인트 저장 디렉터리 (비우면 저장하지 않음)")
 
 	flag.Parse()
 
@@ -58,7 +59,16 @@ func main() {
 	log.Printf("파이프라인 시작: %s (모드: %s)", cfg.Name, cfg.Mode)
 
 	// 파이프라인 생성
-	p, err := pipeline.New(cfg)
+	var opts []pipeline.Option
+	if *checkpointDir != "" {
+		cp, err := newFileCheckpointer(*checkpointDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "체크포인트 저장소 생성 실패: %v\n", err)
+			os.Exit(1)
+		}
+		opts = append(opts, pipeline.WithCheckpointer(cp))
+	}
+	p, err := pipeline.New(cfg, opts...)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "파이프라인 생성 실패: %v\n", err)
 		os.Exit(1)
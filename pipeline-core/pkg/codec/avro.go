@@ -0,0 +1,286 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hamba/avro/v2"
+	"github.com/hamba/avro/v2/ocf"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+)
+
+// avroSampleSize 스키마 추론에 사용하는 레코드 수
+const avroSampleSize = 1000
+
+// avroDecoder Avro OCF(Object Container File) 디코더
+type avroDecoder struct {
+	dec *ocf.Decoder
+}
+
+func newAvroDecoder(r io.Reader) (*avroDecoder, error) {
+	dec, err := ocf.NewDecoder(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open avro: %w", err)
+	}
+	return &avroDecoder{dec: dec}, nil
+}
+
+func (d *avroDecoder) Decode() (map[string]any, error) {
+	if !d.dec.HasNext() {
+		if err := d.dec.Error(); err != nil {
+			return nil, fmt.Errorf("failed to read avro: %w", err)
+		}
+		return nil, io.EOF
+	}
+
+	var v any
+	if err := d.dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode avro record: %w", err)
+	}
+	v = unwrapAvro(d.dec.Schema(), v)
+
+	record, ok := Normalize(v).(map[string]any)
+	if !ok {
+		// 레코드가 아닌 최상위 스키마
+		return map[string]any{"value": Normalize(v)}, nil
+	}
+	return record, nil
+}
+
+// unwrapAvro 유니온 값의 {타입 이름: 값} 래핑 제거
+func unwrapAvro(s avro.Schema, v any) any {
+	switch sch := s.(type) {
+	case *avro.UnionSchema:
+		m, ok := v.(map[string]any)
+		if !ok || len(m) != 1 {
+			// null 또는 기본 타입은 래핑되지 않음
+			for _, branch := range sch.Types() {
+				if branch.Type() != avro.Null {
+					return unwrapAvro(branch, v)
+				}
+			}
+			return v
+		}
+		for key, inner := range m {
+			for _, branch := range sch.Types() {
+				if avroBranchName(branch) == key {
+					return unwrapAvro(branch, inner)
+				}
+			}
+		}
+		return v
+	case *avro.RecordSchema:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return v
+		}
+		for _, f := range sch.Fields() {
+			if item, exists := m[f.Name()]; exists {
+				m[f.Name()] = unwrapAvro(f.Type(), item)
+			}
+		}
+		return m
+	case *avro.ArraySchema:
+		arr, ok := v.([]any)
+		if !ok {
+			return v
+		}
+		for i, item := range arr {
+			arr[i] = unwrapAvro(sch.Items(), item)
+		}
+		return arr
+	case *avro.MapSchema:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return v
+		}
+		for k, item := range m {
+			m[k] = unwrapAvro(sch.Values(), item)
+		}
+		return m
+	}
+	return v
+}
+
+func avroBranchName(s avro.Schema) string {
+	if named, ok := s.(avro.NamedSchema); ok {
+		return named.FullName()
+	}
+	if ls, ok := s.(avro.LogicalTypeSchema); ok && ls.Logical() != nil {
+		return string(s.Type()) + "." + string(ls.Logical().Type())
+	}
+	return string(s.Type())
+}
+
+// avroEncoder Avro OCF 인코더
+// 모든 필드는 ["null", T] 유니온으로 기록된다
+type avroEncoder struct {
+	w       io.Writer
+	typ     *fieldType
+	enc     *ocf.Encoder
+	pending []map[string]any
+}
+
+func newAvroEncoder(w io.Writer, s *schema.DataSchema) *avroEncoder {
+	e := &avroEncoder{w: w}
+	if s != nil {
+		e.typ = typeFromDataSchema(s)
+	}
+	return e
+}
+
+func (e *avroEncoder) Encode(record map[string]any) error {
+	record = NormalizeRecord(record)
+
+	if e.enc == nil {
+		if e.typ == nil {
+			e.pending = append(e.pending, record)
+			if len(e.pending) < avroSampleSize {
+				return nil
+			}
+			return e.start()
+		}
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	return e.write(record)
+}
+
+func (e *avroEncoder) start() error {
+	if e.typ == nil {
+		e.typ = typeFromRecords(e.pending)
+	}
+
+	schemaJSON, err := json.Marshal(avroRecord(e.typ, "record"))
+	if err != nil {
+		return err
+	}
+	enc, err := ocf.NewEncoder(string(schemaJSON), e.w, ocf.WithCodec(ocf.Deflate))
+	if err != nil {
+		return fmt.Errorf("failed to create avro encoder: %w", err)
+	}
+	e.enc = enc
+
+	pending := e.pending
+	e.pending = nil
+	for _, r := range pending {
+		if err := e.write(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *avroEncoder) write(record map[string]any) error {
+	row, err := e.typ.conform(record)
+	if err != nil {
+		return fmt.Errorf("avro: %w", err)
+	}
+	if err := e.enc.Encode(avroValue(e.typ, row, "record")); err != nil {
+		return fmt.Errorf("failed to write avro record: %w", err)
+	}
+	return nil
+}
+
+func (e *avroEncoder) Close() error {
+	if e.enc == nil {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	return e.enc.Close()
+}
+
+// avroRecord 객체 타입을 Avro record 스키마로 변환
+func avroRecord(t *fieldType, name string) map[string]any {
+	fields := make([]map[string]any, 0, len(t.fields))
+	for _, k := range sortedKeys(t.fields) {
+		fieldName := avroName(k)
+		fields = append(fields, map[string]any{
+			"name":    fieldName,
+			"type":    []any{"null", avroType(t.fields[k], name+"_"+fieldName)},
+			"default": nil,
+		})
+	}
+	return map[string]any{
+		"type":   "record",
+		"name":   name,
+		"fields": fields,
+	}
+}
+
+func avroType(t *fieldType, name string) any {
+	switch t.kind {
+	case kindInt:
+		return "long"
+	case kindFloat:
+		return "double"
+	case kindBool:
+		return "boolean"
+	case kindObject:
+		return avroRecord(t, name)
+	case kindArray:
+		return map[string]any{"type": "array", "items": avroType(t.elem, name+"_item")}
+	}
+	return "string"
+}
+
+// avroValue 필드 이름을 Avro 이름 규칙에 맞게 변환
+// 유니온 안의 record 값은 {레코드 이름: 값} 형태로 감싼다
+func avroValue(t *fieldType, v any, name string) any {
+	switch t.kind {
+	case kindObject:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return v
+		}
+		out := make(map[string]any, len(m))
+		for k, item := range m {
+			fieldName := avroName(k)
+			value := avroValue(t.fields[k], item, name+"_"+fieldName)
+			if item != nil && t.fields[k].kind == kindObject {
+				value = map[string]any{name + "_" + fieldName: value}
+			}
+			out[fieldName] = value
+		}
+		return out
+	case kindArray:
+		arr, ok := v.([]any)
+		if !ok {
+			return v
+		}
+		out := make([]any, len(arr))
+		for i, item := range arr {
+			out[i] = avroValue(t.elem, item, name+"_item")
+		}
+		return out
+	}
+	return v
+}
+
+// avroName Avro 이름 규칙([A-Za-z_][A-Za-z0-9_]*)에 맞지 않는 문자를 '_'로 치환
+func avroName(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_' || (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z'):
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if i == 0 {
+				b.WriteRune('_')
+			}
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
@@ -0,0 +1,268 @@
+// Package codec 파일 포맷 인코더/디코더
+// 파일 소스, 파일 싱크, 오브젝트 스토리지 소스가 공통으로 사용한다.
+package codec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+)
+
+// 지원 포맷
+const (
+	FormatNDJSON  = "ndjson"
+	FormatParquet = "parquet"
+	FormatAvro    = "avro"
+	FormatXML     = "xml"
+)
+
+// 지원 압축
+const (
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// Decoder 레코드 단위 디코더
+// 더 이상 레코드가 없으면 io.EOF 반환
+type Decoder interface {
+	Decode() (map[string]any, error)
+}
+
+// Encoder 레코드 단위 인코더
+// Close는 버퍼/트레일러를 기록하지만 하위 Writer는 닫지 않는다
+type Encoder interface {
+	Encode(record map[string]any) error
+	Close() error
+}
+
+// Options 인코더/디코더 옵션
+type Options struct {
+	RecordPath string             // xml: 레코드 요소 경로 (예: "catalog/book")
+	Schema     *schema.DataSchema // parquet/avro 쓰기 스키마 (없으면 레코드에서 추론)
+}
+
+// IsRecordFormat 줄 단위가 아닌 레코드 컨테이너 포맷 여부
+func IsRecordFormat(format string) bool {
+	switch format {
+	case FormatParquet, FormatAvro, FormatXML:
+		return true
+	}
+	return false
+}
+
+// NewDecoder 포맷별 디코더 생성
+// parquet은 임의 접근이 필요하므로 io.ReaderAt이 아니면 메모리로 읽어들인다
+func NewDecoder(format string, r io.Reader, opts Options) (Decoder, error) {
+	switch format {
+	case FormatNDJSON:
+		return newNDJSONDecoder(r), nil
+	case FormatParquet:
+		ra, size, err := readerAt(r)
+		if err != nil {
+			return nil, err
+		}
+		return NewParquetDecoder(ra, size)
+	case FormatAvro:
+		return newAvroDecoder(r)
+	case FormatXML:
+		return newXMLDecoder(r, opts.RecordPath), nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+// NewEncoder 포맷별 인코더 생성
+func NewEncoder(format string, w io.Writer, opts Options) (Encoder, error) {
+	switch format {
+	case FormatNDJSON, "json":
+		return newNDJSONEncoder(w), nil
+	case FormatParquet:
+		return newParquetEncoder(w, opts.Schema), nil
+	case FormatAvro:
+		return newAvroEncoder(w, opts.Schema), nil
+	default:
+		return nil, fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+func readerAt(r io.Reader) (io.ReaderAt, int64, error) {
+	switch v := r.(type) {
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil {
+			return nil, 0, err
+		}
+		return v, info.Size(), nil
+	case interface {
+		io.ReaderAt
+		Size() int64
+	}:
+		return v, v.Size(), nil
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bytes.NewReader(data), int64(len(data)), nil
+}
+
+// DetectCompression 선두 바이트(매직 넘버)로 압축 방식 판별
+func DetectCompression(header []byte) string {
+	switch {
+	case len(header) >= 2 && header[0] == 0x1f && header[1] == 0x8b:
+		return CompressionGzip
+	case len(header) >= 4 && bytes.Equal(header[:4], []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		return CompressionZstd
+	}
+	return CompressionNone
+}
+
+// NewDecompressor 압축 해제 Reader 생성
+func NewDecompressor(r io.Reader, compression string) (io.ReadCloser, error) {
+	switch compression {
+	case "", CompressionNone:
+		return io.NopCloser(r), nil
+	case CompressionGzip:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open gzip: %w", err)
+		}
+		return gz, nil
+	case CompressionZstd:
+		zr, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open zstd: %w", err)
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", compression)
+	}
+}
+
+// NewCompressor 압축 Writer 생성 (Close 시 하위 Writer는 닫지 않음)
+func NewCompressor(w io.Writer, compression string) (io.WriteCloser, error) {
+	switch compression {
+	case "", CompressionNone:
+		return nopWriteCloser{w}, nil
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionZstd:
+		return zstd.NewWriter(w)
+	default:
+		return nil, fmt.Errorf("unsupported compression: %s", compression)
+	}
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+// Normalize 디코딩된 값을 레코드 Data 표현으로 변환
+// 정수는 int64, 실수는 float64, 시간은 RFC3339 문자열, 바이트는 문자열로 맞춰
+// schema.DataSchema 검증(integer/number/string 등)을 그대로 적용할 수 있게 한다
+func Normalize(v any) any {
+	switch val := v.(type) {
+	case nil, string, bool, int64, float64:
+		return val
+	case int:
+		return int64(val)
+	case int8:
+		return int64(val)
+	case int16:
+		return int64(val)
+	case int32:
+		return int64(val)
+	case uint8:
+		return int64(val)
+	case uint16:
+		return int64(val)
+	case uint32:
+		return int64(val)
+	case uint:
+		if val > math.MaxInt64 {
+			return float64(val)
+		}
+		return int64(val)
+	case uint64:
+		if val > math.MaxInt64 {
+			return float64(val)
+		}
+		return int64(val)
+	case float32:
+		return float64(val)
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return i
+		}
+		f, _ := val.Float64()
+		return f
+	case []byte:
+		return string(val)
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case time.Duration:
+		return val.String()
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[k] = Normalize(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = Normalize(item)
+		}
+		return out
+	case []map[string]any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = Normalize(item)
+		}
+		return out
+	case []string:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = item
+		}
+		return out
+	default:
+		// 그 외 타입은 JSON 왕복으로 변환
+		data, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Sprintf("%v", val)
+		}
+		var out any
+		if err := json.Unmarshal(data, &out); err != nil {
+			return string(data)
+		}
+		return Normalize(out)
+	}
+}
+
+// NormalizeRecord 레코드의 모든 값 정규화
+func NormalizeRecord(record map[string]any) map[string]any {
+	return Normalize(record).(map[string]any)
+}
+
+// sortedKeys 맵 키 정렬 (스키마 필드 순서 고정용)
+func sortedKeys(m map[string]*fieldType) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,249 @@
+package codec
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+)
+
+func decodeAll(t *testing.T, dec Decoder) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	for {
+		r, err := dec.Decode()
+		if err == io.EOF {
+			return records
+		}
+		if err != nil {
+			t.Fatalf("decode failed: %v", err)
+		}
+		records = append(records, r)
+	}
+}
+
+func encodeAll(t *testing.T, format string, opts Options, records []map[string]any) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	enc, err := NewEncoder(format, &buf, opts)
+	if err != nil {
+		t.Fatalf("NewEncoder failed: %v", err)
+	}
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			t.Fatalf("encode failed: %v", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+	return &buf
+}
+
+var testRecords = []map[string]any{
+	{
+		"id":      float64(1), // JSON 소스에서 들어온 정수
+		"name":    "alice",
+		"score":   9.5,
+		"active":  true,
+		"tags":    []any{"a", "b"},
+		"address": map[string]any{"city": "Seoul", "zip": "04524"},
+		"seen_at": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	},
+	{
+		"id":     int64(2),
+		"name":   nil,
+		"score":  int64(7),
+		"active": false,
+		"tags":   []any{},
+	},
+}
+
+var testSchema = &schema.DataSchema{
+	Name: "user",
+	Fields: []schema.FieldSchema{
+		{Name: "id", Type: schema.FieldTypeInteger, Required: true},
+		{Name: "name", Type: schema.FieldTypeString},
+		{Name: "score", Type: schema.FieldTypeNumber},
+		{Name: "active", Type: schema.FieldTypeBoolean},
+		{Name: "tags", Type: schema.FieldTypeArray, Items: &schema.FieldSchema{Type: schema.FieldTypeString}},
+		{Name: "address", Type: schema.FieldTypeObject, Properties: []schema.FieldSchema{
+			{Name: "city", Type: schema.FieldTypeString},
+			{Name: "zip", Type: schema.FieldTypeString},
+		}},
+		{Name: "seen_at", Type: schema.FieldTypeString},
+	},
+}
+
+func TestRecordFormatRoundTrip(t *testing.T) {
+	for _, format := range []string{FormatParquet, FormatAvro} {
+		for _, withSchema := range []bool{false, true} {
+			name := format + "/inferred"
+			opts := Options{}
+			if withSchema {
+				name = format + "/schema"
+				opts.Schema = testSchema
+			}
+
+			t.Run(name, func(t *testing.T) {
+				buf := encodeAll(t, format, opts, testRecords)
+
+				dec, err := NewDecoder(format, buf, Options{})
+				if err != nil {
+					t.Fatalf("NewDecoder failed: %v", err)
+				}
+				records := decodeAll(t, dec)
+				if len(records) != 2 {
+					t.Fatalf("expected 2 records, got %d", len(records))
+				}
+
+				// 스키마 없이 추론해도 정수 값만 관측된 필드는 스키마 추론과 같이 정수로 기록됨
+				first := records[0]
+				if first["id"] != int64(1) || first["score"] != 9.5 || first["active"] != true {
+					t.Errorf("scalar mismatch: %+v", first)
+				}
+				if !reflect.DeepEqual(first["tags"], []any{"a", "b"}) {
+					t.Errorf("tags mismatch: %#v", first["tags"])
+				}
+				if addr, _ := first["address"].(map[string]any); addr["city"] != "Seoul" {
+					t.Errorf("address mismatch: %#v", first["address"])
+				}
+				if first["seen_at"] != "2024-01-02T03:04:05Z" {
+					t.Errorf("seen_at mismatch: %#v", first["seen_at"])
+				}
+				if records[1]["name"] != nil || records[1]["score"] != float64(7) {
+					t.Errorf("second record mismatch: %+v", records[1])
+				}
+
+				// 디코딩 결과는 DataSchema 검증을 통과해야 함
+				for i, r := range records {
+					if err := testSchema.Validate(r); err != nil {
+						t.Errorf("record %d validation failed: %v", i, err)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestTypeFromRecords(t *testing.T) {
+	records := []map[string]any{
+		{"id": float64(1), "code": "a", "note": nil, "score": 1.5, "meta": map[string]any{"n": int64(1)}},
+		{"id": int64(2), "code": float64(3), "score": int64(2), "meta": map[string]any{"n": 2.5}},
+	}
+	want := map[string]kind{"id": kindInt, "code": kindString, "note": kindString, "score": kindFloat, "meta": kindObject}
+
+	typ := typeFromRecords(records)
+	for name, k := range want {
+		if got := typ.fields[name]; got == nil || got.kind != k {
+			t.Errorf("%s = %v, want %s", name, got, k)
+		}
+	}
+	if n := typ.fields["meta"].fields["n"]; n.kind != kindFloat {
+		t.Errorf("meta.n = %s, want %s", n.kind, kindFloat)
+	}
+}
+
+func TestParquetEncoderTypeMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	enc, _ := NewEncoder(FormatParquet, &buf, Options{Schema: testSchema})
+	if err := enc.Encode(map[string]any{"id": 1.5}); err == nil {
+		t.Error("expected error for non-integer id")
+	}
+}
+
+func TestAvroFieldNames(t *testing.T) {
+	buf := encodeAll(t, FormatAvro, Options{}, []map[string]any{{"user-id": "u1", "1st": int64(1)}})
+
+	dec, _ := NewDecoder(FormatAvro, buf, Options{})
+	records := decodeAll(t, dec)
+	if records[0]["user_id"] != "u1" || records[0]["_1st"] != int64(1) {
+		t.Errorf("sanitized names mismatch: %+v", records[0])
+	}
+}
+
+func TestXMLDecoder(t *testing.T) {
+	input := `<?xml version="1.0"?>
+<catalog xmlns="urn:test">
+  <meta><book id="ignored"/></meta>
+  <books>
+    <book id="1" lang="en">
+      <title>Go</title>
+      <author>A</author>
+      <author>B</author>
+      <price currency="USD">10.5</price>
+    </book>
+    <book id="2"><title>Rust</title></book>
+  </books>
+</catalog>`
+
+	tests := []struct {
+		name  string
+		path  string
+		count int
+	}{
+		{"relative", "books/book", 2},
+		{"absolute", "/catalog/books/book", 2},
+		{"any depth", "book", 3},
+		{"root children", "", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dec, _ := NewDecoder(FormatXML, strings.NewReader(input), Options{RecordPath: tt.path})
+			records := decodeAll(t, dec)
+			if len(records) != tt.count {
+				t.Fatalf("expected %d records, got %d", tt.count, len(records))
+			}
+		})
+	}
+
+	dec, _ := NewDecoder(FormatXML, strings.NewReader(input), Options{RecordPath: "books/book"})
+	book := decodeAll(t, dec)[0]
+	if book["@id"] != "1" || book["title"] != "Go" {
+		t.Errorf("book mismatch: %+v", book)
+	}
+	if !reflect.DeepEqual(book["author"], []any{"A", "B"}) {
+		t.Errorf("authors mismatch: %#v", book["author"])
+	}
+	price, _ := book["price"].(map[string]any)
+	if price["@currency"] != "USD" || price["#text"] != "10.5" {
+		t.Errorf("price mismatch: %#v", book["price"])
+	}
+}
+
+func TestCompressedNDJSON(t *testing.T) {
+	for _, compression := range []string{CompressionGzip, CompressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			var buf bytes.Buffer
+			cw, err := NewCompressor(&buf, compression)
+			if err != nil {
+				t.Fatalf("NewCompressor failed: %v", err)
+			}
+			enc, _ := NewEncoder(FormatNDJSON, cw, Options{})
+			_ = enc.Encode(map[string]any{"id": 1})
+			_ = enc.Encode(map[string]any{"id": 2})
+			_ = enc.Close()
+			_ = cw.Close()
+
+			if got := DetectCompression(buf.Bytes()); got != compression {
+				t.Fatalf("DetectCompression mismatch: got %s", got)
+			}
+
+			rc, err := NewDecompressor(&buf, compression)
+			if err != nil {
+				t.Fatalf("NewDecompressor failed: %v", err)
+			}
+			defer rc.Close()
+
+			dec, _ := NewDecoder(FormatNDJSON, rc, Options{})
+			if records := decodeAll(t, dec); len(records) != 2 || records[1]["id"] != float64(2) {
+				t.Errorf("records mismatch: %+v", records)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+)
+
+// kind 쓰기 스키마의 값 종류
+type kind int
+
+const (
+	kindNull kind = iota
+	kindString
+	kindInt
+	kindFloat
+	kindBool
+	kindObject
+	kindArray
+)
+
+func (k kind) String() string {
+	switch k {
+	case kindString:
+		return "string"
+	case kindInt:
+		return "integer"
+	case kindFloat:
+		return "number"
+	case kindBool:
+		return "boolean"
+	case kindObject:
+		return "object"
+	case kindArray:
+		return "array"
+	}
+	return "null"
+}
+
+// fieldType parquet/avro 쓰기 스키마 생성을 위한 중간 타입 트리
+type fieldType struct {
+	kind   kind
+	fields map[string]*fieldType // object
+	elem   *fieldType            // array
+}
+
+// finalize 표현할 수 없는 타입 정리
+// 빈 객체, 중첩 배열은 문자열(JSON)로 저장한다
+func (t *fieldType) finalize() *fieldType {
+	switch t.kind {
+	case kindObject:
+		if len(t.fields) == 0 {
+			return &fieldType{kind: kindString}
+		}
+		out := &fieldType{kind: kindObject, fields: make(map[string]*fieldType, len(t.fields))}
+		for k, ft := range t.fields {
+			out.fields[k] = ft.finalize()
+		}
+		return out
+	case kindArray:
+		elem := t.elem.finalize()
+		if elem.kind == kindArray {
+			return &fieldType{kind: kindString}
+		}
+		return &fieldType{kind: kindArray, elem: elem}
+	}
+	return t
+}
+
+// typeFromRecords 레코드 샘플에서 최상위 객체 타입 추론
+// 스키마 추론(schema.Infer)과 같은 규칙을 쓰도록 추론한 DataSchema를 변환한다
+// (정수/실수 혼합은 실수, 서로 다른 타입이나 null만 관측된 필드는 문자열)
+func typeFromRecords(records []map[string]any) *fieldType {
+	return typeFromDataSchema(schema.Infer("", records, schema.InferOptions{MaxEnumValues: -1}))
+}
+
+// typeFromDataSchema DataSchema를 쓰기 스키마로 변환
+func typeFromDataSchema(s *schema.DataSchema) *fieldType {
+	t := &fieldType{kind: kindObject, fields: make(map[string]*fieldType, len(s.Fields))}
+	for _, f := range s.Fields {
+		t.fields[f.Name] = typeFromField(&f)
+	}
+	return t.finalizeRoot()
+}
+
+// finalizeRoot 최상위 객체는 필드가 없어도 객체로 유지
+func (t *fieldType) finalizeRoot() *fieldType {
+	out := &fieldType{kind: kindObject, fields: make(map[string]*fieldType, len(t.fields))}
+	for k, ft := range t.fields {
+		out.fields[k] = ft.finalize()
+	}
+	return out
+}
+
+func typeFromField(f *schema.FieldSchema) *fieldType {
+	switch f.Type {
+	case schema.FieldTypeString:
+		return &fieldType{kind: kindString}
+	case schema.FieldTypeInteger:
+		return &fieldType{kind: kindInt}
+	case schema.FieldTypeNumber:
+		return &fieldType{kind: kindFloat}
+	case schema.FieldTypeBoolean:
+		return &fieldType{kind: kindBool}
+	case schema.FieldTypeObject:
+		t := &fieldType{kind: kindObject, fields: make(map[string]*fieldType, len(f.Properties))}
+		for i := range f.Properties {
+			t.fields[f.Properties[i].Name] = typeFromField(&f.Properties[i])
+		}
+		return t
+	case schema.FieldTypeArray:
+		if f.Items == nil {
+			return &fieldType{kind: kindArray, elem: &fieldType{kind: kindString}}
+		}
+		return &fieldType{kind: kindArray, elem: typeFromField(f.Items)}
+	}
+	// any 등 타입이 정해지지 않은 필드는 JSON 문자열
+	return &fieldType{kind: kindString}
+}
+
+// conform 값을 쓰기 스키마 타입으로 변환
+// 스키마에 없는 객체 필드는 제외된다
+func (t *fieldType) conform(v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	switch t.kind {
+	case kindString:
+		switch val := v.(type) {
+		case string:
+			return val, nil
+		case map[string]any, []any:
+			data, err := json.Marshal(val)
+			if err != nil {
+				return nil, err
+			}
+			return string(data), nil
+		case float64:
+			return strconv.FormatFloat(val, 'f', -1, 64), nil
+		default:
+			return fmt.Sprint(val), nil
+		}
+
+	case kindInt:
+		switch val := v.(type) {
+		case int64:
+			return val, nil
+		case float64:
+			if val != math.Trunc(val) {
+				return nil, fmt.Errorf("expected integer, got %v", val)
+			}
+			return int64(val), nil
+		}
+
+	case kindFloat:
+		switch val := v.(type) {
+		case float64:
+			return val, nil
+		case int64:
+			return float64(val), nil
+		}
+
+	case kindBool:
+		if val, ok := v.(bool); ok {
+			return val, nil
+		}
+
+	case kindObject:
+		if val, ok := v.(map[string]any); ok {
+			out := make(map[string]any, len(t.fields))
+			for k, ft := range t.fields {
+				c, err := ft.conform(val[k])
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				out[k] = c
+			}
+			return out, nil
+		}
+
+	case kindArray:
+		if val, ok := v.([]any); ok {
+			out := make([]any, 0, len(val))
+			for i, item := range val {
+				if item == nil {
+					continue // repeated 요소는 null 불가
+				}
+				c, err := t.elem.conform(item)
+				if err != nil {
+					return nil, fmt.Errorf("[%d]: %w", i, err)
+				}
+				out = append(out, c)
+			}
+			return out, nil
+		}
+	}
+
+	return nil, fmt.Errorf("expected %s, got %T", t.kind, v)
+}
@@ -0,0 +1,59 @@
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ndjsonDecoder 줄 단위 JSON 디코더
+type ndjsonDecoder struct {
+	reader *bufio.Reader
+	line   int
+}
+
+func newNDJSONDecoder(r io.Reader) *ndjsonDecoder {
+	return &ndjsonDecoder{reader: bufio.NewReader(r)}
+}
+
+func (d *ndjsonDecoder) Decode() (map[string]any, error) {
+	for {
+		line, err := d.reader.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			return nil, err
+		}
+		d.line++
+
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			var data map[string]any
+			if decErr := json.Unmarshal(line, &data); decErr != nil {
+				return nil, fmt.Errorf("line %d: %w", d.line, decErr)
+			}
+			return data, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ndjsonEncoder 줄 단위 JSON 인코더
+type ndjsonEncoder struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONEncoder(w io.Writer) *ndjsonEncoder {
+	return &ndjsonEncoder{encoder: json.NewEncoder(w)}
+}
+
+func (e *ndjsonEncoder) Encode(record map[string]any) error {
+	return e.encoder.Encode(record)
+}
+
+func (e *ndjsonEncoder) Close() error {
+	return nil
+}
@@ -0,0 +1,170 @@
+package codec
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+)
+
+// parquetReadBatch 한 번에 읽는 행 수
+const parquetReadBatch = 128
+
+// parquetSampleSize 스키마 추론에 사용하는 레코드 수
+const parquetSampleSize = 1000
+
+// ParquetDecoder Parquet 파일 디코더
+type ParquetDecoder struct {
+	reader *parquet.GenericReader[map[string]any]
+	rows   []map[string]any
+	pos    int
+	n      int
+	eof    bool
+}
+
+// NewParquetDecoder Parquet 디코더 생성
+func NewParquetDecoder(r io.ReaderAt, size int64) (*ParquetDecoder, error) {
+	file, err := parquet.OpenFile(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parquet: %w", err)
+	}
+
+	return &ParquetDecoder{
+		reader: parquet.NewGenericReader[map[string]any](file, file.Schema()),
+		rows:   make([]map[string]any, parquetReadBatch),
+	}, nil
+}
+
+func (d *ParquetDecoder) Decode() (map[string]any, error) {
+	if d.pos >= d.n {
+		if d.eof {
+			return nil, io.EOF
+		}
+		for i := range d.rows {
+			d.rows[i] = map[string]any{}
+		}
+
+		n, err := d.reader.Read(d.rows)
+		d.pos, d.n = 0, n
+		if err == io.EOF {
+			d.eof = true
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read parquet: %w", err)
+		}
+		if n == 0 {
+			return nil, io.EOF
+		}
+	}
+
+	row := d.rows[d.pos]
+	d.pos++
+	return NormalizeRecord(row), nil
+}
+
+// Close 리더 닫기
+func (d *ParquetDecoder) Close() error {
+	return d.reader.Close()
+}
+
+// parquetEncoder Parquet 인코더
+// 스키마가 없으면 처음 parquetSampleSize개 레코드로 추론한 뒤 기록을 시작한다
+type parquetEncoder struct {
+	w       io.Writer
+	typ     *fieldType
+	writer  *parquet.GenericWriter[map[string]any]
+	pending []map[string]any
+}
+
+func newParquetEncoder(w io.Writer, s *schema.DataSchema) *parquetEncoder {
+	e := &parquetEncoder{w: w}
+	if s != nil {
+		e.typ = typeFromDataSchema(s)
+	}
+	return e
+}
+
+func (e *parquetEncoder) Encode(record map[string]any) error {
+	record = NormalizeRecord(record)
+
+	if e.writer == nil {
+		if e.typ == nil {
+			e.pending = append(e.pending, record)
+			if len(e.pending) < parquetSampleSize {
+				return nil
+			}
+			return e.start()
+		}
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	return e.write(record)
+}
+
+func (e *parquetEncoder) start() error {
+	if e.typ == nil {
+		e.typ = typeFromRecords(e.pending)
+	}
+
+	e.writer = parquet.NewGenericWriter[map[string]any](e.w, parquet.NewSchema("record", parquetGroup(e.typ)))
+
+	pending := e.pending
+	e.pending = nil
+	for _, r := range pending {
+		if err := e.write(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *parquetEncoder) write(record map[string]any) error {
+	row, err := e.typ.conform(record)
+	if err != nil {
+		return fmt.Errorf("parquet: %w", err)
+	}
+	if _, err := e.writer.Write([]map[string]any{row.(map[string]any)}); err != nil {
+		return fmt.Errorf("failed to write parquet row: %w", err)
+	}
+	return nil
+}
+
+func (e *parquetEncoder) Close() error {
+	if e.writer == nil {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	return e.writer.Close()
+}
+
+// parquetGroup 객체 타입을 Parquet 그룹 노드로 변환 (모든 필드 optional)
+func parquetGroup(t *fieldType) parquet.Group {
+	group := make(parquet.Group, len(t.fields))
+	for _, name := range sortedKeys(t.fields) {
+		ft := t.fields[name]
+		if ft.kind == kindArray {
+			group[name] = parquet.Repeated(parquetNode(ft.elem))
+		} else {
+			group[name] = parquet.Optional(parquetNode(ft))
+		}
+	}
+	return group
+}
+
+func parquetNode(t *fieldType) parquet.Node {
+	switch t.kind {
+	case kindInt:
+		return parquet.Int(64)
+	case kindFloat:
+		return parquet.Leaf(parquet.DoubleType)
+	case kindBool:
+		return parquet.Leaf(parquet.BooleanType)
+	case kindObject:
+		return parquetGroup(t)
+	}
+	return parquet.String()
+}
@@ -0,0 +1,145 @@
+package codec
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// xmlDecoder 레코드 요소 경로 기반 XML 디코더
+//
+// recordPath 규칙:
+//   - "/catalog/book": 루트부터의 절대 경로
+//   - "book", "items/book": 어느 깊이든 해당 경로로 끝나는 요소
+//   - "": 루트 요소의 직계 자식
+//
+// 속성은 "@이름", 자식 요소와 함께 있는 텍스트는 "#text" 키로 저장하고,
+// 같은 이름의 자식 요소가 여러 개면 배열로 묶는다. 값은 모두 문자열이다.
+type xmlDecoder struct {
+	dec      *xml.Decoder
+	path     []string
+	absolute bool
+	stack    []string
+}
+
+func newXMLDecoder(r io.Reader, recordPath string) *xmlDecoder {
+	d := &xmlDecoder{dec: xml.NewDecoder(r)}
+	d.dec.Strict = false
+
+	recordPath = strings.TrimSpace(recordPath)
+	if strings.HasPrefix(recordPath, "/") {
+		d.absolute = true
+	}
+	for _, part := range strings.Split(strings.Trim(recordPath, "/"), "/") {
+		if part != "" {
+			d.path = append(d.path, part)
+		}
+	}
+	return d
+}
+
+func (d *xmlDecoder) Decode() (map[string]any, error) {
+	for {
+		token, err := d.dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("failed to read xml: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			d.stack = append(d.stack, t.Name.Local)
+			if !d.matches() {
+				continue
+			}
+
+			value, err := d.parseElement(t)
+			d.stack = d.stack[:len(d.stack)-1]
+			if err != nil {
+				return nil, err
+			}
+			if record, ok := value.(map[string]any); ok {
+				return record, nil
+			}
+			return map[string]any{"#text": value}, nil
+
+		case xml.EndElement:
+			if len(d.stack) > 0 {
+				d.stack = d.stack[:len(d.stack)-1]
+			}
+		}
+	}
+}
+
+// matches 현재 요소가 레코드 경로와 일치하는지 확인
+func (d *xmlDecoder) matches() bool {
+	if len(d.path) == 0 {
+		return len(d.stack) == 2
+	}
+	if d.absolute && len(d.stack) != len(d.path) {
+		return false
+	}
+	if len(d.stack) < len(d.path) {
+		return false
+	}
+
+	offset := len(d.stack) - len(d.path)
+	for i, name := range d.path {
+		if name != "*" && d.stack[offset+i] != name {
+			return false
+		}
+	}
+	return true
+}
+
+// parseElement 요소 하나를 값으로 변환 (끝 태그까지 소비)
+func (d *xmlDecoder) parseElement(start xml.StartElement) (any, error) {
+	m := make(map[string]any)
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		m["@"+attr.Name.Local] = attr.Value
+	}
+
+	var text strings.Builder
+	for {
+		token, err := d.dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read xml element %s: %w", start.Name.Local, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := d.parseElement(t)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing := m[name].(type) {
+			case nil:
+				m[name] = child
+			case []any:
+				m[name] = append(existing, child)
+			default:
+				m[name] = []any{existing, child}
+			}
+
+		case xml.CharData:
+			text.Write(t)
+
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(m) == 0 {
+				return content, nil
+			}
+			if content != "" {
+				m["#text"] = content
+			}
+			return m, nil
+		}
+	}
+}
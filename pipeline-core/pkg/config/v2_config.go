@@ -3,9 +3,14 @@ package config
 import (
 	"fmt"
 	"os"
+	"path"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+	"github.com/conduix/conduix/shared/types"
 )
 
 // PipelineMode 파이프라인 모드
@@ -28,12 +33,16 @@ type PipelineConfigV2 struct {
 
 // SourceV2 데이터 소스 설정
 type SourceV2 struct {
-	Type string `yaml:"type"` // file, sql, http, kafka, sql_event, cdc
+	Type string `yaml:"type"` // file, sql, http, http_server, kafka, sql_event, cdc, elasticsearch, mongodb, s3, generate, redis
 
 	// File
-	Path   string   `yaml:"path,omitempty"`
-	Paths  []string `yaml:"paths,omitempty"`
-	Format string   `yaml:"format,omitempty"` // json, csv, lines
+	Path        string   `yaml:"path,omitempty"`
+	Paths       []string `yaml:"paths,omitempty"`
+	Format      string   `yaml:"format,omitempty"`      // json, ndjson, csv, lines, parquet, avro, xml
+	RecordPath  string   `yaml:"record_path,omitempty"` // xml 레코드 요소 경로 (예: catalog/book)
+	Tail        bool     `yaml:"tail,omitempty"`        // 파일 끝에서 추가되는 내용 계속 읽기
+	ReadFrom    string   `yaml:"read_from,omitempty"`   // beginning, end (tail 모드 최초 실행 시 시작 위치)
+	Compression string   `yaml:"compression,omitempty"` // auto, gzip, zstd, none (default: auto)
 
 	// SQL (query-based)
 	Driver      string             `yaml:"driver,omitempty"` // mysql, postgres
@@ -41,6 +50,7 @@ type SourceV2 struct {
 	Query       string             `yaml:"query,omitempty"`
 	Params      []string           `yaml:"params,omitempty"`
 	Incremental *IncrementalConfig `yaml:"incremental,omitempty"`
+	Partition   *PartitionConfig   `yaml:"partition,omitempty"`
 
 	// HTTP
 	URL        string            `yaml:"url,omitempty"`
@@ -49,6 +59,18 @@ type SourceV2 struct {
 	Body       string            `yaml:"body,omitempty"`
 	Auth       *AuthConfig       `yaml:"auth,omitempty"`
 	Pagination *PaginationConfig `yaml:"pagination,omitempty"`
+	RateLimit  float64           `yaml:"rate_limit,omitempty"`  // 초당 최대 요청 수 (0: 제한 없음, generate: 초당 레코드 수)
+	MaxRetries int               `yaml:"max_retries,omitempty"` // 429/5xx 재시도 횟수 (default: 3, 음수: 재시도 안 함)
+	RetryDelay int               `yaml:"retry_delay,omitempty"` // milliseconds, 첫 재시도 대기 (default: 500, 2배씩 증가)
+	State      []HTTPStateConfig `yaml:"state,omitempty"`       // 증분 동기화 상태 (url/body/headers에서 {{.state.<key>}}로 참조)
+
+	// HTTP Server (webhook 수신, 수신 경로는 path 사용)
+	Address       string            `yaml:"address,omitempty"`        // 수신 주소 (default: 0.0.0.0:8080)
+	IngestAuth    *IngestAuthConfig `yaml:"ingest_auth,omitempty"`    // 요청 인증 (shared secret 또는 HMAC 서명)
+	BufferSize    int               `yaml:"buffer_size,omitempty"`    // 수신 버퍼 레코드 수 (default: 10000)
+	MaxBodySize   int               `yaml:"max_body_size,omitempty"`  // bytes, 요청 본문 최대 크기 (default: 10MB)
+	AckTimeout    int               `yaml:"ack_timeout,omitempty"`    // milliseconds, 버퍼 공간 대기 시간 (default: 1000, 초과 시 429)
+	CommitTimeout int               `yaml:"commit_timeout,omitempty"` // milliseconds, 싱크 기록 확정(Ack) 대기 시간 (default: 30000, 초과 시 504)
 
 	// Kafka
 	Brokers        []string `yaml:"brokers,omitempty"`
@@ -61,30 +83,121 @@ type SourceV2 struct {
 	CommitInterval int      `yaml:"commit_interval,omitempty"` // milliseconds
 
 	// SQL Event Table (polling-based)
-	Table           string   `yaml:"table,omitempty"`
-	IDColumn        string   `yaml:"id_column,omitempty"` // default: "id"
-	TimestampColumn string   `yaml:"timestamp_column,omitempty"`
-	Columns         []string `yaml:"columns,omitempty"` // columns to select
-	Where           string   `yaml:"where,omitempty"`   // additional WHERE clause
-	OrderBy         string   `yaml:"order_by,omitempty"`
-	BatchSize       int      `yaml:"batch_size,omitempty"`    // default: 1000
-	PollInterval    int      `yaml:"poll_interval,omitempty"` // milliseconds, default: 1000
+	Table           string        `yaml:"table,omitempty"`
+	IDColumn        string        `yaml:"id_column,omitempty"` // default: "id"
+	TimestampColumn string        `yaml:"timestamp_column,omitempty"`
+	Columns         []string      `yaml:"columns,omitempty"` // columns to select
+	Where           string        `yaml:"where,omitempty"`   // additional WHERE clause
+	OrderBy         string        `yaml:"order_by,omitempty"`
+	BatchSize       int           `yaml:"batch_size,omitempty"`    // default: 1000
+	PollInterval    int           `yaml:"poll_interval,omitempty"` // milliseconds, default: 1000 (file tail 모드 공용)
+	Outbox          *OutboxConfig `yaml:"outbox,omitempty"`        // transactional outbox 모드
 
 	// CDC (Change Data Capture)
-	Host     string   `yaml:"host,omitempty"`
-	Port     int      `yaml:"port,omitempty"`
-	Username string   `yaml:"username,omitempty"`
-	Password string   `yaml:"password,omitempty"`
-	Database string   `yaml:"database,omitempty"`
-	Tables   []string `yaml:"tables,omitempty"`    // tables to watch
-	ServerID uint32   `yaml:"server_id,omitempty"` // MySQL server ID for binlog
-	SlotName string   `yaml:"slot_name,omitempty"` // PostgreSQL replication slot
+	Host        string   `yaml:"host,omitempty"`
+	Port        int      `yaml:"port,omitempty"`
+	Username    string   `yaml:"username,omitempty"`
+	Password    string   `yaml:"password,omitempty"`
+	Database    string   `yaml:"database,omitempty"`
+	Tables      []string `yaml:"tables,omitempty"`      // tables to watch
+	ServerID    uint32   `yaml:"server_id,omitempty"`   // MySQL server ID for binlog
+	SlotName    string   `yaml:"slot_name,omitempty"`   // PostgreSQL replication slot
+	Publication string   `yaml:"publication,omitempty"` // PostgreSQL publication (default: slot_name)
+
+	SnapshotMode      string `yaml:"snapshot_mode,omitempty"`       // never, initial (MySQL: 기존 행을 읽은 후 binlog로 이어서 읽음)
+	SnapshotChunkSize int    `yaml:"snapshot_chunk_size,omitempty"` // 스냅샷 청크 크기 (PK 기준, default: 1000)
+
+	Filter *CDCFilterConfig `yaml:"filter,omitempty"` // 데이터베이스/테이블/컬럼 필터 및 마스킹
+
+	// Elasticsearch / MongoDB (username, password, database, batch_size 공용)
+	Addresses    []string       `yaml:"addresses,omitempty"`     // elasticsearch 주소 목록
+	APIKey       string         `yaml:"api_key,omitempty"`       // elasticsearch API key (username/password 대신)
+	Index        string         `yaml:"index,omitempty"`         // elasticsearch 인덱스 (패턴, 별칭 가능)
+	Sort         []string       `yaml:"sort,omitempty"`          // elasticsearch 정렬 필드 (예: id, -updated_at), 고유 필드를 지정하면 PIT 만료 후에도 재개 가능
+	KeepAlive    string         `yaml:"keep_alive,omitempty"`    // elasticsearch point-in-time 유지 시간 (default: 5m)
+	URI          string         `yaml:"uri,omitempty"`           // mongodb 연결 URI
+	Collection   string         `yaml:"collection,omitempty"`    // mongodb 컬렉션
+	ChangeStream bool           `yaml:"change_stream,omitempty"` // mongodb change stream 모드 (find 대신 변경 이벤트 수신)
+	QueryFilter  map[string]any `yaml:"query_filter,omitempty"`  // elasticsearch query DSL 또는 mongodb find 필터 (change stream: $match 조건)
+
+	// S3 (format, compression, record_path 공용)
+	Bucket    string             `yaml:"bucket,omitempty"`
+	Prefix    string             `yaml:"prefix,omitempty"`     // 목록 조회 키 접두사
+	Pattern   string             `yaml:"pattern,omitempty"`    // 키 glob 필터 (예: logs/*/*.json.gz, *는 /를 넘지 않음)
+	Region    string             `yaml:"region,omitempty"`     // default: us-east-1
+	Endpoint  string             `yaml:"endpoint,omitempty"`   // S3 호환 스토리지 주소 (지정 시 path-style 요청)
+	AccessKey string             `yaml:"access_key,omitempty"` // 미지정 시 기본 자격 증명 체인 사용
+	SecretKey string             `yaml:"secret_key,omitempty"`
+	AfterRead *S3AfterReadConfig `yaml:"after_read,omitempty"` // 객체의 레코드가 모두 싱크에 기록(Ack)된 후 처리
+
+	// Generate (테스트 데이터 생성, rate_limit 공용)
+	Mapping string             `yaml:"mapping,omitempty"` // Bloblang 매핑 (this.index: 1부터 시작하는 레코드 번호)
+	Schema  *schema.DataSchema `yaml:"schema,omitempty"`  // mapping 대신 필드 타입/enum/pattern에 맞는 값 생성
+	Count   int                `yaml:"count,omitempty"`   // 생성할 레코드 수 (0: 취소될 때까지)
+	Seed    int64              `yaml:"seed,omitempty"`    // 난수 시드 (같은 시드는 같은 레코드 생성, 0: 실행마다 다름)
+
+	// Redis Streams (url: redis://host:6379/0, password, group_id, batch_size, max_wait, start_offset 공용)
+	Streams   []string `yaml:"streams,omitempty"`    // 스트림 키 목록
+	Consumer  string   `yaml:"consumer,omitempty"`   // 컨슈머 이름 (default: hostname, 재시작 시 같은 이름이면 확인되지 않은 메시지부터 다시 읽음)
+	ClaimIdle int      `yaml:"claim_idle,omitempty"` // milliseconds, 다른 컨슈머가 이 시간 이상 확인하지 않은 메시지를 가져옴 (default: 60000, 음수: 사용 안 함)
+}
+
+// S3AfterReadConfig 읽기가 끝난 S3 객체 후처리 설정
+// move는 prefix 아래로 복사 후 원본을 삭제하고 (키의 source prefix 부분을 대체),
+// tag는 기존 태그에 tags를 덧붙인다
+type S3AfterReadConfig struct {
+	Action string            `yaml:"action"`           // move, tag, delete
+	Prefix string            `yaml:"prefix,omitempty"` // move 대상 키 접두사
+	Tags   map[string]string `yaml:"tags,omitempty"`   // tag로 추가할 태그
+}
+
+// CDCFilterConfig CDC 필터 설정
+// 모든 패턴은 전체 일치 정규식이며 exclude가 include보다 우선한다
+type CDCFilterConfig struct {
+	IncludeDatabases []string           `yaml:"include_databases,omitempty"`
+	ExcludeDatabases []string           `yaml:"exclude_databases,omitempty"`
+	IncludeTables    []string           `yaml:"include_tables,omitempty"`  // "db.table"
+	ExcludeTables    []string           `yaml:"exclude_tables,omitempty"`  // "db.table"
+	IncludeColumns   []string           `yaml:"include_columns,omitempty"` // "db.table.column"
+	ExcludeColumns   []string           `yaml:"exclude_columns,omitempty"` // "db.table.column"
+	Masks            []ColumnMaskConfig `yaml:"masks,omitempty"`
+}
+
+// ColumnMaskConfig 컬럼 마스킹 설정
+type ColumnMaskConfig struct {
+	Column string `yaml:"column"`         // "db.table.column" 정규식
+	Method string `yaml:"method"`         // redact, hash, partial (default: redact)
+	Keep   int    `yaml:"keep,omitempty"` // partial: 끝에서 유지할 글자 수
 }
 
 // IncrementalConfig 증분 처리 설정 (SQL용)
+// 실행이 끝날 때마다 Column의 최대값(high-water mark)을 체크포인트에 저장하고
+// 다음 실행에서는 그보다 큰 행만 조회한다
 type IncrementalConfig struct {
 	Column   string `yaml:"column"`
-	StateKey string `yaml:"state_key"`
+	StateKey string `yaml:"state_key"` // 체크포인트 키 (default: column)
+}
+
+// PartitionConfig 병렬 범위 조회 설정 (SQL용)
+// Column의 MIN/MAX 범위를 Count개로 나눠 동시에 조회한다 (숫자 또는 날짜 컬럼)
+type PartitionConfig struct {
+	Column string `yaml:"column"`
+	Count  int    `yaml:"count,omitempty"` // default: 4
+}
+
+// OutboxConfig SQL 이벤트 테이블 outbox 모드 설정
+// on_ack가 delete/mark이면 처리되지 않은 행 전체를 조회하므로 늦게 커밋된 행도 읽히며,
+// 그렇지 않으면 lookback 구간을 다시 조회하고 중복은 dedup으로 거른다
+type OutboxConfig struct {
+	OnAck             string `yaml:"on_ack,omitempty"`             // delete, mark, none (default: processed_column이 있으면 mark)
+	ProcessedColumn   string `yaml:"processed_column,omitempty"`   // 처리 완료 시각 컬럼 (예: processed_at)
+	AttemptsColumn    string `yaml:"attempts_column,omitempty"`    // 전달 시도 횟수 컬럼 (예: attempts)
+	MaxAttempts       int    `yaml:"max_attempts,omitempty"`       // 초과한 행은 조회하지 않음 (attempts_column 필요)
+	VisibilityTimeout int    `yaml:"visibility_timeout,omitempty"` // milliseconds, 확인되지 않은 행 재전달 대기 (default: 30000)
+	Lookback          int    `yaml:"lookback,omitempty"`           // milliseconds, timestamp_column 기준 재조회 구간 (on_ack none)
+	DedupStorage      string `yaml:"dedup_storage,omitempty"`      // redis, memory (default: memory)
+	DedupRedisURL     string `yaml:"dedup_redis_url,omitempty"`    // dedup_storage redis 주소 (host:port 또는 redis:// URL)
+	DedupTTL          string `yaml:"dedup_ttl,omitempty"`          // 처리된 ID 보관 기간 (default: 24h)
 }
 
 // AuthConfig HTTP 인증 설정
@@ -100,11 +213,39 @@ type AuthConfig struct {
 }
 
 // PaginationConfig HTTP 페이징 설정
+// NextField/DataField는 JSONPath 형식 경로 (예: $.meta.next, data.items)
 type PaginationConfig struct {
-	Type      string `yaml:"type"`       // next_url, offset, cursor
-	NextField string `yaml:"next_field"` // 응답에서 다음 URL 필드
+	Type      string `yaml:"type"`       // next_url, offset, page, cursor, link_header
+	NextField string `yaml:"next_field"` // next_url: 다음 URL 경로, cursor: 다음 커서 경로
 	DataField string `yaml:"data_field"` // 실제 데이터 필드
 	MaxPages  int    `yaml:"max_pages"`  // 최대 페이지 수
+
+	PageSize    int    `yaml:"page_size,omitempty"`    // offset/page: 페이지 크기 (limit 파라미터, 이보다 적게 오면 마지막 페이지)
+	LimitParam  string `yaml:"limit_param,omitempty"`  // default: limit
+	OffsetParam string `yaml:"offset_param,omitempty"` // default: offset
+	PageParam   string `yaml:"page_param,omitempty"`   // default: page
+	StartPage   int    `yaml:"start_page,omitempty"`   // default: 1
+	CursorParam string `yaml:"cursor_param,omitempty"` // default: cursor
+	CursorIn    string `yaml:"cursor_in,omitempty"`    // query, body (JSON 요청 본문 필드, default: query)
+}
+
+// HTTPStateConfig HTTP 증분 동기화 상태 값
+// 실행이 성공적으로 끝나면 체크포인트에 저장되고 다음 실행의 템플릿에 전달된다
+type HTTPStateConfig struct {
+	Key     string `yaml:"key"`               // 템플릿 키
+	Field   string `yaml:"field,omitempty"`   // 레코드 필드 경로, 읽은 값 중 최대값 저장 (예: updated_at)
+	Cursor  string `yaml:"cursor,omitempty"`  // 응답 본문 경로, 마지막으로 받은 값 저장 (opaque 커서)
+	Initial string `yaml:"initial,omitempty"` // 저장된 값이 없을 때 사용할 값
+}
+
+// IngestAuthConfig HTTP 수신 요청 인증 설정
+type IngestAuthConfig struct {
+	Type      string `yaml:"type"`                // secret, hmac
+	Header    string `yaml:"header,omitempty"`    // secret: X-Webhook-Secret, hmac: X-Signature (default)
+	Secret    string `yaml:"secret"`              // 공유 비밀 또는 HMAC 키
+	Algorithm string `yaml:"algorithm,omitempty"` // hmac: sha256 (default), sha1, sha512
+	Encoding  string `yaml:"encoding,omitempty"`  // hmac: hex (default), base64
+	Prefix    string `yaml:"prefix,omitempty"`    // hmac: 서명 값 접두사 (예: "sha256=")
 }
 
 // RealtimeConfig 실시간 파이프라인 설정
@@ -112,8 +253,52 @@ type RealtimeConfig struct {
 	IDField        string `yaml:"id_field"`         // 중복 체크용 ID 필드
 	EventTypeField string `yaml:"event_type_field"` // CREATE/UPDATE/DELETE 구분
 	EntityIDField  string `yaml:"entity_id_field"`  // 엔티티 ID 필드
-	DedupStorage   string `yaml:"dedup_storage"`    // redis, memory
+	DedupStorage   string `yaml:"dedup_storage"`    // memory, lru, bloom, redis, sql
 	DedupTTL       string `yaml:"dedup_ttl"`        // 중복 ID 보관 기간
+
+	// 복합 키: 여러 필드(점으로 중첩 경로 지정 가능, 예: order.id)로 키를 만듦, 지정하면 단일 필드 설정 대신 사용
+	IDFields       []string `yaml:"id_fields,omitempty"`        // 중복 체크용 이벤트 키 필드
+	EntityIDFields []string `yaml:"entity_id_fields,omitempty"` // 엔티티 키 필드 (DataType id_fields와 같은 형식)
+
+	// 순서 보장: 엔티티별 마지막 적용 버전보다 오래된 이벤트는 버림 (memory, redis, sql만 지원)
+	VersionField string `yaml:"version_field,omitempty"` // 버전(정수) 또는 이벤트 시각(RFC3339) 필드
+	TombstoneTTL string `yaml:"tombstone_ttl,omitempty"` // DELETE 툼스톤 보관 기간 (default: dedup_ttl)
+
+	// 제한된 메모리 저장소(lru, bloom) 설정
+	DedupMaxEntries        int     `yaml:"dedup_max_entries,omitempty"`         // lru: 최대 항목 수, bloom: TTL 동안 예상 ID 수 (default: 1000000)
+	DedupFalsePositiveRate float64 `yaml:"dedup_false_positive_rate,omitempty"` // bloom 오탐률 (default: 0.001)
+	DedupBuckets           int     `yaml:"dedup_buckets,omitempty"`             // bloom 시간 버킷 수 (default: 4)
+
+	// redis 저장소 주소 (host:port 또는 redis:// URL, default: localhost:6379)
+	DedupRedisURL string `yaml:"dedup_redis_url,omitempty"`
+
+	// sql 저장소 설정 (테이블은 시작 시 없으면 생성)
+	DedupSQLDriver      string `yaml:"dedup_sql_driver,omitempty"`       // mysql, postgres, sqlite
+	DedupSQLDSN         string `yaml:"dedup_sql_dsn,omitempty"`          // 접속 문자열
+	DedupSQLTablePrefix string `yaml:"dedup_sql_table_prefix,omitempty"` // 테이블 이름 접두사 (default: conduix_dedup_<파이프라인 이름>)
+}
+
+// EventKeyFields 중복 체크용 이벤트 키 필드 (id_fields 우선, 없으면 id_field)
+func (r *RealtimeConfig) EventKeyFields() []string {
+	return keyFields(r.IDFields, r.IDField)
+}
+
+// EntityKeyFields 엔티티 키 필드 (entity_id_fields 우선, 없으면 entity_id_field)
+func (r *RealtimeConfig) EntityKeyFields() []string {
+	return keyFields(r.EntityIDFields, r.EntityIDField)
+}
+
+func keyFields(fields []string, field string) []string {
+	var out []string
+	for _, f := range fields {
+		if f != "" {
+			out = append(out, f)
+		}
+	}
+	if len(out) == 0 && field != "" {
+		out = []string{field}
+	}
+	return out
 }
 
 // StepV2 처리 단계
@@ -124,6 +309,28 @@ type StepV2 struct {
 	Sample    float64      `yaml:"sample,omitempty"`    // 샘플링 비율
 	Select    []string     `yaml:"select,omitempty"`    // 필드 선택
 	Exclude   []string     `yaml:"exclude,omitempty"`   // 필드 제외
+
+	// 중복 제거 (transform, filter 다음에 적용)
+	Dedup *DedupStepConfig `yaml:"dedup,omitempty"`
+}
+
+// DedupStepConfig 중복 제거 단계 설정
+// keep=first는 저장소(storage)로 윈도우 안의 재발생을 판정하고, keep=last는 윈도우가 닫힐 때까지 키별 마지막 레코드를 보류한다
+type DedupStepConfig struct {
+	Key     string `yaml:"key"`               // 키 표현식 (예: ".order_id" 또는 ".tenant, .order.id")
+	Window  string `yaml:"window"`            // 중복 판정 윈도우 (예: 10m)
+	Keep    string `yaml:"keep,omitempty"`    // first, last (default: first)
+	Storage string `yaml:"storage,omitempty"` // keep=first 저장소: memory, lru, bloom, redis, sql (default: memory)
+
+	// 저장소별 설정 (RealtimeConfig의 dedup_* 설정과 같은 의미)
+	MaxEntries     int    `yaml:"max_entries,omitempty"`
+	RedisURL       string `yaml:"redis_url,omitempty"`
+	SQLDriver      string `yaml:"sql_driver,omitempty"`
+	SQLDSN         string `yaml:"sql_dsn,omitempty"`
+	SQLTablePrefix string `yaml:"sql_table_prefix,omitempty"`
+
+	// Namespace 저장소 키 공간 (파이프라인이 파이프라인 이름과 단계 이름으로 설정)
+	Namespace string `yaml:"-"`
 }
 
 // FilterConfig 필터 설정 (문자열 또는 구조화된 형식)
@@ -210,11 +417,45 @@ func (fc *FilterConfig) GetExpression() string {
 
 // OutputConfig 출력 설정 (Stub)
 type OutputConfig struct {
-	Type      string               `yaml:"type"` // stub
+	Type      string               `yaml:"type"` // stub, file, redis, sql, elasticsearch, mongodb
 	LogLevel  string               `yaml:"log_level,omitempty"`
 	LogFormat string               `yaml:"log_format,omitempty"`
 	Metrics   *MetricsOutputConfig `yaml:"metrics,omitempty"`
 	Callback  *CallbackConfig      `yaml:"callback,omitempty"`
+
+	// File
+	Path        string `yaml:"path,omitempty"`
+	Format      string `yaml:"format,omitempty"`      // ndjson, parquet, avro (default: ndjson)
+	Compression string `yaml:"compression,omitempty"` // none, gzip, zstd (default: none)
+
+	// Redis
+	URL       string `yaml:"url,omitempty"`        // redis://host:6379/0
+	Password  string `yaml:"password,omitempty"`   // url의 비밀번호 대신 사용
+	Command   string `yaml:"command,omitempty"`    // xadd, hset, lpush, rpush (default: xadd)
+	Key       string `yaml:"key,omitempty"`        // 스트림/해시/리스트 키 (레코드 필드 참조 가능, 예: user:{{.id}})
+	MaxLen    int64  `yaml:"max_len,omitempty"`    // xadd 스트림 최대 길이 (근사 트리밍, 0: 제한 없음)
+	TTL       string `yaml:"ttl,omitempty"`        // hset/lpush/rpush 키 만료 시간 (예: 24h)
+	BatchSize int    `yaml:"batch_size,omitempty"` // 파이프라인으로 묶어 보내는 명령 수 (default: 100)
+
+	// SQL (batch_size 공용)
+	Driver string `yaml:"driver,omitempty"` // mysql, postgres, sqlite
+	DSN    string `yaml:"dsn,omitempty"`
+	Table  string `yaml:"table,omitempty"`
+
+	// Elasticsearch (password, batch_size 공용)
+	Addresses []string `yaml:"addresses,omitempty"`
+	APIKey    string   `yaml:"api_key,omitempty"`
+	Username  string   `yaml:"username,omitempty"`
+	Index     string   `yaml:"index,omitempty"`
+
+	// MongoDB (batch_size 공용)
+	URI        string `yaml:"uri,omitempty"`
+	Database   string `yaml:"database,omitempty"`
+	Collection string `yaml:"collection,omitempty"`
+
+	// 삭제 전략 (sql, elasticsearch, mongodb): DataType의 id_fields/delete_strategy와 같은 형식
+	IDFields       []string              `yaml:"id_fields,omitempty"`       // 대상 식별 필드 (복합키 가능, upsert/삭제 키)
+	DeleteStrategy *types.DeleteStrategy `yaml:"delete_strategy,omitempty"` // 삭제 감지 및 물리/논리 삭제, 무시
 }
 
 // MetricsOutputConfig 메트릭 출력 설정
@@ -282,10 +523,49 @@ func (c *PipelineConfigV2) Validate() error {
 		}
 	}
 
+	// 단계 검증
+	for _, step := range c.Steps {
+		if err := step.validateDedup(); err != nil {
+			return fmt.Errorf("step %s: %w", step.Name, err)
+		}
+	}
+
 	// 출력 기본값
 	if c.Output.Type == "" {
 		c.Output.Type = "stub"
 	}
+	if c.Output.Type == "file" && c.Output.Path == "" {
+		return fmt.Errorf("output: file path is required")
+	}
+	if c.Output.Type == "redis" {
+		if c.Output.URL == "" || c.Output.Key == "" {
+			return fmt.Errorf("output: redis url and key are required")
+		}
+		switch c.Output.Command {
+		case "", "xadd", "hset", "lpush", "rpush":
+		default:
+			return fmt.Errorf("output: unsupported redis command: %s", c.Output.Command)
+		}
+		if c.Output.TTL != "" {
+			if _, err := time.ParseDuration(c.Output.TTL); err != nil {
+				return fmt.Errorf("output: invalid redis ttl: %w", err)
+			}
+		}
+	}
+	switch c.Output.Type {
+	case "sql":
+		if c.Output.Driver == "" || c.Output.DSN == "" || c.Output.Table == "" {
+			return fmt.Errorf("output: sql driver, dsn and table are required")
+		}
+	case "elasticsearch":
+		if len(c.Output.Addresses) == 0 || c.Output.Index == "" {
+			return fmt.Errorf("output: elasticsearch addresses and index are required")
+		}
+	case "mongodb":
+		if c.Output.URI == "" || c.Output.Database == "" || c.Output.Collection == "" {
+			return fmt.Errorf("output: mongodb uri, database and collection are required")
+		}
+	}
 
 	return nil
 }
@@ -299,6 +579,21 @@ func (c *PipelineConfigV2) validateSource() error {
 		if c.Source.Format == "" {
 			c.Source.Format = "json"
 		}
+		switch c.Source.Format {
+		case "json", "ndjson", "csv", "lines", "parquet", "avro", "xml":
+		default:
+			return fmt.Errorf("unsupported file format: %s", c.Source.Format)
+		}
+		switch c.Source.ReadFrom {
+		case "", "beginning", "end":
+		default:
+			return fmt.Errorf("invalid read_from: %s (expected beginning or end)", c.Source.ReadFrom)
+		}
+		switch c.Source.Compression {
+		case "", "auto", "gzip", "zstd", "none":
+		default:
+			return fmt.Errorf("unsupported compression: %s", c.Source.Compression)
+		}
 
 	case "sql":
 		if c.Source.Driver == "" {
@@ -310,6 +605,132 @@ func (c *PipelineConfigV2) validateSource() error {
 		if c.Source.Query == "" {
 			return fmt.Errorf("sql query is required")
 		}
+		if c.Source.Incremental != nil && c.Source.Incremental.Column == "" {
+			return fmt.Errorf("sql incremental column is required")
+		}
+		if c.Source.Partition != nil {
+			if c.Source.Partition.Column == "" {
+				return fmt.Errorf("sql partition column is required")
+			}
+			if c.Source.Partition.Count <= 0 {
+				c.Source.Partition.Count = 4
+			}
+		}
+
+	case "elasticsearch":
+		if len(c.Source.Addresses) == 0 {
+			return fmt.Errorf("elasticsearch addresses are required")
+		}
+		if c.Source.Index == "" {
+			return fmt.Errorf("elasticsearch index is required")
+		}
+		if c.Source.KeepAlive != "" {
+			if _, err := time.ParseDuration(c.Source.KeepAlive); err != nil {
+				return fmt.Errorf("invalid keep_alive: %w", err)
+			}
+		}
+
+	case "s3":
+		if c.Source.Bucket == "" {
+			return fmt.Errorf("s3 bucket is required")
+		}
+		if c.Source.Format == "" {
+			c.Source.Format = "json"
+		}
+		switch c.Source.Format {
+		case "json", "ndjson", "csv", "lines", "parquet", "avro", "xml":
+		default:
+			return fmt.Errorf("unsupported file format: %s", c.Source.Format)
+		}
+		switch c.Source.Compression {
+		case "", "auto", "gzip", "zstd", "none":
+		default:
+			return fmt.Errorf("unsupported compression: %s", c.Source.Compression)
+		}
+		if c.Source.Pattern != "" {
+			if _, err := path.Match(c.Source.Pattern, ""); err != nil {
+				return fmt.Errorf("invalid s3 pattern %s: %w", c.Source.Pattern, err)
+			}
+		}
+		if (c.Source.AccessKey == "") != (c.Source.SecretKey == "") {
+			return fmt.Errorf("s3 access_key and secret_key must be set together")
+		}
+		if a := c.Source.AfterRead; a != nil {
+			switch a.Action {
+			case "delete":
+			case "move":
+				if a.Prefix == "" {
+					return fmt.Errorf("s3 after_read move requires prefix")
+				}
+			case "tag":
+				if len(a.Tags) == 0 {
+					return fmt.Errorf("s3 after_read tag requires tags")
+				}
+			default:
+				return fmt.Errorf("unsupported s3 after_read action: %s", a.Action)
+			}
+		}
+
+	case "redis":
+		if c.Source.URL == "" {
+			return fmt.Errorf("redis url is required")
+		}
+		if len(c.Source.Streams) == 0 {
+			return fmt.Errorf("redis streams are required")
+		}
+		if c.Source.GroupID == "" {
+			c.Source.GroupID = c.Name + "-consumer"
+		}
+		switch c.Source.StartOffset {
+		case "", "earliest", "latest":
+		default:
+			return fmt.Errorf("invalid start_offset: %s (expected earliest or latest)", c.Source.StartOffset)
+		}
+
+	case "generate":
+		if (c.Source.Mapping == "") == (c.Source.Schema == nil) {
+			return fmt.Errorf("generate requires exactly one of mapping or schema")
+		}
+		if c.Source.Count < 0 {
+			return fmt.Errorf("generate count must not be negative")
+		}
+
+	case "mongodb":
+		if c.Source.URI == "" {
+			return fmt.Errorf("mongodb uri is required")
+		}
+		if c.Source.Database == "" || c.Source.Collection == "" {
+			return fmt.Errorf("mongodb database and collection are required")
+		}
+
+	case "http_server":
+		if c.Source.Address == "" {
+			c.Source.Address = "0.0.0.0:8080"
+		}
+		if c.Source.Path == "" {
+			c.Source.Path = "/"
+		}
+		if auth := c.Source.IngestAuth; auth != nil {
+			if auth.Secret == "" {
+				return fmt.Errorf("ingest_auth secret is required")
+			}
+			switch auth.Type {
+			case "secret":
+			case "hmac":
+				switch auth.Algorithm {
+				case "", "sha256", "sha1", "sha512":
+				default:
+					return fmt.Errorf("unsupported ingest_auth algorithm: %s", auth.Algorithm)
+				}
+				switch auth.Encoding {
+				case "", "hex", "base64":
+				default:
+					return fmt.Errorf("unsupported ingest_auth encoding: %s", auth.Encoding)
+				}
+			default:
+				return fmt.Errorf("unsupported ingest_auth type: %s", auth.Type)
+			}
+		}
 
 	case "http", "rest_api":
 		if c.Source.URL == "" {
@@ -318,6 +739,30 @@ func (c *PipelineConfigV2) validateSource() error {
 		if c.Source.Method == "" {
 			c.Source.Method = "GET"
 		}
+		for i, st := range c.Source.State {
+			if st.Key == "" {
+				return fmt.Errorf("state[%d]: key is required", i)
+			}
+			if (st.Field == "") == (st.Cursor == "") {
+				return fmt.Errorf("state %s: exactly one of field or cursor is required", st.Key)
+			}
+		}
+		if p := c.Source.Pagination; p != nil {
+			switch p.Type {
+			case "", "next_url", "offset", "page", "link_header":
+			case "cursor":
+				if p.NextField == "" {
+					return fmt.Errorf("cursor pagination requires next_field")
+				}
+			default:
+				return fmt.Errorf("unsupported pagination type: %s", p.Type)
+			}
+			switch p.CursorIn {
+			case "", "query", "body":
+			default:
+				return fmt.Errorf("invalid pagination cursor_in: %s (expected query or body)", p.CursorIn)
+			}
+		}
 		if c.Source.Auth != nil {
 			if err := c.validateAuth(); err != nil {
 				return fmt.Errorf("auth: %w", err)
@@ -354,6 +799,35 @@ func (c *PipelineConfigV2) validateSource() error {
 		if c.Source.PollInterval <= 0 {
 			c.Source.PollInterval = 1000
 		}
+		if outbox := c.Source.Outbox; outbox != nil {
+			if outbox.OnAck == "" && outbox.ProcessedColumn != "" {
+				outbox.OnAck = "mark"
+			}
+			switch outbox.OnAck {
+			case "", "none", "delete":
+			case "mark":
+				if outbox.ProcessedColumn == "" {
+					return fmt.Errorf("sql_event outbox on_ack mark requires processed_column")
+				}
+			default:
+				return fmt.Errorf("invalid sql_event outbox on_ack: %s (expected delete, mark or none)", outbox.OnAck)
+			}
+			if outbox.MaxAttempts > 0 && outbox.AttemptsColumn == "" {
+				return fmt.Errorf("sql_event outbox max_attempts requires attempts_column")
+			}
+			switch outbox.DedupStorage {
+			case "", "memory":
+			case "redis":
+				if outbox.DedupRedisURL == "" {
+					return fmt.Errorf("sql_event outbox dedup_storage redis requires dedup_redis_url")
+				}
+			default:
+				return fmt.Errorf("invalid sql_event outbox dedup_storage: %s (expected memory or redis)", outbox.DedupStorage)
+			}
+			if outbox.Lookback > 0 && c.Source.TimestampColumn == "" {
+				return fmt.Errorf("sql_event outbox lookback requires timestamp_column")
+			}
+		}
 
 	case "cdc":
 		if c.Source.Driver == "" {
@@ -376,6 +850,18 @@ func (c *PipelineConfigV2) validateSource() error {
 		if c.Source.ServerID == 0 {
 			c.Source.ServerID = 101
 		}
+		switch c.Source.SnapshotMode {
+		case "", "never":
+		case "initial":
+			if c.Source.Driver != "mysql" {
+				return fmt.Errorf("snapshot_mode initial is only supported for mysql")
+			}
+			if len(c.Source.Tables) == 0 {
+				return fmt.Errorf("snapshot_mode initial requires tables")
+			}
+		default:
+			return fmt.Errorf("invalid snapshot_mode: %s (expected never or initial)", c.Source.SnapshotMode)
+		}
 
 	default:
 		return fmt.Errorf("unsupported source type: %s", c.Source.Type)
@@ -410,14 +896,39 @@ func (c *PipelineConfigV2) validateRealtime() error {
 		return fmt.Errorf("realtime config is required for realtime mode")
 	}
 
-	if c.Realtime.IDField == "" {
-		return fmt.Errorf("id_field is required for deduplication")
+	if len(c.Realtime.EventKeyFields()) == 0 {
+		return fmt.Errorf("id_field or id_fields is required for deduplication")
 	}
 
 	if c.Realtime.DedupStorage == "" {
 		c.Realtime.DedupStorage = "memory"
 	}
 
+	switch c.Realtime.DedupStorage {
+	case "memory", "lru", "bloom", "redis":
+	case "sql":
+		switch c.Realtime.DedupSQLDriver {
+		case "mysql", "postgres", "sqlite":
+		default:
+			return fmt.Errorf("invalid dedup_sql_driver: %q (must be mysql, postgres or sqlite)", c.Realtime.DedupSQLDriver)
+		}
+		if c.Realtime.DedupSQLDSN == "" {
+			return fmt.Errorf("dedup_sql_dsn is required for sql dedup_storage")
+		}
+	default:
+		return fmt.Errorf("invalid dedup_storage: %s (must be memory, lru, bloom, redis or sql)", c.Realtime.DedupStorage)
+	}
+
+	if c.Realtime.DedupMaxEntries < 0 {
+		return fmt.Errorf("dedup_max_entries must not be negative")
+	}
+	if c.Realtime.DedupBuckets < 0 {
+		return fmt.Errorf("dedup_buckets must not be negative")
+	}
+	if rate := c.Realtime.DedupFalsePositiveRate; rate < 0 || rate >= 1 {
+		return fmt.Errorf("dedup_false_positive_rate must be between 0 and 1: %v", rate)
+	}
+
 	if c.Realtime.DedupTTL == "" {
 		c.Realtime.DedupTTL = "24h"
 	}
@@ -427,6 +938,54 @@ func (c *PipelineConfigV2) validateRealtime() error {
 		return fmt.Errorf("invalid dedup_ttl format: %w", err)
 	}
 
+	if c.Realtime.VersionField != "" {
+		if len(c.Realtime.EntityKeyFields()) == 0 {
+			return fmt.Errorf("entity_id_field or entity_id_fields is required when version_field is set")
+		}
+		switch c.Realtime.DedupStorage {
+		case "memory", "redis", "sql":
+		default:
+			return fmt.Errorf("version_field requires memory, redis or sql dedup_storage, got %s", c.Realtime.DedupStorage)
+		}
+	}
+	if c.Realtime.TombstoneTTL != "" {
+		if _, err := time.ParseDuration(c.Realtime.TombstoneTTL); err != nil {
+			return fmt.Errorf("invalid tombstone_ttl format: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *StepV2) validateDedup() error {
+	d := s.Dedup
+	if d == nil {
+		return nil
+	}
+	if strings.Trim(d.Key, "[]. ") == "" {
+		return fmt.Errorf("dedup key is required")
+	}
+	window, err := time.ParseDuration(d.Window)
+	if err != nil {
+		return fmt.Errorf("invalid dedup window: %w", err)
+	}
+	if window <= 0 {
+		return fmt.Errorf("dedup window must be positive: %s", d.Window)
+	}
+	switch d.Keep {
+	case "", "first", "last":
+	default:
+		return fmt.Errorf("invalid dedup keep: %s (must be first or last)", d.Keep)
+	}
+	switch d.Storage {
+	case "", "memory", "lru", "bloom", "redis":
+	case "sql":
+		if d.SQLDriver == "" || d.SQLDSN == "" {
+			return fmt.Errorf("dedup sql_driver and sql_dsn are required for sql storage")
+		}
+	default:
+		return fmt.Errorf("invalid dedup storage: %s (must be memory, lru, bloom, redis or sql)", d.Storage)
+	}
 	return nil
 }
 
@@ -0,0 +1,198 @@
+package dedup
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"sync"
+	"time"
+)
+
+// bloomFilter 고정 크기 Bloom 필터 (동시성 보호는 호출자 책임)
+type bloomFilter struct {
+	bits   []uint64
+	m      uint64 // 비트 수
+	k      uint64 // 해시 함수 수
+	count  int64  // 추가된 항목 수 (중복 포함 안 함)
+	expiry time.Time
+}
+
+// newBloomFilter n개를 넣었을 때 오탐률이 p가 되도록 크기 결정
+func newBloomFilter(n int, p float64) *bloomFilter {
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &bloomFilter{bits: make([]uint64, (m+63)/64), m: m, k: k}
+}
+
+// bloomHashes 이중 해싱용 해시 두 개 (h1 + i*h2)
+func bloomHashes(key string) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	h1 := h.Sum64()
+	h2 := fnv.New64()
+	_, _ = h2.Write([]byte(key))
+	return h1, h2.Sum64() | 1
+}
+
+func (f *bloomFilter) test(h1, h2 uint64) bool {
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// add 항목 추가 (이미 있던 것으로 보이면 false)
+func (f *bloomFilter) add(h1, h2 uint64) bool {
+	added := false
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			f.bits[bit/64] |= 1 << (bit % 64)
+			added = true
+		}
+	}
+	if added {
+		f.count++
+	}
+	return added
+}
+
+// falsePositiveRate 현재 채워진 정도로 추정한 오탐률
+func (f *bloomFilter) falsePositiveRate() float64 {
+	return math.Pow(1-math.Exp(-float64(f.k)*float64(f.count)/float64(f.m)), float64(f.k))
+}
+
+// BloomDedupService 시간 버킷으로 회전하는 Bloom 필터 기반 중복 제거
+// TTL을 Buckets개 구간으로 나눠 구간마다 새 필터에 ID를 넣고, 조회는 보관 중인 모든 필터를 확인한다.
+// 가장 오래된 필터는 TTL이 지나면 통째로 버려지므로 메모리는 MaxEntries와 오탐률로 고정된다.
+// 오탐(처리하지 않은 ID를 중복으로 판정)은 FalsePositiveRate 이하로 유지되며 누락된 중복은 없다.
+// 엔티티 존재 여부는 삭제가 필요하므로 정확한 LRU(MaxEntries)로 관리한다
+type BloomDedupService struct {
+	filters  []*bloomFilter // 앞쪽이 오래된 필터, 마지막이 현재 필터
+	span     time.Duration  // 필터 하나가 ID를 받는 기간
+	keep     int            // 보관할 필터 수
+	capacity int            // 필터당 예상 ID 수
+	fpRate   float64        // 필터당 오탐률
+	entities *lruSet
+	counter  lookupCounter
+	now      func() time.Time
+	mu       sync.Mutex
+}
+
+// NewBloomDedupService Bloom 필터 기반 서비스 생성
+// expectedItems는 TTL 동안 들어올 것으로 예상하는 ID 수, falsePositiveRate는 전체 오탐률
+func NewBloomDedupService(expectedItems int, falsePositiveRate float64, ttl time.Duration, buckets int) *BloomDedupService {
+	// 보관 중인 모든 필터(buckets+1개)를 조회하므로 필터당 오탐률을 나눠 배정
+	keep := buckets + 1
+	s := &BloomDedupService{
+		span:     ttl / time.Duration(buckets),
+		keep:     keep,
+		capacity: int(math.Ceil(float64(expectedItems) / float64(buckets))),
+		fpRate:   falsePositiveRate / float64(keep),
+		entities: newLRUSet(expectedItems, 0),
+		now:      time.Now,
+	}
+	if s.span <= 0 {
+		s.span = time.Nanosecond
+	}
+	return s
+}
+
+// rotate 현재 필터 기간이 지났으면 새 필터를 만들고 오래된 필터 제거
+// 필터 하나는 span 동안 ID를 받고 keep개를 보관하므로 ID는 최소 TTL 동안 남는다
+func (s *BloomDedupService) rotate(now time.Time) {
+	if n := len(s.filters); n > 0 && now.Before(s.filters[n-1].expiry) {
+		return
+	}
+	f := newBloomFilter(s.capacity, s.fpRate)
+	f.expiry = now.Add(s.span)
+	s.filters = append(s.filters, f)
+
+	// 마지막 필터 기간이 끝난 뒤 오래 지났으면 보관 기간이 지난 필터도 함께 정리
+	cutoff := now.Add(-time.Duration(s.keep-1) * s.span)
+	for len(s.filters) > 1 && (len(s.filters) > s.keep || s.filters[0].expiry.Before(cutoff)) {
+		s.filters = s.filters[1:]
+	}
+}
+
+func (s *BloomDedupService) IsDuplicate(ctx context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rotate(s.now())
+	h1, h2 := bloomHashes(eventID)
+	dup := false
+	for _, f := range s.filters {
+		if f.test(h1, h2) {
+			dup = true
+			break
+		}
+	}
+	s.counter.record(dup)
+	return dup, nil
+}
+
+func (s *BloomDedupService) MarkProcessed(ctx context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rotate(s.now())
+	h1, h2 := bloomHashes(eventID)
+	s.filters[len(s.filters)-1].add(h1, h2)
+	return nil
+}
+
+func (s *BloomDedupService) EntityExists(ctx context.Context, entityID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.entities.contains(entityID, s.now()), nil
+}
+
+func (s *BloomDedupService) SetEntityExists(ctx context.Context, entityID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entities.add(entityID, s.now())
+	return nil
+}
+
+func (s *BloomDedupService) DeleteEntity(ctx context.Context, entityID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entities.delete(entityID)
+	return nil
+}
+
+// Stats 현재 통계 (Entries는 보관 중인 필터에 추가된 ID 수, 중복 ID와 오탐으로 빠진 ID는 세지 않음)
+func (s *BloomDedupService) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.counter.stats(StorageBloom)
+	notFalse := 1.0
+	for _, f := range s.filters {
+		stats.Entries += f.count
+		stats.MemoryBytes += int64(len(f.bits) * 8)
+		notFalse *= 1 - f.falsePositiveRate()
+	}
+	stats.FalsePositiveRate = 1 - notFalse
+	stats.Entities = int64(s.entities.len())
+	stats.Evictions = s.entities.evictions
+	stats.MemoryBytes += s.entities.memoryBytes()
+	return stats
+}
+
+func (s *BloomDedupService) Close() error {
+	return nil
+}
@@ -0,0 +1,107 @@
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBloomDedupServiceFalsePositiveRate(t *testing.T) {
+	ctx := context.Background()
+	const n = 10000
+	s := NewBloomDedupService(n, 0.01, time.Hour, 4)
+
+	for i := 0; i < n/4; i++ {
+		_ = s.MarkProcessed(ctx, fmt.Sprintf("seen-%d", i))
+	}
+	for i := 0; i < n/4; i++ {
+		if dup, _ := s.IsDuplicate(ctx, fmt.Sprintf("seen-%d", i)); !dup {
+			t.Fatalf("seen-%d not detected", i)
+		}
+	}
+
+	falsePositives := 0
+	for i := 0; i < n; i++ {
+		if dup, _ := s.IsDuplicate(ctx, fmt.Sprintf("new-%d", i)); dup {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / n; rate > 0.01 {
+		t.Errorf("false positive rate = %v", rate)
+	}
+
+	stats := s.Stats()
+	if stats.Storage != StorageBloom || stats.Entries < n/4-10 || stats.Lookups != n+n/4 {
+		t.Errorf("stats = %+v", stats)
+	}
+	if stats.FalsePositiveRate <= 0 || stats.FalsePositiveRate > 0.01 {
+		t.Errorf("estimated false positive rate = %v", stats.FalsePositiveRate)
+	}
+	if stats.MemoryBytes <= 0 {
+		t.Errorf("memory = %d", stats.MemoryBytes)
+	}
+}
+
+func TestBloomDedupServiceRotation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewBloomDedupService(1000, 0.001, 4*time.Minute, 4)
+	s.now = func() time.Time { return now }
+
+	_ = s.MarkProcessed(ctx, "a")
+
+	// TTL 동안은 버킷이 회전해도 유지
+	for i := 0; i < 4; i++ {
+		now = now.Add(time.Minute)
+		if dup, _ := s.IsDuplicate(ctx, "a"); !dup {
+			t.Fatalf("expected duplicate after %d minutes", i+1)
+		}
+	}
+	if len(s.filters) > s.keep {
+		t.Errorf("filters = %d, keep = %d", len(s.filters), s.keep)
+	}
+
+	// 마지막 버킷까지 지나면 제거
+	now = now.Add(time.Minute)
+	if dup, _ := s.IsDuplicate(ctx, "a"); dup {
+		t.Error("expected expiry after ttl")
+	}
+
+	// 오래 비어 있다가 들어오면 오래된 필터를 한 번에 정리
+	now = now.Add(time.Hour)
+	_, _ = s.IsDuplicate(ctx, "b")
+	if len(s.filters) != 1 {
+		t.Errorf("filters = %d", len(s.filters))
+	}
+}
+
+func TestNewOptions(t *testing.T) {
+	tests := []struct {
+		opts    Options
+		want    string
+		wantErr bool
+	}{
+		{Options{}, StorageMemory, false},
+		{Options{Storage: StorageLRU}, StorageLRU, false},
+		{Options{Storage: StorageBloom}, StorageBloom, false},
+		{Options{Storage: StorageBloom, FalsePositiveRate: 1.5}, "", true},
+		{Options{Storage: StorageSQL, SQLDriver: "sqlite", SQLDSN: ":memory:"}, StorageSQL, false},
+		{Options{Storage: StorageSQL}, "", true},
+		{Options{Storage: "disk"}, "", true},
+	}
+	for _, tt := range tests {
+		svc, err := New(tt.opts)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("New(%+v) error = %v", tt.opts, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := svc.(StatsReporter).Stats().Storage; got != tt.want {
+			t.Errorf("New(%+v) storage = %s, want %s", tt.opts, got, tt.want)
+		}
+		_ = svc.Close()
+	}
+}
@@ -4,6 +4,7 @@ package dedup
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 )
@@ -38,11 +39,64 @@ type DedupService interface {
 	Close() error
 }
 
+// 중복 제거 저장소 종류 (RealtimeConfig.DedupStorage)
+const (
+	StorageMemory = "memory" // 무제한 맵 (개발/테스트용)
+	StorageLRU    = "lru"    // 최대 항목 수가 정해진 정확한 LRU
+	StorageBloom  = "bloom"  // 시간 버킷으로 회전하는 Bloom 필터 (오탐 허용)
+	StorageRedis  = "redis"
+	StorageSQL    = "sql" // mysql, postgres, sqlite 테이블 (엔티티 상태 영구 보관)
+)
+
+// Stats 중복 제거 저장소 통계
+type Stats struct {
+	Storage           string  `json:"storage"`
+	Lookups           int64   `json:"lookups"`             // IsDuplicate 호출 수
+	Hits              int64   `json:"hits"`                // 중복으로 판정된 수
+	HitRate           float64 `json:"hit_rate"`            // Hits / Lookups
+	Entries           int64   `json:"entries"`             // 보관 중인 이벤트 ID 수
+	Entities          int64   `json:"entities"`            // 보관 중인 엔티티 수
+	Tombstones        int64   `json:"tombstones"`          // 보관 중인 삭제 툼스톤 수
+	Evictions         int64   `json:"evictions"`           // 용량 초과로 제거된 항목 수
+	MemoryBytes       int64   `json:"memory_bytes"`        // 추정 메모리 사용량
+	FalsePositiveRate float64 `json:"false_positive_rate"` // 추정 오탐률 (bloom만 해당)
+}
+
+// StatsReporter 통계를 제공하는 중복 제거 서비스
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// lookupCounter IsDuplicate 조회/적중 카운터 (동시성 보호는 호출자 책임)
+type lookupCounter struct {
+	lookups int64
+	hits    int64
+}
+
+func (c *lookupCounter) record(dup bool) {
+	c.lookups++
+	if dup {
+		c.hits++
+	}
+}
+
+func (c *lookupCounter) stats(storage string) Stats {
+	stats := Stats{Storage: storage, Lookups: c.lookups, Hits: c.hits}
+	if c.lookups > 0 {
+		stats.HitRate = float64(c.hits) / float64(c.lookups)
+	}
+	return stats
+}
+
 // MemoryDedupService 메모리 기반 중복 제거 (개발/테스트용)
+// 엔티티 버전도 TTL이 지나면 만료되므로 오래된 이벤트는 TTL 안에 도착한 경우에만 걸러진다
 type MemoryDedupService struct {
 	processedIDs map[string]time.Time
 	entities     map[string]bool
+	versions     map[string]entityVersion
 	ttl          time.Duration
+	tombstoneTTL time.Duration
+	counter      lookupCounter
 	mu           sync.RWMutex
 	cleanupStop  chan struct{}
 }
@@ -52,7 +106,9 @@ func NewMemoryDedupService(ttl time.Duration) *MemoryDedupService {
 	s := &MemoryDedupService{
 		processedIDs: make(map[string]time.Time),
 		entities:     make(map[string]bool),
+		versions:     make(map[string]entityVersion),
 		ttl:          ttl,
+		tombstoneTTL: ttl,
 		cleanupStop:  make(chan struct{}),
 	}
 
@@ -86,13 +142,29 @@ func (s *MemoryDedupService) cleanup() {
 			delete(s.processedIDs, id)
 		}
 	}
+	for id, v := range s.versions {
+		if s.versionExpired(v, now) {
+			delete(s.versions, id)
+		}
+	}
+}
+
+// versionExpired 툼스톤은 tombstoneTTL, 나머지 버전은 TTL이 지나면 만료
+func (s *MemoryDedupService) versionExpired(v entityVersion, now time.Time) bool {
+	if v.deleted {
+		return now.Sub(v.deletedAt) > s.tombstoneTTL
+	}
+	return now.Sub(v.appliedAt) > s.ttl
 }
 
 func (s *MemoryDedupService) IsDuplicate(ctx context.Context, eventID string) (bool, error) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	_, exists := s.processedIDs[eventID]
+	// 정리 주기 전이라도 TTL이 지난 ID는 중복으로 보지 않음
+	ts, exists := s.processedIDs[eventID]
+	exists = exists && time.Since(ts) <= s.ttl
+	s.counter.record(exists)
 	return exists, nil
 }
 
@@ -127,87 +199,164 @@ func (s *MemoryDedupService) DeleteEntity(ctx context.Context, entityID string)
 	return nil
 }
 
-func (s *MemoryDedupService) Close() error {
-	close(s.cleanupStop)
-	return nil
-}
-
-// RedisDedupService Redis 기반 중복 제거 (프로덕션용)
-type RedisDedupService struct {
-	prefix string
-	ttl    time.Duration
-	// client *redis.Client // 실제 구현 시 redis 클라이언트 사용
+// isStale 만료되지 않은 마지막 적용 버전 기준으로 판정 (호출자가 잠금 보유)
+func (s *MemoryDedupService) isStale(entityID string, version int64, eventType EventType) bool {
+	last, ok := s.versions[entityID]
+	if !ok || s.versionExpired(last, time.Now()) {
+		return false
+	}
+	return last.staleAgainst(version, eventType)
 }
 
-// NewRedisDedupService Redis 기반 서비스 생성
-func NewRedisDedupService(addr, prefix string, ttl time.Duration) (*RedisDedupService, error) {
-	// TODO: Redis 클라이언트 초기화
-	// client := redis.NewClient(&redis.Options{
-	//     Addr: addr,
-	// })
+func (s *MemoryDedupService) IsStale(ctx context.Context, entityID string, version int64, eventType EventType) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	return &RedisDedupService{
-		prefix: prefix,
-		ttl:    ttl,
-	}, nil
+	return s.isStale(entityID, version, eventType), nil
 }
 
-//nolint:unused
-func (s *RedisDedupService) eventKey(eventID string) string {
-	return fmt.Sprintf("%s:event:%s", s.prefix, eventID)
-}
+func (s *MemoryDedupService) ApplyVersion(ctx context.Context, entityID string, version int64, eventType EventType) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-//nolint:unused
-func (s *RedisDedupService) entityKey(entityID string) string {
-	return fmt.Sprintf("%s:entity:%s", s.prefix, entityID)
+	if s.isStale(entityID, version, eventType) {
+		return nil
+	}
+	v := entityVersion{version: version, appliedAt: time.Now()}
+	if eventType == EventDelete {
+		v.deleted = true
+		v.deletedAt = v.appliedAt
+	}
+	s.versions[entityID] = v
+	return nil
 }
 
-func (s *RedisDedupService) IsDuplicate(ctx context.Context, eventID string) (bool, error) {
-	// TODO: Redis EXISTS 명령
-	// exists, err := s.client.Exists(ctx, s.eventKey(eventID)).Result()
-	// return exists > 0, err
-	return false, nil
+// Stats 현재 통계
+func (s *MemoryDedupService) Stats() Stats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := s.counter.stats(StorageMemory)
+	stats.Entries = int64(len(s.processedIDs))
+	stats.Entities = int64(len(s.entities))
+	for id := range s.processedIDs {
+		stats.MemoryBytes += int64(len(id)) + lruEntryOverhead/2
+	}
+	for id := range s.entities {
+		stats.MemoryBytes += int64(len(id)) + lruEntryOverhead/2
+	}
+	for id, v := range s.versions {
+		stats.MemoryBytes += int64(len(id)) + lruEntryOverhead/2
+		if v.deleted {
+			stats.Tombstones++
+		}
+	}
+	return stats
 }
 
-func (s *RedisDedupService) MarkProcessed(ctx context.Context, eventID string) error {
-	// TODO: Redis SETEX 명령
-	// return s.client.SetEx(ctx, s.eventKey(eventID), "1", s.ttl).Err()
+func (s *MemoryDedupService) Close() error {
+	close(s.cleanupStop)
 	return nil
 }
 
-func (s *RedisDedupService) EntityExists(ctx context.Context, entityID string) (bool, error) {
-	// TODO: Redis EXISTS 명령
-	// exists, err := s.client.Exists(ctx, s.entityKey(entityID)).Result()
-	// return exists > 0, err
-	return false, nil
+// Options 중복 제거 서비스 생성 옵션
+type Options struct {
+	Storage           string        // memory, lru, bloom, redis
+	TTL               time.Duration // 처리된 이벤트 ID 보관 기간 (기본: 24h)
+	MaxEntries        int           // lru: 최대 항목 수, bloom: TTL 동안 예상 ID 수 (기본: 1,000,000)
+	FalsePositiveRate float64       // bloom 오탐률 (기본: 0.001)
+	Buckets           int           // bloom 시간 버킷 수 (기본: 4)
+	TombstoneTTL      time.Duration // 삭제 툼스톤 보관 기간 (기본: TTL)
+	RedisURL          string        // redis 주소 (host:port 또는 redis:// URL, 기본: localhost:6379)
+	SQLDriver         string        // sql 드라이버 (mysql, postgres, sqlite)
+	SQLDSN            string        // sql 접속 문자열
+	SQLTablePrefix    string        // sql 테이블 이름 접두사 (기본: conduix_dedup, Namespace가 있으면 conduix_dedup_<namespace>)
+
+	// Namespace 저장소를 공유하는 파이프라인/단계끼리 키가 섞이지 않도록 구분하는 이름 (NamespaceOf로 생성)
+	// redis 키 접두사(dedup:<namespace>)와 sql 테이블 접두사 기본값에 쓰인다
+	Namespace string
 }
 
-func (s *RedisDedupService) SetEntityExists(ctx context.Context, entityID string) error {
-	// TODO: Redis SET 명령 (TTL 없음 - 영구 저장)
-	// return s.client.Set(ctx, s.entityKey(entityID), "1", 0).Err()
-	return nil
+// NamespaceOf 파이프라인 ID, 단계 이름 등을 이어 Options.Namespace 값 생성 (빈 값은 제외)
+func NamespaceOf(parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+	return strings.Join(nonEmpty, ":")
 }
 
-func (s *RedisDedupService) DeleteEntity(ctx context.Context, entityID string) error {
-	// TODO: Redis DEL 명령
-	// return s.client.Del(ctx, s.entityKey(entityID)).Err()
-	return nil
-}
+// 옵션 기본값
+const (
+	DefaultTTL               = 24 * time.Hour
+	DefaultMaxEntries        = 1_000_000
+	DefaultFalsePositiveRate = 0.001
+	DefaultBuckets           = 4
+)
 
-func (s *RedisDedupService) Close() error {
-	// TODO: Redis 연결 종료
-	// return s.client.Close()
-	return nil
-}
+// New 옵션에 따라 적절한 서비스 생성
+func New(opts Options) (DedupService, error) {
+	if opts.TTL <= 0 {
+		opts.TTL = DefaultTTL
+	}
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = DefaultMaxEntries
+	}
+	if opts.FalsePositiveRate == 0 {
+		opts.FalsePositiveRate = DefaultFalsePositiveRate
+	}
+	if opts.Buckets <= 0 {
+		opts.Buckets = DefaultBuckets
+	}
+	if opts.TombstoneTTL <= 0 {
+		opts.TombstoneTTL = opts.TTL
+	}
+	if opts.RedisURL == "" {
+		opts.RedisURL = "localhost:6379"
+	}
 
-// NewDedupService 설정에 따라 적절한 서비스 생성
-func NewDedupService(storage string, ttl time.Duration) (DedupService, error) {
-	switch storage {
-	case "memory", "":
-		return NewMemoryDedupService(ttl), nil
-	case "redis":
-		return NewRedisDedupService("localhost:6379", "dedup", ttl)
+	switch opts.Storage {
+	case StorageMemory, "":
+		svc := NewMemoryDedupService(opts.TTL)
+		svc.tombstoneTTL = opts.TombstoneTTL
+		return svc, nil
+	case StorageLRU:
+		return NewLRUDedupService(opts.MaxEntries, opts.TTL), nil
+	case StorageBloom:
+		if opts.FalsePositiveRate <= 0 || opts.FalsePositiveRate >= 1 {
+			return nil, fmt.Errorf("bloom false positive rate must be between 0 and 1: %v", opts.FalsePositiveRate)
+		}
+		return NewBloomDedupService(opts.MaxEntries, opts.FalsePositiveRate, opts.TTL, opts.Buckets), nil
+	case StorageRedis:
+		prefix := "dedup"
+		if opts.Namespace != "" {
+			prefix += ":" + opts.Namespace
+		}
+		svc, err := NewRedisDedupService(opts.RedisURL, prefix, opts.TTL)
+		if err != nil {
+			return nil, err
+		}
+		svc.tombstoneTTL = opts.TombstoneTTL
+		return svc, nil
+	case StorageSQL:
+		prefix := opts.SQLTablePrefix
+		if prefix == "" && opts.Namespace != "" {
+			prefix = namespaceTablePrefix(opts.Namespace)
+		}
+		svc, err := NewSQLDedupService(opts.SQLDriver, opts.SQLDSN, prefix, opts.TTL)
+		if err != nil {
+			return nil, err
+		}
+		svc.tombstoneTTL = opts.TombstoneTTL
+		return svc, nil
 	default:
-		return nil, fmt.Errorf("unsupported dedup storage: %s", storage)
+		return nil, fmt.Errorf("unsupported dedup storage: %s", opts.Storage)
 	}
 }
+
+// NewDedupService 저장소 종류와 TTL만으로 서비스 생성 (나머지 옵션은 기본값)
+func NewDedupService(storage string, ttl time.Duration) (DedupService, error) {
+	return New(Options{Storage: storage, TTL: ttl})
+}
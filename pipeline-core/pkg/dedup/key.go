@@ -0,0 +1,161 @@
+package dedup
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KeyBuilder 레코드의 하나 이상의 필드로 이벤트/엔티티 키 생성
+// 필드는 "customer.id"처럼 점으로 중첩 경로를 지정할 수 있고, 값은 CanonicalKey로 인코딩하므로
+// 같은 키는 저장소(memory, redis 등)와 소스(JSON 숫자, SQL 정수, CSV 문자열)에 관계없이 같은 문자열이 된다
+type KeyBuilder struct {
+	fields []string
+}
+
+// NewKeyBuilder 키 필드 목록으로 생성 (빈 필드명은 무시)
+func NewKeyBuilder(fields ...string) *KeyBuilder {
+	b := &KeyBuilder{}
+	for _, f := range fields {
+		if f != "" {
+			b.fields = append(b.fields, f)
+		}
+	}
+	return b
+}
+
+// Fields 키 필드 목록
+func (b *KeyBuilder) Fields() []string {
+	if b == nil {
+		return nil
+	}
+	return b.fields
+}
+
+// Key 레코드의 키 (필드가 없거나 키 필드 중 하나라도 없거나 null이면 false)
+func (b *KeyBuilder) Key(data map[string]any) (string, bool) {
+	if b == nil || len(b.fields) == 0 {
+		return "", false
+	}
+	values := make([]any, len(b.fields))
+	for i, f := range b.fields {
+		v, ok := lookupField(data, f)
+		if !ok || v == nil {
+			return "", false
+		}
+		values[i] = v
+	}
+	return CanonicalKey(values...), true
+}
+
+// CanonicalKey 값 목록을 안정적인 키 문자열로 인코딩
+// 숫자는 타입과 관계없이 같은 10진 표기(42, int64(42), 42.0, "42" 모두 "42"),
+// 시각은 UTC RFC3339Nano, 객체/배열은 키가 정렬된 JSON으로 바꾼다.
+// 값마다 "\"와 "|"를 이스케이프한 뒤 "|"로 연결하므로, 값 하나("a|b" → "a\|b")와
+// 복합 키("a", "b" → "a|b")가 같은 문자열이 되지 않는다
+func CanonicalKey(values ...any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = keyEscaper.Replace(canonicalValue(v))
+	}
+	return strings.Join(parts, "|")
+}
+
+var keyEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`)
+
+// jsonNumberPattern JSON 숫자 문법 (선행 0, +, 16진수는 문자열로 유지)
+var jsonNumberPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+func canonicalValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		if jsonNumberPattern.MatchString(val) {
+			return canonicalNumber(val)
+		}
+		return val
+	case json.Number:
+		return canonicalNumber(string(val))
+	case bool:
+		return strconv.FormatBool(val)
+	case int:
+		return strconv.FormatInt(int64(val), 10)
+	case int8:
+		return strconv.FormatInt(int64(val), 10)
+	case int16:
+		return strconv.FormatInt(int64(val), 10)
+	case int32:
+		return strconv.FormatInt(int64(val), 10)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case uint:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint8:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint16:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint64:
+		return strconv.FormatUint(val, 10)
+	case float32:
+		return canonicalFloat(float64(val))
+	case float64:
+		return canonicalFloat(val)
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	case []byte:
+		return string(val)
+	default:
+		// encoding/json은 map 키를 정렬하므로 같은 내용이면 같은 문자열
+		if b, err := json.Marshal(val); err == nil {
+			return string(b)
+		}
+		return fmt.Sprint(val)
+	}
+}
+
+// canonicalNumber 숫자 문자열 정규화 (정수는 정밀도 손실 없이 그대로)
+func canonicalNumber(s string) string {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return strconv.FormatInt(n, 10)
+	}
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return strconv.FormatUint(n, 10)
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return canonicalFloat(f)
+	}
+	return s
+}
+
+// canonicalFloat 정수 값은 정수로, 나머지는 가장 짧은 표기로
+func canonicalFloat(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// lookupField 점으로 구분된 중첩 경로의 값
+func lookupField(data map[string]any, path string) (any, bool) {
+	if v, ok := data[path]; ok {
+		return v, true
+	}
+	current := any(data)
+	for _, part := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
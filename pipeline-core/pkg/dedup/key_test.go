@@ -0,0 +1,106 @@
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCanonicalKey(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 21, 0, 0, 0, time.FixedZone("KST", 9*3600))
+	tests := []struct {
+		name   string
+		values []any
+		want   string
+	}{
+		{"int", []any{42}, "42"},
+		{"int64", []any{int64(42)}, "42"},
+		{"uint32", []any{uint32(42)}, "42"},
+		{"float", []any{42.0}, "42"},
+		{"numeric string", []any{"42"}, "42"},
+		{"json number", []any{json.Number("42")}, "42"},
+		{"exponent string", []any{"4.2e1"}, "42"},
+		{"fraction", []any{1.5}, "1.5"},
+		{"leading zero kept", []any{"042"}, "042"},
+		{"plain string", []any{"abc"}, "abc"},
+		{"single escaped", []any{`a|b\`}, `a\|b\\`},
+		{"large int", []any{"9007199254740993"}, "9007199254740993"},
+		{"bool", []any{true}, "true"},
+		{"time in utc", []any{ts}, "2024-05-01T12:00:00Z"},
+		{"object", []any{map[string]any{"b": 1, "a": 2}}, `{"a":2,"b":1}`},
+		{"composite", []any{1, "a"}, "1|a"},
+		{"composite mixed types", []any{"1", 2.0}, "1|2"},
+		{"composite escaped", []any{"a|b", `c\`}, `a\|b|c\\`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanonicalKey(tt.values...); got != tt.want {
+				t.Errorf("CanonicalKey(%v) = %q, want %q", tt.values, got, tt.want)
+			}
+		})
+	}
+
+	// 구분자가 값에 들어 있어도 경계가 섞이지 않아야 함
+	if CanonicalKey("a|b", "c") == CanonicalKey("a", "b|c") {
+		t.Error("escaped composite keys collide")
+	}
+	if CanonicalKey("a|b") == CanonicalKey("a", "b") {
+		t.Error("single key collides with composite key")
+	}
+}
+
+func TestKeyBuilder(t *testing.T) {
+	b := NewKeyBuilder("tenant", "customer.id", "")
+	if got := b.Fields(); len(got) != 2 {
+		t.Fatalf("Fields() = %v, want empty names dropped", got)
+	}
+
+	tests := []struct {
+		name   string
+		data   map[string]any
+		want   string
+		wantOK bool
+	}{
+		{"nested", map[string]any{"tenant": "t1", "customer": map[string]any{"id": 7}}, "t1|7", true},
+		{"nested from json", map[string]any{"tenant": "t1", "customer": map[string]any{"id": float64(7)}}, "t1|7", true},
+		{"flattened key", map[string]any{"tenant": "t1", "customer.id": "7"}, "t1|7", true},
+		{"missing field", map[string]any{"tenant": "t1"}, "", false},
+		{"null field", map[string]any{"tenant": "t1", "customer": map[string]any{"id": nil}}, "", false},
+		{"not an object", map[string]any{"tenant": "t1", "customer": "c"}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := b.Key(tt.data)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Key() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	var empty *KeyBuilder
+	if _, ok := empty.Key(map[string]any{"id": 1}); ok {
+		t.Error("nil builder should not produce a key")
+	}
+}
+
+// 소스마다 타입이 달라도 같은 키가 되어 저장소에 관계없이 중복으로 판정되어야 함
+func TestKeyBuilderSharedAcrossStorages(t *testing.T) {
+	ctx := context.Background()
+	redis, _ := newTestRedisDedup(t)
+	memory := NewMemoryDedupService(time.Hour)
+	defer memory.Close()
+
+	b := NewKeyBuilder("order.id", "line")
+	first, _ := b.Key(map[string]any{"order": map[string]any{"id": int64(10)}, "line": 1})
+	second, _ := b.Key(map[string]any{"order": map[string]any{"id": "10"}, "line": json.Number("1")})
+
+	for name, svc := range map[string]DedupService{"memory": memory, "redis": redis} {
+		if err := svc.MarkProcessed(ctx, first); err != nil {
+			t.Fatal(err)
+		}
+		if dup, err := svc.IsDuplicate(ctx, second); err != nil || !dup {
+			t.Errorf("%s: IsDuplicate(%q) = %v, %v, want true", name, second, dup, err)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package dedup
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// lruEntryOverhead 키 외에 LRU 항목 하나가 차지하는 대략적인 바이트 수 (map 버킷, list 요소, 시각)
+const lruEntryOverhead = 112
+
+// lruSet 최대 항목 수와 TTL이 있는 LRU 집합 (동시성 보호는 호출자 책임)
+type lruSet struct {
+	maxEntries int
+	ttl        time.Duration // 0이면 만료 없음
+	items      map[string]*list.Element
+	order      *list.List // 앞쪽이 최근 사용
+	keyBytes   int64
+	evictions  int64
+}
+
+type lruItem struct {
+	key       string
+	expiresAt time.Time
+}
+
+func newLRUSet(maxEntries int, ttl time.Duration) *lruSet {
+	return &lruSet{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		items:      make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// contains 만료되지 않은 키가 있는지 확인 (최근 사용으로 갱신하지 않음)
+func (l *lruSet) contains(key string, now time.Time) bool {
+	elem, ok := l.items[key]
+	if !ok {
+		return false
+	}
+	if item := elem.Value.(*lruItem); l.ttl > 0 && now.After(item.expiresAt) {
+		l.remove(elem)
+		return false
+	}
+	return true
+}
+
+// add 키 추가 또는 갱신, 용량을 넘으면 가장 오래 사용하지 않은 키 제거
+func (l *lruSet) add(key string, now time.Time) {
+	expiresAt := now.Add(l.ttl)
+	if elem, ok := l.items[key]; ok {
+		elem.Value.(*lruItem).expiresAt = expiresAt
+		l.order.MoveToFront(elem)
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&lruItem{key: key, expiresAt: expiresAt})
+	l.keyBytes += int64(len(key))
+
+	for l.maxEntries > 0 && l.order.Len() > l.maxEntries {
+		oldest := l.order.Back()
+		// 만료된 항목은 용량 초과로 밀려난 것으로 세지 않음
+		if item := oldest.Value.(*lruItem); l.ttl == 0 || !now.After(item.expiresAt) {
+			l.evictions++
+		}
+		l.remove(oldest)
+	}
+}
+
+func (l *lruSet) delete(key string) {
+	if elem, ok := l.items[key]; ok {
+		l.remove(elem)
+	}
+}
+
+func (l *lruSet) remove(elem *list.Element) {
+	item := elem.Value.(*lruItem)
+	l.order.Remove(elem)
+	delete(l.items, item.key)
+	l.keyBytes -= int64(len(item.key))
+}
+
+func (l *lruSet) len() int {
+	return l.order.Len()
+}
+
+func (l *lruSet) memoryBytes() int64 {
+	return l.keyBytes + int64(l.order.Len())*lruEntryOverhead
+}
+
+// LRUDedupService 최대 항목 수가 정해진 정확한 LRU 기반 중복 제거
+// 처리된 ID와 엔티티를 각각 MaxEntries까지 보관하며, 넘치면 가장 오래 사용하지 않은 항목부터 제거한다.
+// 제거된 ID가 다시 들어오면 중복으로 판정하지 못하므로 MaxEntries는 TTL 동안의 ID 수보다 크게 잡는다
+type LRUDedupService struct {
+	events   *lruSet
+	entities *lruSet
+	counter  lookupCounter
+	now      func() time.Time
+	mu       sync.Mutex
+}
+
+// NewLRUDedupService LRU 기반 서비스 생성
+func NewLRUDedupService(maxEntries int, ttl time.Duration) *LRUDedupService {
+	return &LRUDedupService{
+		events:   newLRUSet(maxEntries, ttl),
+		entities: newLRUSet(maxEntries, 0),
+		now:      time.Now,
+	}
+}
+
+func (s *LRUDedupService) IsDuplicate(ctx context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dup := s.events.contains(eventID, s.now())
+	s.counter.record(dup)
+	return dup, nil
+}
+
+func (s *LRUDedupService) MarkProcessed(ctx context.Context, eventID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events.add(eventID, s.now())
+	return nil
+}
+
+func (s *LRUDedupService) EntityExists(ctx context.Context, entityID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.entities.contains(entityID, s.now()), nil
+}
+
+func (s *LRUDedupService) SetEntityExists(ctx context.Context, entityID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entities.add(entityID, s.now())
+	return nil
+}
+
+func (s *LRUDedupService) DeleteEntity(ctx context.Context, entityID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entities.delete(entityID)
+	return nil
+}
+
+// Stats 현재 통계
+func (s *LRUDedupService) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := s.counter.stats(StorageLRU)
+	stats.Entries = int64(s.events.len())
+	stats.Entities = int64(s.entities.len())
+	stats.Evictions = s.events.evictions + s.entities.evictions
+	stats.MemoryBytes = s.events.memoryBytes() + s.entities.memoryBytes()
+	return stats
+}
+
+func (s *LRUDedupService) Close() error {
+	return nil
+}
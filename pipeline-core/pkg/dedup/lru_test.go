@@ -0,0 +1,77 @@
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLRUDedupServiceEviction(t *testing.T) {
+	ctx := context.Background()
+	s := NewLRUDedupService(2, time.Hour)
+
+	for _, id := range []string{"a", "b", "c"} {
+		if err := s.MarkProcessed(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"a", false}, // 용량 초과로 제거됨
+		{"b", true},
+		{"c", true},
+	}
+	for _, tt := range tests {
+		if dup, _ := s.IsDuplicate(ctx, tt.id); dup != tt.want {
+			t.Errorf("IsDuplicate(%q) = %v, want %v", tt.id, dup, tt.want)
+		}
+	}
+
+	stats := s.Stats()
+	if stats.Storage != StorageLRU || stats.Entries != 2 || stats.Evictions != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+	if stats.Lookups != 3 || stats.Hits != 2 || stats.HitRate != 2.0/3.0 {
+		t.Errorf("hit stats = %+v", stats)
+	}
+	if stats.MemoryBytes <= 0 {
+		t.Errorf("memory = %d", stats.MemoryBytes)
+	}
+}
+
+func TestLRUDedupServiceTTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewLRUDedupService(10, time.Minute)
+	s.now = func() time.Time { return now }
+
+	_ = s.MarkProcessed(ctx, "a")
+	if dup, _ := s.IsDuplicate(ctx, "a"); !dup {
+		t.Error("expected duplicate before ttl")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if dup, _ := s.IsDuplicate(ctx, "a"); dup {
+		t.Error("expected expiry after ttl")
+	}
+	if stats := s.Stats(); stats.Entries != 0 || stats.Evictions != 0 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestLRUDedupServiceEntities(t *testing.T) {
+	ctx := context.Background()
+	s := NewLRUDedupService(10, time.Minute)
+
+	_ = s.SetEntityExists(ctx, "e1")
+	if ok, _ := s.EntityExists(ctx, "e1"); !ok {
+		t.Error("expected entity")
+	}
+	_ = s.DeleteEntity(ctx, "e1")
+	if ok, _ := s.EntityExists(ctx, "e1"); ok {
+		t.Error("expected entity deleted")
+	}
+}
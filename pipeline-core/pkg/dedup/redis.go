@@ -0,0 +1,152 @@
+package dedup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// applyVersionScript 더 최신 버전일 때만 엔티티 버전을 원자적으로 기록
+// 버전은 음수가 아닌 정수 문자열로 저장하며 Lua 숫자(double) 정밀도 손실을 피하려고 길이와 사전순으로 비교한다.
+// KEYS[1]=버전 키, ARGV[1]=버전, ARGV[2]=삭제 여부(1/0), ARGV[3]=툼스톤 TTL(ms)
+var applyVersionScript = redis.NewScript(`
+local cur = redis.call('HMGET', KEYS[1], 'v', 'd')
+if cur[1] then
+	local last, v = cur[1], ARGV[1]
+	if #v ~= #last then
+		if #v < #last then return 0 end
+	elseif v < last then
+		return 0
+	elseif v == last and cur[2] == '1' and ARGV[2] == '0' then
+		return 0
+	end
+end
+redis.call('HSET', KEYS[1], 'v', ARGV[1], 'd', ARGV[2])
+if ARGV[2] == '1' then
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+else
+	redis.call('PERSIST', KEYS[1])
+end
+return 1
+`)
+
+// RedisDedupService Redis 기반 중복 제거 (프로덕션용)
+// 처리된 이벤트 ID는 TTL이 있는 키로, 엔티티 존재 여부와 버전은 영구 키로 저장한다 (툼스톤만 만료)
+type RedisDedupService struct {
+	client       *redis.Client
+	prefix       string
+	ttl          time.Duration
+	tombstoneTTL time.Duration
+}
+
+// NewRedisDedupService Redis 기반 서비스 생성
+// addr는 host:port 또는 redis:// URL
+func NewRedisDedupService(addr, prefix string, ttl time.Duration) (*RedisDedupService, error) {
+	options := &redis.Options{Addr: addr}
+	if strings.Contains(addr, "://") {
+		var err error
+		if options, err = redis.ParseURL(addr); err != nil {
+			return nil, fmt.Errorf("invalid redis url: %w", err)
+		}
+	}
+
+	return &RedisDedupService{
+		client:       redis.NewClient(options),
+		prefix:       prefix,
+		ttl:          ttl,
+		tombstoneTTL: ttl,
+	}, nil
+}
+
+func (s *RedisDedupService) eventKey(eventID string) string {
+	return fmt.Sprintf("%s:event:%s", s.prefix, eventID)
+}
+
+func (s *RedisDedupService) entityKey(entityID string) string {
+	return fmt.Sprintf("%s:entity:%s", s.prefix, entityID)
+}
+
+func (s *RedisDedupService) versionKey(entityID string) string {
+	return fmt.Sprintf("%s:version:%s", s.prefix, entityID)
+}
+
+func (s *RedisDedupService) IsDuplicate(ctx context.Context, eventID string) (bool, error) {
+	exists, err := s.client.Exists(ctx, s.eventKey(eventID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis exists failed: %w", err)
+	}
+	return exists > 0, nil
+}
+
+func (s *RedisDedupService) MarkProcessed(ctx context.Context, eventID string) error {
+	if err := s.client.Set(ctx, s.eventKey(eventID), "1", s.ttl).Err(); err != nil {
+		return fmt.Errorf("redis set failed: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisDedupService) EntityExists(ctx context.Context, entityID string) (bool, error) {
+	exists, err := s.client.Exists(ctx, s.entityKey(entityID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis exists failed: %w", err)
+	}
+	return exists > 0, nil
+}
+
+func (s *RedisDedupService) SetEntityExists(ctx context.Context, entityID string) error {
+	// TTL 없음 - 영구 저장
+	if err := s.client.Set(ctx, s.entityKey(entityID), "1", 0).Err(); err != nil {
+		return fmt.Errorf("redis set failed: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisDedupService) DeleteEntity(ctx context.Context, entityID string) error {
+	if err := s.client.Del(ctx, s.entityKey(entityID)).Err(); err != nil {
+		return fmt.Errorf("redis del failed: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisDedupService) IsStale(ctx context.Context, entityID string, version int64, eventType EventType) (bool, error) {
+	values, err := s.client.HMGet(ctx, s.versionKey(entityID), "v", "d").Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("redis hmget failed: %w", err)
+	}
+
+	raw, ok := values[0].(string)
+	if !ok {
+		return false, nil
+	}
+	last, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid stored version for %s: %w", entityID, err)
+	}
+	deleted, _ := values[1].(string)
+	return entityVersion{version: last, deleted: deleted == "1"}.staleAgainst(version, eventType), nil
+}
+
+func (s *RedisDedupService) ApplyVersion(ctx context.Context, entityID string, version int64, eventType EventType) error {
+	deleted := "0"
+	if eventType == EventDelete {
+		deleted = "1"
+	}
+	err := applyVersionScript.Run(ctx, s.client, []string{s.versionKey(entityID)},
+		strconv.FormatInt(version, 10), deleted, s.tombstoneTTL.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("redis apply version failed: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisDedupService) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,129 @@
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func newTestRedisDedup(t *testing.T) (*RedisDedupService, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	svc, err := NewRedisDedupService("redis://"+mr.Addr(), "dedup", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = svc.Close() })
+	return svc, mr
+}
+
+func TestRedisDedupServiceEvents(t *testing.T) {
+	ctx := context.Background()
+	svc, mr := newTestRedisDedup(t)
+
+	if dup, err := svc.IsDuplicate(ctx, "ev1"); err != nil || dup {
+		t.Fatalf("IsDuplicate = %v, %v", dup, err)
+	}
+	if err := svc.MarkProcessed(ctx, "ev1"); err != nil {
+		t.Fatal(err)
+	}
+	if dup, _ := svc.IsDuplicate(ctx, "ev1"); !dup {
+		t.Error("expected duplicate")
+	}
+
+	mr.FastForward(2 * time.Hour)
+	if dup, _ := svc.IsDuplicate(ctx, "ev1"); dup {
+		t.Error("expected expiry after ttl")
+	}
+
+	_ = svc.SetEntityExists(ctx, "e1")
+	if ok, _ := svc.EntityExists(ctx, "e1"); !ok {
+		t.Error("expected entity")
+	}
+	_ = svc.DeleteEntity(ctx, "e1")
+	if ok, _ := svc.EntityExists(ctx, "e1"); ok {
+		t.Error("expected entity deleted")
+	}
+}
+
+func TestRedisDedupServiceVersions(t *testing.T) {
+	svc, _ := newTestRedisDedup(t)
+	versionScenario(t, svc)
+}
+
+func TestRedisDedupServiceTombstoneExpiry(t *testing.T) {
+	ctx := context.Background()
+	svc, mr := newTestRedisDedup(t)
+	svc.tombstoneTTL = time.Minute
+
+	_ = svc.ApplyVersion(ctx, "e1", 5, EventDelete)
+	if ttl := mr.TTL("dedup:version:e1"); ttl != time.Minute {
+		t.Errorf("tombstone ttl = %v", ttl)
+	}
+	if stale, _ := svc.IsStale(ctx, "e1", 4, EventCreate); !stale {
+		t.Error("expected stale create before tombstone expiry")
+	}
+
+	mr.FastForward(2 * time.Minute)
+	if stale, _ := svc.IsStale(ctx, "e1", 4, EventCreate); stale {
+		t.Error("expected tombstone expiry")
+	}
+
+	// 재생성되면 만료 없이 보관
+	_ = svc.ApplyVersion(ctx, "e2", 1, EventDelete)
+	_ = svc.ApplyVersion(ctx, "e2", 2, EventCreate)
+	if ttl := mr.TTL("dedup:version:e2"); ttl != 0 {
+		t.Errorf("live version ttl = %v", ttl)
+	}
+}
+
+func TestApplyVersionScriptComparesDigits(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestRedisDedup(t)
+
+	// 문자열 사전순으로는 "9" > "10"이지만 자릿수로 비교해야 함
+	_ = svc.ApplyVersion(ctx, "e1", 9, EventUpdate)
+	_ = svc.ApplyVersion(ctx, "e1", 10, EventUpdate)
+	if stale, _ := svc.IsStale(ctx, "e1", 9, EventUpdate); !stale {
+		t.Error("expected version 10 to be applied")
+	}
+	// 나노초 시각처럼 큰 값도 정밀도 손실 없이 비교
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC).UnixNano()
+	_ = svc.ApplyVersion(ctx, "e2", base+1, EventUpdate)
+	if stale, _ := svc.IsStale(ctx, "e2", base, EventUpdate); !stale {
+		t.Error("expected 1ns older version to be stale")
+	}
+	_ = svc.ApplyVersion(ctx, "e2", base, EventUpdate)
+	if stale, _ := svc.IsStale(ctx, "e2", base+1, EventUpdate); stale {
+		t.Error("older ApplyVersion overwrote newer version")
+	}
+}
+
+func TestRedisDedupNamespaces(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+
+	newSvc := func(namespace string) DedupService {
+		svc, err := New(Options{Storage: StorageRedis, RedisURL: mr.Addr(), Namespace: namespace})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = svc.Close() })
+		return svc
+	}
+	orders := newSvc(NamespaceOf("orders", "dedup"))
+	payments := newSvc(NamespaceOf("payments", "dedup"))
+
+	_ = orders.MarkProcessed(ctx, "ev1")
+	if dup, _ := payments.IsDuplicate(ctx, "ev1"); dup {
+		t.Error("namespaces share event keys")
+	}
+	if dup, _ := orders.IsDuplicate(ctx, "ev1"); !dup {
+		t.Error("expected duplicate in same namespace")
+	}
+	if !mr.Exists("dedup:orders:dedup:event:ev1") {
+		t.Errorf("keys = %v", mr.Keys())
+	}
+}
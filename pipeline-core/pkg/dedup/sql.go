@@ -0,0 +1,399 @@
+package dedup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/provisioner"
+)
+
+// DefaultSQLTablePrefix sql 저장소 테이블 이름 접두사 기본값
+const DefaultSQLTablePrefix = "conduix_dedup"
+
+// sqlBatchSize IsDuplicateBatch의 IN 절 하나에 넣는 최대 ID 수 (sqlite 바인드 파라미터 제한 이하)
+const sqlBatchSize = 500
+
+var sqlIdentifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// BatchDedupService 여러 이벤트 ID를 한 번에 조회할 수 있는 중복 제거 서비스
+type BatchDedupService interface {
+	DedupService
+
+	// IsDuplicateBatch 이미 처리된 이벤트 ID 집합 (처리되지 않은 ID는 결과에 없음)
+	IsDuplicateBatch(ctx context.Context, eventIDs []string) (map[string]bool, error)
+}
+
+// SQLDedupService 관계형 DB 기반 중복 제거 (mysql, postgres, sqlite)
+// Redis를 쓸 수 없는 환경용으로, 엔티티 존재 여부와 버전이 캐시 eviction 없이 영구 보관된다.
+// 테이블은 <prefix>_events, <prefix>_entities, <prefix>_versions이며 SQLProvisioner로 생성한다.
+// 만료 시각은 드라이버별 시간대 처리 차이를 피하려고 Unix 밀리초(BIGINT)로 저장하고, 인덱스를 타는 범위 삭제로 정리한다
+type SQLDedupService struct {
+	db           *sql.DB
+	driver       string
+	events       string
+	entities     string
+	versions     string
+	ttl          time.Duration
+	tombstoneTTL time.Duration
+	now          func() time.Time
+
+	mu          sync.Mutex
+	counter     lookupCounter
+	cleanupStop chan struct{}
+	closeOnce   sync.Once
+}
+
+// maxTablePrefixLen 테이블 접두사 최대 길이 (접미사 _entities를 붙여도 MySQL 식별자 64자를 넘지 않도록)
+const maxTablePrefixLen = 48
+
+// namespaceTablePrefix 네임스페이스별 테이블 접두사 (식별자에 쓸 수 없는 문자는 _로 바꾸고, 길면 해시 사용)
+func namespaceTablePrefix(namespace string) string {
+	var b strings.Builder
+	for _, r := range namespace {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	prefix := DefaultSQLTablePrefix + "_" + b.String()
+	if len(prefix) > maxTablePrefixLen {
+		h := fnv.New64a()
+		h.Write([]byte(namespace))
+		prefix = fmt.Sprintf("%s_%016x", DefaultSQLTablePrefix, h.Sum64())
+	}
+	return prefix
+}
+
+// NewSQLDedupService SQL 기반 서비스 생성 (연결 확인 후 테이블이 없으면 생성)
+// driver는 mysql, postgres, sqlite 중 하나이고 prefix는 테이블 이름 접두사
+func NewSQLDedupService(driver, dsn, prefix string, ttl time.Duration) (*SQLDedupService, error) {
+	switch driver {
+	case "mysql", "postgres", "sqlite":
+	default:
+		return nil, fmt.Errorf("unsupported dedup sql driver: %s (must be mysql, postgres or sqlite)", driver)
+	}
+	if prefix == "" {
+		prefix = DefaultSQLTablePrefix
+	}
+	if !sqlIdentifierPattern.MatchString(prefix) {
+		return nil, fmt.Errorf("invalid dedup sql table prefix: %q", prefix)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	s := &SQLDedupService{
+		db:           db,
+		driver:       driver,
+		events:       prefix + "_events",
+		entities:     prefix + "_entities",
+		versions:     prefix + "_versions",
+		ttl:          ttl,
+		tombstoneTTL: ttl,
+		now:          time.Now,
+		cleanupStop:  make(chan struct{}),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+	if err := s.migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// 백그라운드 정리
+	go s.cleanupLoop()
+
+	return s, nil
+}
+
+// migrate 중복 제거 테이블 생성
+func (s *SQLDedupService) migrate(ctx context.Context) error {
+	p := provisioner.NewSQLProvisioner()
+	tables := []*provisioner.SQLProvisioningConfig{
+		{
+			TableName: s.events,
+			Columns: []provisioner.SQLColumn{
+				{Name: "id", Type: "VARCHAR(512)", PrimaryKey: true},
+				{Name: "expires_at", Type: "BIGINT"},
+			},
+			Indexes: []provisioner.SQLIndex{{Name: s.events + "_expires_at", Columns: []string{"expires_at"}}},
+		},
+		{
+			TableName: s.entities,
+			Columns: []provisioner.SQLColumn{
+				{Name: "id", Type: "VARCHAR(512)", PrimaryKey: true},
+			},
+		},
+		{
+			TableName: s.versions,
+			Columns: []provisioner.SQLColumn{
+				{Name: "id", Type: "VARCHAR(512)", PrimaryKey: true},
+				{Name: "version", Type: "BIGINT"},
+				{Name: "deleted", Type: "SMALLINT"},
+				{Name: "expires_at", Type: "BIGINT", Nullable: true}, // 툼스톤만 만료
+			},
+			Indexes: []provisioner.SQLIndex{{Name: s.versions + "_expires_at", Columns: []string{"expires_at"}}},
+		},
+	}
+	for _, table := range tables {
+		table.Driver = s.driver
+		if s.driver == "mysql" {
+			table.Charset = "utf8mb4"
+		}
+		if err := p.Migrate(ctx, s.db, table); err != nil {
+			return fmt.Errorf("failed to migrate dedup table %s: %w", table.TableName, err)
+		}
+	}
+	return nil
+}
+
+func (s *SQLDedupService) cleanupLoop() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.cleanupStop:
+			return
+		case <-ticker.C:
+			// 실패하면 다음 주기에 다시 시도
+			_ = s.cleanup(context.Background())
+		}
+	}
+}
+
+// cleanup 만료된 이벤트 ID와 툼스톤 삭제 (expires_at 인덱스 범위 삭제)
+func (s *SQLDedupService) cleanup(ctx context.Context) error {
+	now := s.now().UnixMilli()
+	if _, err := s.db.ExecContext(ctx, s.bind("DELETE FROM "+s.events+" WHERE expires_at <= ?"), now); err != nil {
+		return fmt.Errorf("sql cleanup events failed: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, s.bind("DELETE FROM "+s.versions+" WHERE expires_at <= ?"), now); err != nil {
+		return fmt.Errorf("sql cleanup tombstones failed: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLDedupService) IsDuplicate(ctx context.Context, eventID string) (bool, error) {
+	var one int
+	err := s.db.QueryRowContext(ctx, s.bind("SELECT 1 FROM "+s.events+" WHERE id = ? AND expires_at > ?"),
+		eventID, s.now().UnixMilli()).Scan(&one)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, fmt.Errorf("sql dedup lookup failed: %w", err)
+	}
+
+	dup := err == nil
+	s.mu.Lock()
+	s.counter.record(dup)
+	s.mu.Unlock()
+	return dup, nil
+}
+
+// IsDuplicateBatch 여러 이벤트 ID를 IN 조회로 한 번에 확인
+func (s *SQLDedupService) IsDuplicateBatch(ctx context.Context, eventIDs []string) (map[string]bool, error) {
+	dups := make(map[string]bool)
+	now := s.now().UnixMilli()
+
+	for start := 0; start < len(eventIDs); start += sqlBatchSize {
+		chunk := eventIDs[start:min(start+sqlBatchSize, len(eventIDs))]
+		args := make([]any, 0, len(chunk)+1)
+		args = append(args, now)
+		for _, id := range chunk {
+			args = append(args, id)
+		}
+		query := "SELECT id FROM " + s.events + " WHERE expires_at > ? AND id IN (?" + strings.Repeat(", ?", len(chunk)-1) + ")"
+
+		rows, err := s.db.QueryContext(ctx, s.bind(query), args...)
+		if err != nil {
+			return nil, fmt.Errorf("sql dedup batch lookup failed: %w", err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("sql dedup batch lookup failed: %w", err)
+			}
+			dups[id] = true
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("sql dedup batch lookup failed: %w", err)
+		}
+	}
+
+	s.mu.Lock()
+	for _, id := range eventIDs {
+		s.counter.record(dups[id])
+	}
+	s.mu.Unlock()
+	return dups, nil
+}
+
+func (s *SQLDedupService) MarkProcessed(ctx context.Context, eventID string) error {
+	query := s.upsert(s.events, []string{"id", "expires_at"}, "expires_at")
+	if _, err := s.db.ExecContext(ctx, query, eventID, s.now().Add(s.ttl).UnixMilli()); err != nil {
+		return fmt.Errorf("sql mark processed failed: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLDedupService) EntityExists(ctx context.Context, entityID string) (bool, error) {
+	var one int
+	err := s.db.QueryRowContext(ctx, s.bind("SELECT 1 FROM "+s.entities+" WHERE id = ?"), entityID).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("sql entity lookup failed: %w", err)
+	}
+	return true, nil
+}
+
+func (s *SQLDedupService) SetEntityExists(ctx context.Context, entityID string) error {
+	// TTL 없음 - 영구 저장
+	if _, err := s.db.ExecContext(ctx, s.upsert(s.entities, []string{"id"}), entityID); err != nil {
+		return fmt.Errorf("sql set entity failed: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLDedupService) DeleteEntity(ctx context.Context, entityID string) error {
+	if _, err := s.db.ExecContext(ctx, s.bind("DELETE FROM "+s.entities+" WHERE id = ?"), entityID); err != nil {
+		return fmt.Errorf("sql delete entity failed: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLDedupService) IsStale(ctx context.Context, entityID string, version int64, eventType EventType) (bool, error) {
+	var last entityVersion
+	var deleted int
+	err := s.db.QueryRowContext(ctx,
+		s.bind("SELECT version, deleted FROM "+s.versions+" WHERE id = ? AND (expires_at IS NULL OR expires_at > ?)"),
+		entityID, s.now().UnixMilli()).Scan(&last.version, &deleted)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("sql version lookup failed: %w", err)
+	}
+	last.deleted = deleted == 1
+	return last.staleAgainst(version, eventType), nil
+}
+
+// ApplyVersion 더 최신 버전일 때만 기록하는 조건부 upsert
+// 같은 버전은 재전송으로 보고 덮어쓰되, 툼스톤은 같은 버전의 CREATE/UPDATE로 되살리지 않는다 (staleAgainst와 같은 규칙)
+func (s *SQLDedupService) ApplyVersion(ctx context.Context, entityID string, version int64, eventType EventType) error {
+	now := s.now()
+	deleted := 0
+	var expiresAt any
+	if eventType == EventDelete {
+		deleted = 1
+		expiresAt = now.Add(s.tombstoneTTL).UnixMilli()
+	}
+
+	// 만료된 툼스톤은 없는 것으로 보고 먼저 지움
+	if _, err := s.db.ExecContext(ctx, s.bind("DELETE FROM "+s.versions+" WHERE id = ? AND expires_at <= ?"),
+		entityID, now.UnixMilli()); err != nil {
+		return fmt.Errorf("sql apply version failed: %w", err)
+	}
+
+	var query string
+	if s.driver == "mysql" {
+		// MySQL은 SET 절을 왼쪽부터 적용하며 뒤 식이 갱신된 값을 본다.
+		// 조건이 참이면 deleted를 새 값으로 바꾼 뒤에도 참으로 유지되고 거짓이면 값이 그대로이므로, version을 마지막에 갱신한다
+		cond := "VALUES(version) > version OR (VALUES(version) = version AND (deleted = 0 OR VALUES(deleted) = 1))"
+		query = fmt.Sprintf("INSERT INTO %s (id, version, deleted, expires_at) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE "+
+			"deleted = IF(%[2]s, VALUES(deleted), deleted), expires_at = IF(%[2]s, VALUES(expires_at), expires_at), version = IF(%[2]s, VALUES(version), version)",
+			s.versions, cond)
+	} else {
+		query = s.bind(fmt.Sprintf("INSERT INTO %[1]s (id, version, deleted, expires_at) VALUES (?, ?, ?, ?) ON CONFLICT (id) DO UPDATE SET "+
+			"version = EXCLUDED.version, deleted = EXCLUDED.deleted, expires_at = EXCLUDED.expires_at "+
+			"WHERE %[1]s.version < EXCLUDED.version OR (%[1]s.version = EXCLUDED.version AND (%[1]s.deleted = 0 OR EXCLUDED.deleted = 1))",
+			s.versions))
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, entityID, version, deleted, expiresAt); err != nil {
+		return fmt.Errorf("sql apply version failed: %w", err)
+	}
+	return nil
+}
+
+// upsert 키(id) 충돌 시 updates 컬럼만 갱신하는 INSERT (updates가 없으면 충돌 무시)
+func (s *SQLDedupService) upsert(table string, columns []string, updates ...string) string {
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (?%s)", table, strings.Join(columns, ", "), strings.Repeat(", ?", len(columns)-1))
+
+	if s.driver == "mysql" {
+		if len(updates) == 0 {
+			return query + " ON DUPLICATE KEY UPDATE id = id"
+		}
+		sets := make([]string, len(updates))
+		for i, col := range updates {
+			sets[i] = fmt.Sprintf("%s = VALUES(%s)", col, col)
+		}
+		return query + " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	}
+
+	if len(updates) == 0 {
+		return s.bind(query + " ON CONFLICT (id) DO NOTHING")
+	}
+	sets := make([]string, len(updates))
+	for i, col := range updates {
+		sets[i] = fmt.Sprintf("%s = EXCLUDED.%s", col, col)
+	}
+	return s.bind(query + " ON CONFLICT (id) DO UPDATE SET " + strings.Join(sets, ", "))
+}
+
+// bind "?" 바인드 파라미터를 드라이버 표기로 변환 (postgres: $1, $2, ...)
+func (s *SQLDedupService) bind(query string) string {
+	if s.driver != "postgres" {
+		return query
+	}
+	var sb strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&sb, "$%d", n)
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// Stats 현재 통계 (보관 항목 수는 DB 조회가 필요하므로 조회/적중 수만 제공)
+func (s *SQLDedupService) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.counter.stats(StorageSQL)
+}
+
+func (s *SQLDedupService) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.cleanupStop)
+		err = s.db.Close()
+	})
+	return err
+}
@@ -0,0 +1,165 @@
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestSQLDedup(t *testing.T) *SQLDedupService {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "dedup.db")
+	svc, err := NewSQLDedupService("sqlite", dsn, "", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = svc.Close() })
+	return svc
+}
+
+func TestSQLDedupServiceEvents(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestSQLDedup(t)
+	now := time.Now()
+	svc.now = func() time.Time { return now }
+
+	if dup, err := svc.IsDuplicate(ctx, "ev1"); err != nil || dup {
+		t.Fatalf("IsDuplicate = %v, %v", dup, err)
+	}
+	if err := svc.MarkProcessed(ctx, "ev1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.MarkProcessed(ctx, "ev1"); err != nil {
+		t.Fatalf("MarkProcessed twice: %v", err)
+	}
+	if dup, _ := svc.IsDuplicate(ctx, "ev1"); !dup {
+		t.Error("expected duplicate")
+	}
+
+	// TTL이 지나면 정리 전이라도 중복이 아님
+	now = now.Add(2 * time.Hour)
+	if dup, _ := svc.IsDuplicate(ctx, "ev1"); dup {
+		t.Error("expected expiry after ttl")
+	}
+	if err := svc.cleanup(ctx); err != nil {
+		t.Fatal(err)
+	}
+	var count int
+	if err := svc.db.QueryRow("SELECT COUNT(*) FROM " + svc.events).Scan(&count); err != nil || count != 0 {
+		t.Errorf("events after cleanup = %d, %v", count, err)
+	}
+
+	if stats := svc.Stats(); stats.Storage != StorageSQL || stats.Lookups != 3 || stats.Hits != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestSQLDedupServiceBatch(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestSQLDedup(t)
+
+	// IN 절 분할 경계를 넘도록 sqlBatchSize보다 많이 조회
+	ids := make([]string, sqlBatchSize+10)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("ev%d", i)
+		if i%2 == 0 {
+			if err := svc.MarkProcessed(ctx, ids[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	dups, err := svc.IsDuplicateBatch(ctx, ids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dups) != (len(ids)+1)/2 {
+		t.Errorf("duplicates = %d, want %d", len(dups), (len(ids)+1)/2)
+	}
+	if !dups["ev0"] || dups["ev1"] || !dups[ids[len(ids)-2]] {
+		t.Errorf("unexpected batch result: ev0=%v ev1=%v", dups["ev0"], dups["ev1"])
+	}
+	if stats := svc.Stats(); stats.Lookups != int64(len(ids)) {
+		t.Errorf("lookups = %d", stats.Lookups)
+	}
+}
+
+func TestSQLDedupServiceEntities(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestSQLDedup(t)
+
+	_ = svc.SetEntityExists(ctx, "e1")
+	if err := svc.SetEntityExists(ctx, "e1"); err != nil {
+		t.Fatalf("SetEntityExists twice: %v", err)
+	}
+	if ok, _ := svc.EntityExists(ctx, "e1"); !ok {
+		t.Error("expected entity")
+	}
+	_ = svc.DeleteEntity(ctx, "e1")
+	if ok, _ := svc.EntityExists(ctx, "e1"); ok {
+		t.Error("expected entity deleted")
+	}
+}
+
+func TestSQLDedupServiceVersions(t *testing.T) {
+	versionScenario(t, newTestSQLDedup(t))
+}
+
+func TestSQLDedupServiceTombstoneExpiry(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestSQLDedup(t)
+	now := time.Now()
+	svc.now = func() time.Time { return now }
+	svc.tombstoneTTL = time.Minute
+
+	_ = svc.ApplyVersion(ctx, "e1", 5, EventDelete)
+	if stale, _ := svc.IsStale(ctx, "e1", 1, EventCreate); !stale {
+		t.Error("expected tombstone to reject older create")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if stale, _ := svc.IsStale(ctx, "e1", 1, EventCreate); stale {
+		t.Error("expected expired tombstone to be ignored")
+	}
+	// 만료된 툼스톤은 더 오래된 버전으로도 덮어쓸 수 있음
+	if err := svc.ApplyVersion(ctx, "e1", 1, EventCreate); err != nil {
+		t.Fatal(err)
+	}
+	if stale, _ := svc.IsStale(ctx, "e1", 0, EventUpdate); !stale {
+		t.Error("expected version 1 to be applied after tombstone expiry")
+	}
+}
+
+func TestNewSQLDedupServiceValidation(t *testing.T) {
+	if _, err := NewSQLDedupService("oracle", "dsn", "", time.Hour); err == nil {
+		t.Error("expected unsupported driver error")
+	}
+	if _, err := NewSQLDedupService("sqlite", ":memory:", "bad-prefix;", time.Hour); err == nil {
+		t.Error("expected invalid prefix error")
+	}
+}
+
+func TestNamespaceTablePrefix(t *testing.T) {
+	tests := []struct {
+		namespace string
+		want      string
+	}{
+		{NamespaceOf("orders", "dedup-users"), "conduix_dedup_orders_dedup_users"},
+		{NamespaceOf("", "latest"), "conduix_dedup_latest"},
+	}
+	for _, tt := range tests {
+		if got := namespaceTablePrefix(tt.namespace); got != tt.want {
+			t.Errorf("namespaceTablePrefix(%q) = %s, want %s", tt.namespace, got, tt.want)
+		}
+	}
+
+	long := namespaceTablePrefix(NamespaceOf("6f1c2a3e-8d4b-4f7a-9c1e-2b3d4e5f6a7b", "dedup_orders"))
+	if len(long) > maxTablePrefixLen || !sqlIdentifierPattern.MatchString(long) {
+		t.Errorf("long prefix = %s", long)
+	}
+	if long == namespaceTablePrefix(NamespaceOf("6f1c2a3e-8d4b-4f7a-9c1e-2b3d4e5f6a7b", "dedup_users")) {
+		t.Error("long namespaces collide")
+	}
+}
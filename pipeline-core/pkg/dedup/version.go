@@ -0,0 +1,87 @@
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// VersionTracker 엔티티별 마지막으로 적용된 버전을 추적하는 서비스
+// 순서가 뒤바뀌어 늦게 도착한 이벤트가 최신 데이터를 덮어쓰지 않도록 오래된 이벤트를 걸러낸다.
+// DELETE는 버전과 함께 툼스톤으로 남겨 삭제 이전 버전의 CREATE/UPDATE가 엔티티를 되살리지 못하게 한다
+type VersionTracker interface {
+	// IsStale 이벤트 버전이 엔티티에 마지막으로 적용된 버전보다 오래되었는지 확인
+	IsStale(ctx context.Context, entityID string, version int64, eventType EventType) (bool, error)
+
+	// ApplyVersion 적용된 이벤트 버전 기록 (DELETE는 툼스톤으로 보관, 더 최신 버전이 이미 있으면 무시)
+	ApplyVersion(ctx context.Context, entityID string, version int64, eventType EventType) error
+}
+
+// entityVersion 엔티티에 마지막으로 적용된 버전
+type entityVersion struct {
+	version   int64
+	deleted   bool      // DELETE로 적용된 툼스톤
+	deletedAt time.Time // 툼스톤 만료 기준 시각
+	appliedAt time.Time // 적용 시각 (memory 저장소의 버전 만료 기준)
+}
+
+// staleAgainst 마지막 적용 버전 기준으로 이벤트가 오래되었는지 판정
+// 같은 버전이면 재전송으로 보고 통과시키되, 툼스톤과 같은 버전의 CREATE/UPDATE는 삭제가 우선한다
+func (v entityVersion) staleAgainst(version int64, eventType EventType) bool {
+	if version != v.version {
+		return version < v.version
+	}
+	return v.deleted && eventType != EventDelete
+}
+
+// ParseVersion 버전 필드 값을 비교 가능한 정수로 변환
+// 정수 버전은 그대로, 시각(RFC3339 문자열 또는 time.Time)은 Unix 나노초로 변환한다
+func ParseVersion(value any) (int64, error) {
+	var version int64
+	switch v := value.(type) {
+	case int:
+		version = int64(v)
+	case int32:
+		version = int64(v)
+	case int64:
+		version = v
+	case uint32:
+		version = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("version out of range: %d", v)
+		}
+		version = int64(v)
+	case float64:
+		if v != math.Trunc(v) || v > math.MaxInt64 || v < math.MinInt64 {
+			return 0, fmt.Errorf("version must be an integer: %v", v)
+		}
+		version = int64(v)
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("version must be an integer: %s", v)
+		}
+		version = n
+	case time.Time:
+		version = v.UnixNano()
+	case string:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			version = n
+		} else if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			version = t.UnixNano()
+		} else {
+			return 0, fmt.Errorf("version must be an integer or RFC3339 timestamp: %q", v)
+		}
+	default:
+		return 0, fmt.Errorf("unsupported version type: %T", value)
+	}
+
+	if version < 0 {
+		return 0, fmt.Errorf("version must not be negative: %d", version)
+	}
+	return version, nil
+}
@@ -0,0 +1,134 @@
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseVersion(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 500, time.UTC)
+	tests := []struct {
+		value   any
+		want    int64
+		wantErr bool
+	}{
+		{int64(7), 7, false},
+		{float64(42), 42, false},
+		{json.Number("9"), 9, false},
+		{"15", 15, false},
+		{ts, ts.UnixNano(), false},
+		{ts.Format(time.RFC3339Nano), ts.UnixNano(), false},
+		{float64(1.5), 0, true},
+		{"yesterday", 0, true},
+		{int64(-1), 0, true},
+		{true, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseVersion(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseVersion(%v) error = %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseVersion(%v) = %d, want %d", tt.value, got, tt.want)
+		}
+	}
+}
+
+// versionScenario 순서가 뒤바뀐 이벤트 시나리오 (백엔드 공통)
+func versionScenario(t *testing.T, svc VersionTracker) {
+	t.Helper()
+	ctx := context.Background()
+
+	steps := []struct {
+		entity    string
+		version   int64
+		eventType EventType
+		stale     bool
+	}{
+		{"e1", 1, EventCreate, false},
+		{"e1", 3, EventUpdate, false},
+		{"e1", 2, EventUpdate, true},  // 늦게 도착한 이전 UPDATE
+		{"e1", 3, EventUpdate, false}, // 같은 버전 재전송
+		{"e1", 5, EventDelete, false},
+		{"e1", 4, EventCreate, true},  // 삭제 이전 CREATE는 되살리지 않음
+		{"e1", 5, EventUpdate, true},  // 툼스톤과 같은 버전은 삭제 우선
+		{"e1", 6, EventCreate, false}, // 삭제 이후 재생성
+		{"e2", 1, EventUpdate, false},
+	}
+	for i, step := range steps {
+		stale, err := svc.IsStale(ctx, step.entity, step.version, step.eventType)
+		if err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+		if stale != step.stale {
+			t.Errorf("step %d: IsStale(%s, %d, %s) = %v, want %v", i, step.entity, step.version, step.eventType, stale, step.stale)
+		}
+		if !stale {
+			if err := svc.ApplyVersion(ctx, step.entity, step.version, step.eventType); err != nil {
+				t.Fatalf("step %d: %v", i, err)
+			}
+		}
+	}
+
+	// ApplyVersion은 더 최신 버전을 덮어쓰지 않음
+	if err := svc.ApplyVersion(ctx, "e1", 2, EventUpdate); err != nil {
+		t.Fatal(err)
+	}
+	if stale, _ := svc.IsStale(ctx, "e1", 5, EventUpdate); !stale {
+		t.Error("older ApplyVersion overwrote newer version")
+	}
+}
+
+func TestMemoryDedupServiceVersions(t *testing.T) {
+	svc := NewMemoryDedupService(time.Hour)
+	defer svc.Close()
+	versionScenario(t, svc)
+}
+
+func TestMemoryDedupServiceTombstoneExpiry(t *testing.T) {
+	ctx := context.Background()
+	svc := NewMemoryDedupService(time.Hour)
+	defer svc.Close()
+	svc.tombstoneTTL = time.Millisecond
+
+	_ = svc.ApplyVersion(ctx, "e1", 5, EventDelete)
+	if stats := svc.Stats(); stats.Tombstones != 1 {
+		t.Errorf("tombstones = %d", stats.Tombstones)
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if stale, _ := svc.IsStale(ctx, "e1", 1, EventCreate); stale {
+		t.Error("expected expired tombstone to be ignored")
+	}
+	svc.cleanup()
+	if stats := svc.Stats(); stats.Tombstones != 0 {
+		t.Errorf("tombstones after cleanup = %d", stats.Tombstones)
+	}
+}
+
+func TestMemoryDedupServiceVersionExpiry(t *testing.T) {
+	ctx := context.Background()
+	svc := NewMemoryDedupService(time.Millisecond)
+	defer svc.Close()
+	svc.tombstoneTTL = time.Hour
+
+	_ = svc.ApplyVersion(ctx, "e1", 5, EventUpdate)
+	_ = svc.ApplyVersion(ctx, "e2", 5, EventDelete)
+	time.Sleep(5 * time.Millisecond)
+
+	// 살아 있는 엔티티의 버전은 TTL 후 만료되어 맵이 계속 커지지 않음
+	if stale, _ := svc.IsStale(ctx, "e1", 1, EventUpdate); stale {
+		t.Error("expected expired version to be ignored")
+	}
+	svc.cleanup()
+	svc.mu.RLock()
+	_, live := svc.versions["e1"]
+	_, tombstone := svc.versions["e2"]
+	svc.mu.RUnlock()
+	if live || !tombstone {
+		t.Errorf("versions after cleanup: e1=%v e2=%v", live, tombstone)
+	}
+}
@@ -0,0 +1,286 @@
+package dedup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 윈도우 안에서 남길 발생 (dedup 단계의 keep)
+const (
+	KeepFirst = "first" // 첫 발생을 바로 내보내고 윈도우 동안 나머지를 버림
+	KeepLast  = "last"  // 윈도우가 닫힐 때 마지막 발생을 내보냄
+)
+
+// ParseKeyExpression 키 표현식으로 KeyBuilder 생성
+// 필드 경로를 쉼표로 구분하며 앞의 "."과 감싸는 대괄호는 생략할 수 있다 (예: ".order.id", "[.tenant, .order.id]")
+func ParseKeyExpression(expr string) (*KeyBuilder, error) {
+	expr = strings.TrimSpace(expr)
+	expr = strings.TrimSuffix(strings.TrimPrefix(expr, "["), "]")
+
+	var fields []string
+	for _, part := range strings.Split(expr, ",") {
+		field := strings.TrimPrefix(strings.TrimSpace(part), ".")
+		if field == "" {
+			return nil, fmt.Errorf("invalid key expression %q: empty field", expr)
+		}
+		fields = append(fields, field)
+	}
+	return NewKeyBuilder(fields...), nil
+}
+
+// WindowOptions dedup 단계 생성 옵션
+type WindowOptions struct {
+	Key     string        // 키 표현식 (ParseKeyExpression 형식)
+	Window  time.Duration // 중복 판정 윈도우
+	Keep    string        // first (기본), last
+	Storage Options       // keep=first 판정 저장소 (TTL은 Window로 설정)
+}
+
+// WindowDeduplicator 키와 시간 윈도우로 레코드 중복을 제거 (stream 단계와 v2 파이프라인 단계 공용)
+// first는 DedupService(TTL=윈도우)로 판정하므로 redis, sql 저장소를 쓰면 인스턴스 간에도 중복이 걸러진다.
+// 통과시킨 키는 기록이 끝나 Commit할 때까지 인스턴스 안에서 선점해 두고 저장소에는 Commit에서 표시하므로,
+// 기록에 실패한 레코드(Abandon)는 다시 전달되면 통과한다. 인스턴스 간에는 기록 중에 같은 키가 들어오면
+// 둘 다 통과할 수 있다 (at-least-once).
+// last는 윈도우가 닫힐 때까지 키별 마지막 레코드를 인스턴스 메모리에 보류하고 Release로 내보낸다
+type WindowDeduplicator struct {
+	svc    DedupService
+	owned  bool // NewWindow가 만든 저장소면 Close에서 함께 닫음
+	keys   *KeyBuilder
+	window time.Duration
+	keep   string
+	now    func() time.Time
+
+	mu         sync.Mutex
+	held       map[string]*heldRecord
+	order      []string        // 윈도우가 열린 순서 (윈도우 길이가 같으므로 닫히는 순서와 같음)
+	claimed    map[string]bool // keep=first로 통과시켰지만 아직 Commit/Abandon되지 않은 키
+	claims     []string
+	duplicates int64
+}
+
+// heldRecord keep=last로 보류 중인 키의 마지막 레코드
+type heldRecord struct {
+	record  any
+	closeAt time.Time
+}
+
+// NewWindowDeduplicator 주어진 저장소로 생성 (keep=last는 저장소를 쓰지 않으므로 svc가 nil이어도 됨)
+func NewWindowDeduplicator(svc DedupService, keys *KeyBuilder, window time.Duration, keep string) (*WindowDeduplicator, error) {
+	if keep == "" {
+		keep = KeepFirst
+	}
+	if keep != KeepFirst && keep != KeepLast {
+		return nil, fmt.Errorf("invalid dedup keep: %s (must be first or last)", keep)
+	}
+	if window <= 0 {
+		return nil, fmt.Errorf("dedup window must be positive: %s", window)
+	}
+	if len(keys.Fields()) == 0 {
+		return nil, fmt.Errorf("dedup key is required")
+	}
+	if keep == KeepFirst && svc == nil {
+		return nil, fmt.Errorf("dedup service is required for keep=first")
+	}
+
+	return &WindowDeduplicator{
+		svc:     svc,
+		keys:    keys,
+		window:  window,
+		keep:    keep,
+		now:     time.Now,
+		held:    make(map[string]*heldRecord),
+		claimed: make(map[string]bool),
+	}, nil
+}
+
+// NewWindow 옵션으로 생성 (keep=first면 저장소도 만들고 Close에서 닫음)
+func NewWindow(opts WindowOptions) (*WindowDeduplicator, error) {
+	keys, err := ParseKeyExpression(opts.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	var svc DedupService
+	if opts.Keep != KeepLast && opts.Window > 0 {
+		storage := opts.Storage
+		storage.TTL = opts.Window
+		if svc, err = New(storage); err != nil {
+			return nil, err
+		}
+	}
+
+	d, err := NewWindowDeduplicator(svc, keys, opts.Window, opts.Keep)
+	if err != nil {
+		if svc != nil {
+			_ = svc.Close()
+		}
+		return nil, err
+	}
+	d.owned = true
+	return d, nil
+}
+
+// Offer 레코드를 윈도우에 넣고 지금 내보낼지 반환
+// keep=first로 통과시킨 레코드는 싱크에 기록(또는 이후 단계에서 처리 완료)되면 Commit,
+// 실패하면 Abandon을 호출해야 한다. keep=last면 항상 false이고 보류된 레코드는 윈도우가 닫힌 뒤 Release로 나온다.
+// 키 필드가 없는 레코드는 중복 판정 없이 통과한다
+func (d *WindowDeduplicator) Offer(ctx context.Context, data map[string]any, record any) (bool, error) {
+	key, ok := d.keys.Key(data)
+	if !ok {
+		return true, nil
+	}
+
+	if d.keep == KeepLast {
+		d.hold(key, record)
+		return false, nil
+	}
+
+	// 조회 전에 키를 선점해 같은 인스턴스에서 동시에 들어온 같은 키는 하나만 통과
+	d.mu.Lock()
+	if d.claimed[key] {
+		d.duplicates++
+		d.mu.Unlock()
+		return false, nil
+	}
+	d.claimed[key] = true
+	d.claims = append(d.claims, key)
+	d.mu.Unlock()
+
+	dup, err := d.svc.IsDuplicate(ctx, key)
+	if err != nil || dup {
+		d.mu.Lock()
+		d.unclaim(key)
+		if dup {
+			d.duplicates++
+		}
+		d.mu.Unlock()
+	}
+	if err != nil {
+		return false, fmt.Errorf("dedup lookup failed: %w", err)
+	}
+	return !dup, nil
+}
+
+// Commit 마지막 Commit/Abandon 이후 Offer로 통과시킨 키를 저장소에 처리됨으로 표시
+// 호출자는 레코드를 하나씩 처리하며 그 결과가 정해질 때마다 Commit 또는 Abandon을 호출한다
+func (d *WindowDeduplicator) Commit(ctx context.Context) error {
+	keys := d.takeClaims()
+	for i, key := range keys {
+		if err := d.svc.MarkProcessed(ctx, key); err != nil {
+			d.releaseClaims(keys[i:])
+			return fmt.Errorf("dedup mark failed: %w", err)
+		}
+	}
+	d.releaseClaims(keys)
+	return nil
+}
+
+// Abandon 마지막 Commit/Abandon 이후 Offer로 통과시킨 키의 선점을 풀어 다시 들어오면 통과하도록 함
+func (d *WindowDeduplicator) Abandon() {
+	d.releaseClaims(d.takeClaims())
+}
+
+// takeClaims Commit/Abandon할 키 목록 (선점은 표시가 끝날 때까지 유지)
+func (d *WindowDeduplicator) takeClaims() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	keys := d.claims
+	d.claims = nil
+	return keys
+}
+
+func (d *WindowDeduplicator) releaseClaims(keys []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, key := range keys {
+		delete(d.claimed, key)
+	}
+}
+
+// unclaim 통과시키지 않은 키의 선점 해제 (d.mu를 잡은 상태에서 호출)
+func (d *WindowDeduplicator) unclaim(key string) {
+	delete(d.claimed, key)
+	for i := len(d.claims) - 1; i >= 0; i-- {
+		if d.claims[i] == key {
+			d.claims = append(d.claims[:i], d.claims[i+1:]...)
+			break
+		}
+	}
+}
+
+// Hold keep=last 윈도우에 레코드를 보류하고, 같은 윈도우에서 대체된 이전 레코드를 반환 (새 윈도우면 nil)
+// Offer와 같지만 대체된 레코드가 필요한 호출자(소스 Ack를 하는 파이프라인)용이다. 키 필드가 없으면 held는 false
+func (d *WindowDeduplicator) Hold(data map[string]any, record any) (superseded any, held bool) {
+	key, ok := d.keys.Key(data)
+	if !ok {
+		return nil, false
+	}
+	return d.hold(key, record), true
+}
+
+// hold 키의 윈도우가 열려 있으면 레코드를 교체해 이전 레코드를 반환하고, 없으면 새 윈도우를 연다
+func (d *WindowDeduplicator) hold(key string, record any) any {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if h, ok := d.held[key]; ok {
+		prev := h.record
+		h.record = record
+		d.duplicates++
+		return prev
+	}
+	d.held[key] = &heldRecord{record: record, closeAt: d.now().Add(d.window)}
+	d.order = append(d.order, key)
+	return nil
+}
+
+// Release 윈도우가 닫힌 보류 레코드를 열린 순서대로 반환 (force면 모두 반환, 종료 시 사용)
+// 윈도우는 Release 호출 주기만큼 늦게 닫힐 수 있다
+func (d *WindowDeduplicator) Release(force bool) []any {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	var released []any
+	n := 0
+	for _, key := range d.order {
+		h := d.held[key]
+		if !force && now.Before(h.closeAt) {
+			break
+		}
+		released = append(released, h.record)
+		delete(d.held, key)
+		n++
+	}
+	d.order = d.order[n:]
+	return released
+}
+
+// Duplicates 제거된 중복 레코드 수
+func (d *WindowDeduplicator) Duplicates() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.duplicates
+}
+
+// Pending 보류 중인 레코드 수 (keep=last)
+func (d *WindowDeduplicator) Pending() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.held)
+}
+
+// Close NewWindow가 만든 저장소 종료
+func (d *WindowDeduplicator) Close() error {
+	if d.owned && d.svc != nil {
+		return d.svc.Close()
+	}
+	return nil
+}
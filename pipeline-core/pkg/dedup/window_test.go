@@ -0,0 +1,222 @@
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestParseKeyExpression(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    []string
+		wantErr bool
+	}{
+		{".order_id", []string{"order_id"}, false},
+		{"order.id", []string{"order.id"}, false},
+		{".tenant, .order.id", []string{"tenant", "order.id"}, false},
+		{"[.tenant, .order.id]", []string{"tenant", "order.id"}, false},
+		{"", nil, true},
+		{".a,,.b", nil, true},
+	}
+	for _, tt := range tests {
+		b, err := ParseKeyExpression(tt.expr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseKeyExpression(%q) error = %v", tt.expr, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		got := b.Fields()
+		if len(got) != len(tt.want) {
+			t.Errorf("ParseKeyExpression(%q) = %v, want %v", tt.expr, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("ParseKeyExpression(%q) = %v, want %v", tt.expr, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestWindowDeduplicatorKeepFirst(t *testing.T) {
+	ctx := context.Background()
+	d, err := NewWindow(WindowOptions{Key: ".order.id", Window: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	// 통과한 레코드는 기록된 것으로 보고 바로 Commit
+	offer := func(id any) bool {
+		t.Helper()
+		emit, err := d.Offer(ctx, map[string]any{"order": map[string]any{"id": id}}, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := d.Commit(ctx); err != nil {
+			t.Fatal(err)
+		}
+		return emit
+	}
+
+	if !offer(1) {
+		t.Error("first occurrence should pass")
+	}
+	if offer("1") {
+		t.Error("same key within window should be dropped")
+	}
+	if !offer(2) {
+		t.Error("other key should pass")
+	}
+	if emit, _ := d.Offer(ctx, map[string]any{"other": 1}, nil); !emit {
+		t.Error("record without key should pass")
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if !offer(1) {
+		t.Error("same key after window should pass")
+	}
+	if d.Duplicates() != 1 {
+		t.Errorf("duplicates = %d", d.Duplicates())
+	}
+}
+
+func TestWindowDeduplicatorCommit(t *testing.T) {
+	ctx := context.Background()
+	svc := NewMemoryDedupService(time.Hour)
+	defer svc.Close()
+	d, err := NewWindowDeduplicator(svc, NewKeyBuilder("id"), time.Hour, KeepFirst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	offer := func(id string) bool {
+		t.Helper()
+		emit, err := d.Offer(ctx, map[string]any{"id": id}, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return emit
+	}
+
+	// 기록 전에는 저장소에 표시하지 않지만, 선점한 키는 다시 통과하지 않음
+	if !offer("a") {
+		t.Fatal("first occurrence should pass")
+	}
+	if dup, _ := svc.IsDuplicate(ctx, "a"); dup {
+		t.Error("key marked before commit")
+	}
+	if offer("a") {
+		t.Error("claimed key should be dropped")
+	}
+
+	// 기록에 실패하면 표시하지 않고 놓아주므로 다시 전달된 레코드가 통과
+	d.Abandon()
+	if dup, _ := svc.IsDuplicate(ctx, "a"); dup {
+		t.Error("abandoned key marked")
+	}
+	if !offer("a") {
+		t.Fatal("abandoned key should pass again")
+	}
+
+	if err := d.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if dup, _ := svc.IsDuplicate(ctx, "a"); !dup {
+		t.Error("committed key not marked")
+	}
+	if offer("a") {
+		t.Error("committed key should be dropped")
+	}
+	if d.Duplicates() != 2 {
+		t.Errorf("duplicates = %d", d.Duplicates())
+	}
+}
+
+func TestWindowDeduplicatorKeepLast(t *testing.T) {
+	ctx := context.Background()
+	d, err := NewWindow(WindowOptions{Key: "id", Window: time.Minute, Keep: KeepLast})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	d.now = func() time.Time { return now }
+
+	for i, id := range []string{"a", "b", "a", "a"} {
+		if emit, err := d.Offer(ctx, map[string]any{"id": id}, i); err != nil || emit {
+			t.Fatalf("Offer(%s) = %v, %v", id, emit, err)
+		}
+	}
+	if d.Pending() != 2 || d.Duplicates() != 2 {
+		t.Errorf("pending = %d, duplicates = %d", d.Pending(), d.Duplicates())
+	}
+	if released := d.Release(false); len(released) != 0 {
+		t.Errorf("released before window closed: %v", released)
+	}
+
+	// "c"는 나중에 열린 윈도우이므로 아직 닫히지 않음
+	now = now.Add(30 * time.Second)
+	_, _ = d.Offer(ctx, map[string]any{"id": "c"}, 4)
+	now = now.Add(31 * time.Second)
+	released := d.Release(false)
+	if len(released) != 2 || released[0] != 3 || released[1] != 1 {
+		t.Errorf("released = %v, want last of a (3) then b (1)", released)
+	}
+
+	if released := d.Release(true); len(released) != 1 || released[0] != 4 {
+		t.Errorf("forced release = %v", released)
+	}
+	if d.Pending() != 0 {
+		t.Errorf("pending after release = %d", d.Pending())
+	}
+}
+
+func TestNewWindowDeduplicatorValidation(t *testing.T) {
+	keys := NewKeyBuilder("id")
+	svc := NewMemoryDedupService(time.Hour)
+	defer svc.Close()
+
+	tests := []struct {
+		name   string
+		svc    DedupService
+		keys   *KeyBuilder
+		window time.Duration
+		keep   string
+	}{
+		{"invalid keep", svc, keys, time.Minute, "middle"},
+		{"zero window", svc, keys, 0, KeepFirst},
+		{"no key", svc, NewKeyBuilder(), time.Minute, KeepFirst},
+		{"first without service", nil, keys, time.Minute, KeepFirst},
+	}
+	for _, tt := range tests {
+		if _, err := NewWindowDeduplicator(tt.svc, tt.keys, tt.window, tt.keep); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+	if _, err := NewWindowDeduplicator(nil, keys, time.Minute, KeepLast); err != nil {
+		t.Errorf("keep=last without service: %v", err)
+	}
+}
+
+func TestWindowDeduplicatorHold(t *testing.T) {
+	d, err := NewWindow(WindowOptions{Key: "id", Window: time.Minute, Keep: KeepLast})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if prev, held := d.Hold(map[string]any{"id": "a"}, 1); !held || prev != nil {
+		t.Errorf("first Hold = %v, %v", prev, held)
+	}
+	if prev, held := d.Hold(map[string]any{"id": "a"}, 2); !held || prev != 1 {
+		t.Errorf("second Hold = %v, %v, want superseded 1", prev, held)
+	}
+	if _, held := d.Hold(map[string]any{"other": 1}, 3); held {
+		t.Error("record without key should not be held")
+	}
+	if released := d.Release(true); len(released) != 1 || released[0] != 2 {
+		t.Errorf("released = %v", released)
+	}
+}
@@ -1,7 +1,13 @@
 package executor
 
 import (
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
 )
 
 // configToSourceV2 map[string]any를 config.SourceV2로 변환
@@ -27,6 +33,12 @@ func configToSourceV2(cfg map[string]any) config.SourceV2 {
 	if v, ok := cfg["format"].(string); ok {
 		result.Format = v
 	}
+	if v, ok := cfg["compression"].(string); ok {
+		result.Compression = v
+	}
+	if v, ok := cfg["record_path"].(string); ok {
+		result.RecordPath = v
+	}
 
 	// SQL
 	if v, ok := cfg["driver"].(string); ok {
@@ -64,6 +76,24 @@ func configToSourceV2(cfg map[string]any) config.SourceV2 {
 	if v, ok := cfg["body"].(string); ok {
 		result.Body = v
 	}
+	if v, ok := cfg["rate_limit"].(float64); ok {
+		result.RateLimit = v
+	}
+
+	if v, ok := cfg["state"].([]any); ok {
+		for _, item := range v {
+			stateCfg, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			st := config.HTTPStateConfig{}
+			st.Key, _ = stateCfg["key"].(string)
+			st.Field, _ = stateCfg["field"].(string)
+			st.Cursor, _ = stateCfg["cursor"].(string)
+			st.Initial, _ = stateCfg["initial"].(string)
+			result.State = append(result.State, st)
+		}
+	}
 
 	// Auth
 	if authCfg, ok := cfg["auth"].(map[string]any); ok {
@@ -185,5 +215,134 @@ func configToSourceV2(cfg map[string]any) config.SourceV2 {
 		result.SlotName = v
 	}
 
+	// Elasticsearch / MongoDB
+	result.Addresses = stringList(cfg["addresses"])
+	if v, ok := cfg["api_key"].(string); ok {
+		result.APIKey = v
+	}
+	if v, ok := cfg["index"].(string); ok {
+		result.Index = v
+	}
+	result.Sort = stringList(cfg["sort"])
+	if v, ok := cfg["keep_alive"].(string); ok {
+		result.KeepAlive = v
+	}
+	if v, ok := cfg["uri"].(string); ok {
+		result.URI = v
+	}
+	if v, ok := cfg["collection"].(string); ok {
+		result.Collection = v
+	}
+	if v, ok := cfg["change_stream"].(bool); ok {
+		result.ChangeStream = v
+	}
+	if v, ok := cfg["query_filter"].(map[string]any); ok {
+		result.QueryFilter = v
+	}
+
+	// S3
+	if v, ok := cfg["bucket"].(string); ok {
+		result.Bucket = v
+	}
+	if v, ok := cfg["prefix"].(string); ok {
+		result.Prefix = v
+	}
+	if v, ok := cfg["pattern"].(string); ok {
+		result.Pattern = v
+	}
+	if v, ok := cfg["region"].(string); ok {
+		result.Region = v
+	}
+	if v, ok := cfg["endpoint"].(string); ok {
+		result.Endpoint = v
+	}
+	if v, ok := cfg["access_key"].(string); ok {
+		result.AccessKey = v
+	}
+	if v, ok := cfg["secret_key"].(string); ok {
+		result.SecretKey = v
+	}
+	if v, ok := cfg["after_read"].(map[string]any); ok {
+		afterRead := &config.S3AfterReadConfig{}
+		if s, ok := v["action"].(string); ok {
+			afterRead.Action = s
+		}
+		if s, ok := v["prefix"].(string); ok {
+			afterRead.Prefix = s
+		}
+		if tags, ok := v["tags"].(map[string]any); ok {
+			afterRead.Tags = make(map[string]string)
+			for k, tv := range tags {
+				if ts, ok := tv.(string); ok {
+					afterRead.Tags[k] = ts
+				}
+			}
+		}
+		result.AfterRead = afterRead
+	}
+
+	// Generate
+	if v, ok := cfg["mapping"].(string); ok {
+		result.Mapping = v
+	}
+	if v, ok := cfg["schema"].(map[string]any); ok {
+		if b, err := json.Marshal(v); err == nil {
+			result.Schema = &schema.DataSchema{}
+			if err := json.Unmarshal(b, result.Schema); err != nil {
+				result.Schema = nil
+			}
+		}
+	}
+	if v, ok := cfg["count"].(float64); ok {
+		result.Count = int(v)
+	}
+	if v, ok := cfg["seed"].(float64); ok {
+		result.Seed = int64(v)
+	}
+
+	return result
+}
+
+// stringList []any 설정 값을 문자열 목록으로 변환
+func stringList(v any) []string {
+	items, ok := v.([]any)
+	if !ok {
+		return nil
+	}
+	var result []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
 	return result
 }
+
+// configToDedupWindow dedup Stage 설정을 dedup.WindowOptions로 변환
+// namespace가 없으면 defaultNamespace(파이프라인 ID와 Stage 이름)로 저장소 키를 구분한다
+func configToDedupWindow(cfg map[string]any, defaultNamespace string) (dedup.WindowOptions, error) {
+	opts := dedup.WindowOptions{}
+	opts.Key, _ = cfg["key"].(string)
+	opts.Keep, _ = cfg["keep"].(string)
+	if v, ok := cfg["window"].(string); ok {
+		window, err := time.ParseDuration(v)
+		if err != nil {
+			return opts, fmt.Errorf("invalid dedup window: %w", err)
+		}
+		opts.Window = window
+	}
+
+	opts.Storage.Storage, _ = cfg["storage"].(string)
+	opts.Storage.RedisURL, _ = cfg["redis_url"].(string)
+	opts.Storage.SQLDriver, _ = cfg["sql_driver"].(string)
+	opts.Storage.SQLDSN, _ = cfg["sql_dsn"].(string)
+	opts.Storage.SQLTablePrefix, _ = cfg["sql_table_prefix"].(string)
+	if v, ok := cfg["max_entries"].(float64); ok {
+		opts.Storage.MaxEntries = int(v)
+	}
+	opts.Storage.Namespace, _ = cfg["namespace"].(string)
+	if opts.Storage.Namespace == "" {
+		opts.Storage.Namespace = defaultNamespace
+	}
+	return opts, nil
+}
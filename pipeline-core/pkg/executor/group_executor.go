@@ -4,6 +4,7 @@ package executor
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -296,14 +297,25 @@ func (e *GroupExecutor) runPipeline(ctx context.Context, pipeline types.GroupedP
 	statsCollector := NewStatsCollector(pipeline.ID, pipeline.Name)
 
 	result := &types.PipelineExecutionResult{
-		PipelineID:   pipeline.ID,
-		PipelineName: pipeline.Name,
-		Status:       "running",
-		StartedAt:    time.Now(),
+		PipelineID:    pipeline.ID,
+		PipelineName:  pipeline.Name,
+		Status:        "running",
+		StartedAt:     time.Now(),
+		SchemaVersion: pipeline.SchemaVersion,
 	}
 
+	// dedup Stage 윈도우는 실행마다 새로 만든다
+	run, err := newPipelineRun(pipeline, statsCollector)
+	if err != nil {
+		result.Status = "failed"
+		result.ErrorMessage = err.Error()
+		result.Statistics = statsCollector.GetStatistics()
+		return result, err
+	}
+	defer run.close()
+
 	// 소스 생성 및 실행
-	records, errs, err := e.createAndRunSource(ctx, pipeline.Source)
+	src, records, errs, err := e.createAndRunSource(ctx, pipeline.Source)
 	if err != nil {
 		result.Status = "failed"
 		result.ErrorMessage = err.Error()
@@ -312,6 +324,17 @@ func (e *GroupExecutor) runPipeline(ctx context.Context, pipeline types.GroupedP
 		return result, err
 	}
 
+	// 싱크 전송이 끝난 레코드를 Ack하면 체크포인트가 그 위치까지 전진하는 소스 (file 등)
+	run.acker, _ = src.(source.Acknowledger)
+
+	// keep=last dedup이 보류한 레코드는 윈도우가 닫히면 주기적으로 내보냄
+	var drainTick <-chan time.Time
+	if run.holds() {
+		ticker := time.NewTicker(drainInterval)
+		defer ticker.Stop()
+		drainTick = ticker.C
+	}
+
 	// 레코드 처리
 	for {
 		select {
@@ -321,19 +344,29 @@ func (e *GroupExecutor) runPipeline(ctx context.Context, pipeline types.GroupedP
 			stats := statsCollector.GetStatistics()
 			result.RecordsRead = stats.RecordsCollected
 			result.RecordsWritten = stats.RecordsProcessed
+			result.RecordsDuplicated = run.duplicates()
 			result.ErrorCount = stats.CollectionErrors + stats.ProcessingErrors
 			result.Statistics = stats
 			return result, ctx.Err()
 
+		case <-drainTick:
+			e.drainStages(ctx, run, false)
+
 		case record, ok := <-records:
 			if !ok {
-				// 완료
+				// 완료 (보류 중인 레코드를 모두 내보낸 뒤 체크포인트 확인)
+				e.drainStages(ctx, run, true)
 				now := time.Now()
 				result.CompletedAt = now
 				result.Status = "completed"
+				// 체크포인트를 지원하는 소스는 다음 실행에서 이어 읽을 상태를 반환
+				if cp, ok := src.(source.Checkpointer); ok {
+					result.Checkpoint = cp.GetCheckpoint()
+				}
 				stats := statsCollector.GetStatistics()
 				result.RecordsRead = stats.RecordsCollected
 				result.RecordsWritten = stats.RecordsProcessed
+				result.RecordsDuplicated = run.duplicates()
 				result.ErrorCount = stats.CollectionErrors + stats.ProcessingErrors
 				result.Statistics = stats
 				return result, nil
@@ -342,71 +375,115 @@ func (e *GroupExecutor) runPipeline(ctx context.Context, pipeline types.GroupedP
 			// 수집량 카운트
 			statsCollector.RecordCollected()
 
-			// Stage 적용 (필터별 처리량 추적)
-			data := record.Data
-			var filtered bool
-			for _, stage := range pipeline.Stages {
-				statsCollector.RecordTransformInput(stage.Name, stage.Type)
+			e.processRecord(ctx, run, record, record.Data, 0)
 
-				transformed, err := e.applyStage(data, stage)
-				if err != nil {
-					statsCollector.RecordTransformError(stage.Name)
-					filtered = true
-					break
-				}
+		case err := <-errs:
+			if err != nil {
+				statsCollector.RecordCollectionError()
+				result.ErrorMessage = err.Error()
+			}
+		}
+	}
+}
 
-				// Stage에서 nil 반환 = 필터링됨
-				if transformed == nil {
-					filtered = true
-					break
-				}
+// processRecord start번째 Stage부터 적용해 싱크로 전송하고, 처리가 끝난 레코드를 Ack
+func (e *GroupExecutor) processRecord(ctx context.Context, run *pipelineRun, record source.Record, data map[string]any, start int) {
+	// Stage 적용 (필터별 처리량 추적)
+	var filtered, failed bool
+	for i := start; i < len(run.pipeline.Stages); i++ {
+		stage := run.pipeline.Stages[i]
+		run.stats.RecordTransformInput(stage.Name, stage.Type)
+
+		transformed, err := e.applyStage(ctx, run, i, record, data)
+		if errors.Is(err, errHeld) {
+			// 보류된 레코드는 윈도우가 닫혀 기록된 뒤에 Ack
+			run.settle(ctx, true)
+			return
+		}
+		if err != nil {
+			run.stats.RecordTransformError(stage.Name)
+			filtered = true
+			failed = true
+			break
+		}
 
-				statsCollector.RecordTransformOutput(stage.Name)
-				data = transformed
-			}
+		// Stage에서 nil 반환 = 필터링됨
+		if transformed == nil {
+			filtered = true
+			break
+		}
 
-			// 필터링된 레코드는 Sink로 전송하지 않음
-			if filtered {
-				continue
-			}
+		run.stats.RecordTransformOutput(stage.Name)
+		data = transformed
+	}
 
-			// Sink로 전송 (처리량 추적)
-			for _, sink := range pipeline.Sinks {
-				if err := e.sendToSink(ctx, data, sink); err != nil {
-					statsCollector.RecordProcessingError()
-				} else {
-					statsCollector.RecordProcessed()
-				}
+	// 필터링된 레코드는 Sink로 전송하지 않음
+	sent := true
+	if !filtered {
+		// Sink로 전송 (처리량 추적)
+		for _, sink := range run.pipeline.Sinks {
+			if err := e.sendToSink(ctx, data, sink); err != nil {
+				run.stats.RecordProcessingError()
+				sent = false
+			} else {
+				run.stats.RecordProcessed()
 			}
+		}
+	}
 
-		case err := <-errs:
-			if err != nil {
-				statsCollector.RecordCollectionError()
-				result.ErrorMessage = err.Error()
-			}
+	// keep=first dedup은 기록된 레코드의 키만 처리됨으로 표시
+	run.settle(ctx, sent && !failed)
+
+	// 전송에 실패한 레코드는 Ack하지 않아 체크포인트가 넘어가지 않도록 함
+	if sent {
+		run.ack(ctx, record)
+	}
+}
+
+// drainStages keep=last dedup Stage가 보류한 레코드 중 윈도우가 닫힌 것(force면 모두)을 다음 Stage부터 처리
+func (e *GroupExecutor) drainStages(ctx context.Context, run *pipelineRun, force bool) {
+	for i, d := range run.dedups {
+		if d == nil || !d.keepLast {
+			continue
+		}
+		for _, released := range d.window.Release(force) {
+			h := released.(heldRecord)
+			run.stats.RecordTransformOutput(run.pipeline.Stages[i].Name)
+			e.processRecord(ctx, run, h.record, h.data, i+1)
 		}
 	}
 }
 
 // createAndRunSource 소스 생성 및 실행
-func (e *GroupExecutor) createAndRunSource(ctx context.Context, gs types.GroupedSource) (<-chan source.Record, <-chan error, error) {
+// 단일 소스인 경우 체크포인트 조회를 위해 소스를 함께 반환 (멀티 파티션은 nil)
+func (e *GroupExecutor) createAndRunSource(ctx context.Context, gs types.GroupedSource) (source.Source, <-chan source.Record, <-chan error, error) {
 	// 파티션이 있는 경우 멀티 소스 처리
 	if len(gs.Partitions) > 0 {
-		return e.runMultiPartitionSource(ctx, gs)
+		records, errs, err := e.runMultiPartitionSource(ctx, gs)
+		return nil, records, errs, err
 	}
 
 	// 단일 소스
 	src, err := e.createSource(gs)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
+	}
+
+	// 이전 실행에서 저장된 체크포인트 복구
+	if checkpoint, ok := gs.Config["checkpoint"].(map[string]any); ok {
+		if cp, ok := src.(source.Checkpointer); ok {
+			if err := cp.SetCheckpoint(checkpoint); err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to restore checkpoint: %w", err)
+			}
+		}
 	}
 
 	if err := src.Open(ctx); err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	records, errs := src.Read(ctx)
-	return records, errs, nil
+	return src, records, errs, nil
 }
 
 // runMultiPartitionSource 멀티 파티션 소스 실행
@@ -505,13 +582,30 @@ func (e *GroupExecutor) createSource(gs types.GroupedSource) (source.Source, err
 		return createCDCSourceFromConfig(gs.Config)
 	case "file":
 		return createFileSourceFromConfig(gs.Config)
+	case "elasticsearch":
+		return createElasticsearchSourceFromConfig(gs.Config)
+	case "mongodb":
+		return createMongoDBSourceFromConfig(gs.Config)
+	case "s3":
+		return createS3SourceFromConfig(gs.Config)
+	case "generate":
+		return createGenerateSourceFromConfig(gs.Config)
+	case "redis":
+		// Redis Streams 소스는 싱크 기록 후 Ack(XACK)가 필요하고 스트림이 끝나지 않으므로
+		// Ack와 체크포인트 저장을 하는 v2 파이프라인(pipeline.Pipeline)에서만 실행한다
+		return nil, fmt.Errorf("source type redis is not supported by the group executor; run it as a v2 pipeline")
 	default:
 		return nil, fmt.Errorf("unsupported source type: %s (config: %s)", gs.Type, string(configJSON))
 	}
 }
 
-// applyStage Stage 적용
-func (e *GroupExecutor) applyStage(data map[string]any, stage types.Stage) (map[string]any, error) {
+// applyStage index번째 Stage 적용
+// dedup Stage는 실행마다 만든 윈도우로 판정하며, keep=last가 레코드를 보류하면 errHeld를 반환한다
+func (e *GroupExecutor) applyStage(ctx context.Context, run *pipelineRun, index int, record source.Record, data map[string]any) (map[string]any, error) {
+	if d := run.dedups[index]; d != nil {
+		return run.applyDedup(ctx, d, record, data)
+	}
+
 	// TODO: 실제 변환 로직 구현
 	// Bloblang, filter, sample, aggregate 등
 	return data, nil
@@ -635,3 +729,27 @@ func createFileSourceFromConfig(config map[string]any) (source.Source, error) {
 	cfg.Type = "file"
 	return source.NewFileSource(cfg)
 }
+
+func createElasticsearchSourceFromConfig(config map[string]any) (source.Source, error) {
+	cfg := configToSourceV2(config)
+	cfg.Type = "elasticsearch"
+	return source.NewElasticsearchSource(cfg)
+}
+
+func createMongoDBSourceFromConfig(config map[string]any) (source.Source, error) {
+	cfg := configToSourceV2(config)
+	cfg.Type = "mongodb"
+	return source.NewMongoDBSource(cfg)
+}
+
+func createS3SourceFromConfig(config map[string]any) (source.Source, error) {
+	cfg := configToSourceV2(config)
+	cfg.Type = "s3"
+	return source.NewS3Source(cfg)
+}
+
+func createGenerateSourceFromConfig(config map[string]any) (source.Source, error) {
+	cfg := configToSourceV2(config)
+	cfg.Type = "generate"
+	return source.NewGenerateSource(cfg)
+}
@@ -0,0 +1,64 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/conduix/conduix/shared/types"
+)
+
+func TestRunPipelineDedupStage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.json")
+	lines := "{\"id\":\"a\",\"rev\":1}\n{\"id\":\"b\",\"rev\":1}\n{\"id\":\"a\",\"rev\":2}\n{\"id\":\"c\",\"rev\":1}\n{\"id\":\"b\",\"rev\":2}\n"
+	if err := os.WriteFile(path, []byte(lines), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, keep := range []string{"first", "last"} {
+		t.Run(keep, func(t *testing.T) {
+			pipeline := types.GroupedPipeline{
+				ID:     "orders",
+				Name:   "orders",
+				Source: types.GroupedSource{Type: "file", Name: "orders", Config: map[string]any{"path": path}},
+				Stages: []types.Stage{{Name: "dedup", Type: "dedup", Config: map[string]any{
+					"key":    ".id",
+					"window": "1h",
+					"keep":   keep,
+				}}},
+				Sinks: []types.GroupedSink{{Type: "stdout", Name: "out"}},
+			}
+
+			e := NewGroupExecutor(&types.PipelineGroup{Pipelines: []types.GroupedPipeline{pipeline}})
+			result, err := e.runPipeline(context.Background(), pipeline)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if result.RecordsRead != 5 || result.RecordsWritten != 3 || result.RecordsDuplicated != 2 {
+				t.Errorf("read = %d, written = %d, duplicates = %d, want 5, 3, 2",
+					result.RecordsRead, result.RecordsWritten, result.RecordsDuplicated)
+			}
+			// keep=last로 보류된 레코드도 실행 끝에 기록된 뒤 Ack되어 체크포인트가 파일 끝까지 전진
+			files, _ := result.Checkpoint["files"].([]types.FileOffset)
+			if len(files) != 1 || files[0].LineNumber != 5 {
+				t.Errorf("checkpoint = %+v, want line 5", result.Checkpoint)
+			}
+		})
+	}
+}
+
+func TestRunPipelineInvalidDedupStage(t *testing.T) {
+	pipeline := types.GroupedPipeline{
+		ID:     "orders",
+		Source: types.GroupedSource{Type: "generate", Config: map[string]any{"mapping": "root.id = 1"}},
+		Stages: []types.Stage{{Name: "dedup", Type: "dedup", Config: map[string]any{"key": ".id", "window": "1h", "keep": "middle"}}},
+	}
+
+	e := NewGroupExecutor(&types.PipelineGroup{Pipelines: []types.GroupedPipeline{pipeline}})
+	result, err := e.runPipeline(context.Background(), pipeline)
+	if err == nil || result.Status != "failed" {
+		t.Errorf("status = %s, err = %v, want failed", result.Status, err)
+	}
+}
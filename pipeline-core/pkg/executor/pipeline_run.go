@@ -0,0 +1,143 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+	"github.com/conduix/conduix/shared/types"
+)
+
+// drainInterval keep=last dedup Stage가 보류한 레코드를 내보내는 주기
+const drainInterval = time.Second
+
+// errHeld keep=last dedup Stage가 레코드를 보류함 (윈도우가 닫히면 다음 Stage부터 이어서 처리)
+var errHeld = errors.New("record held")
+
+// pipelineRun 파이프라인 한 번 실행의 처리 상태
+type pipelineRun struct {
+	pipeline types.GroupedPipeline
+	stats    *StatsCollector
+	acker    source.Acknowledger
+	dedups   []*dedupStage // Stage 위치별 dedup 상태 (dedup이 아닌 Stage는 nil)
+}
+
+// dedupStage 실행 중인 dedup Stage의 윈도우
+type dedupStage struct {
+	window   *dedup.WindowDeduplicator
+	keepLast bool
+}
+
+// heldRecord keep=last로 보류한 레코드와 보류 시점의 데이터
+type heldRecord struct {
+	record source.Record
+	data   map[string]any
+}
+
+// newPipelineRun Stage 설정으로 dedup 윈도우를 만들어 실행 상태 생성
+func newPipelineRun(pipeline types.GroupedPipeline, stats *StatsCollector) (*pipelineRun, error) {
+	run := &pipelineRun{
+		pipeline: pipeline,
+		stats:    stats,
+		dedups:   make([]*dedupStage, len(pipeline.Stages)),
+	}
+	for i, stage := range pipeline.Stages {
+		if stage.Type != "dedup" {
+			continue
+		}
+		opts, err := configToDedupWindow(stage.Config, dedup.NamespaceOf(pipeline.ID, stage.Name))
+		if err != nil {
+			run.close()
+			return nil, fmt.Errorf("invalid dedup stage %s: %w", stage.Name, err)
+		}
+		window, err := dedup.NewWindow(opts)
+		if err != nil {
+			run.close()
+			return nil, fmt.Errorf("invalid dedup stage %s: %w", stage.Name, err)
+		}
+		run.dedups[i] = &dedupStage{window: window, keepLast: opts.Keep == dedup.KeepLast}
+	}
+	return run, nil
+}
+
+// applyDedup keep=first는 중복이면 nil을 반환하고, keep=last는 레코드를 보류하며 errHeld를 반환
+// 같은 윈도우에서 대체된 이전 레코드는 더 이상 기록되지 않으므로 바로 Ack한다
+func (r *pipelineRun) applyDedup(ctx context.Context, d *dedupStage, record source.Record, data map[string]any) (map[string]any, error) {
+	if d.keepLast {
+		superseded, held := d.window.Hold(data, heldRecord{record: record, data: data})
+		if !held {
+			return data, nil
+		}
+		if prev, ok := superseded.(heldRecord); ok {
+			r.ack(ctx, prev.record)
+		}
+		return nil, errHeld
+	}
+
+	emit, err := d.window.Offer(ctx, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !emit {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// settle keep=first dedup에 레코드 처리 결과 통지 (기록, 필터링, 보류면 키를 표시하고 실패면 놓아줌)
+func (r *pipelineRun) settle(ctx context.Context, ok bool) {
+	for _, d := range r.dedups {
+		if d == nil || d.keepLast {
+			continue
+		}
+		if !ok {
+			d.window.Abandon()
+			continue
+		}
+		// 표시에 실패하면 이후 같은 키가 한 번 더 통과할 뿐이므로 처리 오류로 세지 않음
+		_ = d.window.Commit(ctx)
+	}
+}
+
+// ack 처리가 끝난 레코드를 소스에 통지
+func (r *pipelineRun) ack(ctx context.Context, record source.Record) {
+	if r.acker == nil {
+		return
+	}
+	if err := r.acker.Ack(ctx, []source.Record{record}); err != nil {
+		r.stats.RecordProcessingError()
+	}
+}
+
+// holds 레코드를 보류하는 keep=last dedup Stage가 있는지
+func (r *pipelineRun) holds() bool {
+	for _, d := range r.dedups {
+		if d != nil && d.keepLast {
+			return true
+		}
+	}
+	return false
+}
+
+// duplicates dedup Stage들이 제거한 중복 레코드 수
+func (r *pipelineRun) duplicates() int64 {
+	var n int64
+	for _, d := range r.dedups {
+		if d != nil {
+			n += d.window.Duplicates()
+		}
+	}
+	return n
+}
+
+// close dedup 윈도우의 저장소 종료
+func (r *pipelineRun) close() {
+	for _, d := range r.dedups {
+		if d != nil {
+			_ = d.window.Close()
+		}
+	}
+}
@@ -3,10 +3,12 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/conduix/conduix/pipeline-core/pkg/actor"
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
 	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
 	"github.com/conduix/conduix/pipeline-core/pkg/processor"
@@ -14,6 +16,24 @@ import (
 	"github.com/conduix/conduix/pipeline-core/pkg/source"
 )
 
+// Acknowledger 소스에 Ack를 보내는 주기와 최대 대기 레코드 수
+const (
+	ackInterval  = time.Second
+	ackBatchSize = 500
+)
+
+// checkpointInterval 실행 중 소스 체크포인트 저장 주기
+const checkpointInterval = 10 * time.Second
+
+// shutdownTimeout 취소된 실행을 마치며 싱크 Flush와 체크포인트 저장에 주는 시간
+const shutdownTimeout = 10 * time.Second
+
+// drainInterval 보류 단계(dedup keep=last)의 닫힌 윈도우를 확인하는 주기
+const drainInterval = time.Second
+
+// dedupBatchSize 중복 일괄 조회(BatchDedupService)로 한 번에 확인하는 최대 레코드 수
+const dedupBatchSize = 100
+
 // Pipeline 파이프라인 실행기
 type Pipeline struct {
 	config     *config.PipelineConfigV2
@@ -21,11 +41,15 @@ type Pipeline struct {
 	processors []processor.Processor
 	sink       sink.Sink
 	dedup      dedup.DedupService
+	versions   dedup.VersionTracker // version_field가 설정된 경우만 사용
+
+	checkpointer actor.Checkpointer // 소스 체크포인트 저장소 (없으면 저장/복구 안 함)
 
 	// 실시간 설정
-	idField        string
+	eventKeys      *dedup.KeyBuilder // 중복 체크용 이벤트 키
+	entityKeys     *dedup.KeyBuilder // 엔티티 키 (Upsert, 버전 추적)
 	eventTypeField string
-	entityIDField  string
+	versionField   string
 
 	// 통계
 	stats Stats
@@ -40,10 +64,26 @@ type Stats struct {
 	FilteredCount  int64
 	ErrorCount     int64
 	DuplicateCount int64
+	StaleCount     int64        // 마지막 적용 버전보다 오래되어 버려진 이벤트 수
+	Dedup          *dedup.Stats // 중복 제거 저장소 통계 (실시간 모드)
+
+	// dedup 단계별 제거된 중복 수 (단계 이름 → 수)
+	StepDuplicates map[string]int64
+}
+
+// Option 파이프라인 옵션
+type Option func(*Pipeline)
+
+// WithCheckpointer 소스 체크포인트 저장소 설정
+// 체크포인트는 /<파이프라인>/<소스> 경로에 저장되고 Run 시작 시 소스에 복구된다
+func WithCheckpointer(cp actor.Checkpointer) Option {
+	return func(p *Pipeline) {
+		p.checkpointer = cp
+	}
 }
 
 // New 새 파이프라인 생성
-func New(cfg *config.PipelineConfigV2) (*Pipeline, error) {
+func New(cfg *config.PipelineConfigV2, opts ...Option) (*Pipeline, error) {
 	// 소스 생성
 	src, err := source.NewSource(cfg.Source)
 	if err != nil {
@@ -53,6 +93,12 @@ func New(cfg *config.PipelineConfigV2) (*Pipeline, error) {
 	// 프로세서 생성
 	processors := make([]processor.Processor, 0, len(cfg.Steps))
 	for _, step := range cfg.Steps {
+		if step.Dedup != nil {
+			// 같은 저장소를 쓰는 다른 파이프라인/단계와 키가 섞이지 않도록 구분
+			d := *step.Dedup
+			d.Namespace = dedup.NamespaceOf(cfg.Name, step.Name)
+			step.Dedup = &d
+		}
 		p, err := processor.NewProcessor(step)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create processor %s: %w", step.Name, err)
@@ -72,18 +118,44 @@ func New(cfg *config.PipelineConfigV2) (*Pipeline, error) {
 		processors: processors,
 		sink:       snk,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
 
 	// 실시간 모드 설정
 	if cfg.IsRealtime() && cfg.Realtime != nil {
 		ttl, _ := time.ParseDuration(cfg.Realtime.DedupTTL)
-		dedupSvc, err := dedup.NewDedupService(cfg.Realtime.DedupStorage, ttl)
+		tombstoneTTL, _ := time.ParseDuration(cfg.Realtime.TombstoneTTL)
+		dedupSvc, err := dedup.New(dedup.Options{
+			Storage:           cfg.Realtime.DedupStorage,
+			TTL:               ttl,
+			MaxEntries:        cfg.Realtime.DedupMaxEntries,
+			FalsePositiveRate: cfg.Realtime.DedupFalsePositiveRate,
+			Buckets:           cfg.Realtime.DedupBuckets,
+			TombstoneTTL:      tombstoneTTL,
+			RedisURL:          cfg.Realtime.DedupRedisURL,
+			SQLDriver:         cfg.Realtime.DedupSQLDriver,
+			SQLDSN:            cfg.Realtime.DedupSQLDSN,
+			SQLTablePrefix:    cfg.Realtime.DedupSQLTablePrefix,
+			Namespace:         cfg.Name,
+		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create dedup service: %w", err)
 		}
 		p.dedup = dedupSvc
-		p.idField = cfg.Realtime.IDField
+		p.eventKeys = dedup.NewKeyBuilder(cfg.Realtime.EventKeyFields()...)
+		p.entityKeys = dedup.NewKeyBuilder(cfg.Realtime.EntityKeyFields()...)
 		p.eventTypeField = cfg.Realtime.EventTypeField
-		p.entityIDField = cfg.Realtime.EntityIDField
+
+		if cfg.Realtime.VersionField != "" {
+			versions, ok := dedupSvc.(dedup.VersionTracker)
+			if !ok {
+				_ = dedupSvc.Close()
+				return nil, fmt.Errorf("dedup storage %s does not support version_field", cfg.Realtime.DedupStorage)
+			}
+			p.versions = versions
+			p.versionField = cfg.Realtime.VersionField
+		}
 	}
 
 	return p, nil
@@ -98,6 +170,11 @@ func (p *Pipeline) Run(ctx context.Context) error {
 
 	log.Printf("[pipeline] Starting %s (mode=%s)", p.config.Name, p.config.Mode)
 
+	// 저장된 체크포인트 복구 (Read 전에 설정)
+	if err := p.restoreCheckpoint(); err != nil {
+		return err
+	}
+
 	// 소스 열기
 	if err := p.source.Open(ctx); err != nil {
 		return fmt.Errorf("failed to open source: %w", err)
@@ -113,12 +190,67 @@ func (p *Pipeline) Run(ctx context.Context) error {
 	// 데이터 읽기
 	records, errs := p.source.Read(ctx)
 
+	// 레코드를 보류하는 단계(dedup keep=last)가 있으면 주기적으로 내보냄
+	var drainTick <-chan time.Time
+	for _, proc := range p.processors {
+		if _, ok := proc.(processor.Drainer); ok {
+			ticker := time.NewTicker(drainInterval)
+			defer ticker.Stop()
+			drainTick = ticker.C
+			break
+		}
+	}
+
+	// 싱크 확정 후 Ack를 받는 소스 (outbox 등)
+	acker, _ := p.source.(source.Acknowledger)
+	_, ordered := p.source.(source.OrderedAcker)
+	var pending []source.Record
+	var ackTick <-chan time.Time
+	if acker != nil {
+		ticker := time.NewTicker(ackInterval)
+		defer ticker.Stop()
+		ackTick = ticker.C
+	}
+
+	// 끝나지 않는 소스(tail, cdc 등)도 재시작 시 이어 읽도록 실행 중에 주기적으로 저장
+	var checkpointTick <-chan time.Time
+	if _, ok := p.source.(source.Checkpointer); ok && p.checkpointer != nil {
+		ticker := time.NewTicker(checkpointInterval)
+		defer ticker.Stop()
+		checkpointTick = ticker.C
+	}
+
 	// 처리 루프
 	for {
 		select {
 		case <-ctx.Done():
+			p.shutdown(ctx, acker, &pending)
 			return ctx.Err()
 
+		case <-drainTick:
+			settled, err := p.drainProcessors(ctx, false)
+			if acker != nil {
+				pending = append(pending, settled...)
+			}
+			if err != nil && ordered {
+				p.shutdown(ctx, acker, &pending)
+				return err
+			}
+
+		case <-ackTick:
+			if err := p.flushAndAck(ctx, acker, &pending); err != nil {
+				log.Printf("[pipeline] Ack error: %v", err)
+			}
+
+		case <-checkpointTick:
+			if err := p.flushAndAck(ctx, acker, &pending); err != nil {
+				log.Printf("[pipeline] Ack error: %v", err)
+				continue
+			}
+			if err := p.saveCheckpoint(); err != nil {
+				log.Printf("[pipeline] Checkpoint error: %v", err)
+			}
+
 		case err, ok := <-errs:
 			if !ok {
 				errs = nil
@@ -133,15 +265,57 @@ func (p *Pipeline) Run(ctx context.Context) error {
 			if !ok {
 				// 소스 완료
 				log.Printf("[pipeline] Source completed")
-				return p.sink.Flush(ctx)
+				settled, drainErr := p.drainProcessors(ctx, true)
+				if acker != nil {
+					pending = append(pending, settled...)
+				}
+				if err := p.flushAndAck(ctx, acker, &pending); err != nil {
+					return err
+				}
+				if err := p.saveCheckpoint(); err != nil {
+					return err
+				}
+				if ordered {
+					return drainErr
+				}
+				return nil
 			}
 
-			p.stats.TotalRecords++
-
-			// 레코드 처리
-			if err := p.processRecord(ctx, record); err != nil {
-				log.Printf("[pipeline] Process error: %v", err)
-				p.stats.ErrorCount++
+			// 이미 도착한 레코드를 모아 중복 여부를 한 번에 조회
+			batch := p.collectBatch(record, records)
+			dups := p.lookupDuplicates(ctx, batch)
+
+			for _, record := range batch {
+				p.stats.TotalRecords++
+
+				// 레코드 처리 (실패한 레코드는 Ack하지 않아 소스가 다시 전달하도록 함)
+				if err := p.processRecord(ctx, record, dups); err != nil {
+					var held *processor.HeldError
+					if !errors.As(err, &held) {
+						log.Printf("[pipeline] Process error: %v", err)
+						p.stats.ErrorCount++
+						if ordered {
+							// 실패한 레코드는 다시 오지 않고 체크포인트도 그 앞에서 멈추므로,
+							// 앞선 레코드까지 저장하고 중단해 재시작 시 실패한 레코드부터 다시 읽음
+							p.shutdown(ctx, acker, &pending)
+							return fmt.Errorf("stopping on failed record from %s (offset %s): %w", p.source.Name(), record.Metadata.Offset, err)
+						}
+						continue
+					}
+					// 보류된 레코드는 Drain으로 싱크에 기록된 뒤 Ack하고, 지금은 대체된 이전 레코드만 Ack
+					if held.Superseded == nil {
+						continue
+					}
+					record = *held.Superseded
+				}
+				if acker != nil {
+					pending = append(pending, record)
+					if len(pending) >= ackBatchSize {
+						if err := p.flushAndAck(ctx, acker, &pending); err != nil {
+							log.Printf("[pipeline] Ack error: %v", err)
+						}
+					}
+				}
 			}
 		}
 
@@ -151,15 +325,140 @@ func (p *Pipeline) Run(ctx context.Context) error {
 		}
 	}
 
+	_, _ = p.drainProcessors(ctx, true)
 	return p.sink.Flush(ctx)
 }
 
-func (p *Pipeline) processRecord(ctx context.Context, record source.Record) error {
+// shutdown 취소된 실행에서 기록된 레코드까지 Flush/Ack하고 체크포인트 저장
+// 보류 중이거나 아직 기록되지 않은 레코드는 체크포인트에 들어가지 않아 다음 실행에서 다시 읽는다
+func (p *Pipeline) shutdown(ctx context.Context, acker source.Acknowledger, pending *[]source.Record) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+
+	if err := p.flushAndAck(ctx, acker, pending); err != nil {
+		log.Printf("[pipeline] Ack error on shutdown: %v", err)
+		return
+	}
+	if err := p.saveCheckpoint(); err != nil {
+		log.Printf("[pipeline] Checkpoint error on shutdown: %v", err)
+	}
+}
+
+// flushAndAck 싱크를 Flush한 뒤 기록이 확정된 레코드를 소스에 Ack (acker가 nil이면 Flush만)
+func (p *Pipeline) flushAndAck(ctx context.Context, acker source.Acknowledger, pending *[]source.Record) error {
+	if err := p.sink.Flush(ctx); err != nil {
+		return fmt.Errorf("sink flush failed: %w", err)
+	}
+	if acker == nil || len(*pending) == 0 {
+		return nil
+	}
+	if err := acker.Ack(ctx, *pending); err != nil {
+		return fmt.Errorf("source ack failed: %w", err)
+	}
+	*pending = nil
+	return nil
+}
+
+// checkpointPath 소스 체크포인트 저장 경로
+func (p *Pipeline) checkpointPath() string {
+	return fmt.Sprintf("/%s/%s", p.config.Name, p.source.Name())
+}
+
+// restoreCheckpoint 저장된 체크포인트를 소스에 설정
+func (p *Pipeline) restoreCheckpoint() error {
+	cp, ok := p.source.(source.Checkpointer)
+	if !ok || p.checkpointer == nil {
+		return nil
+	}
+	checkpoint, err := p.checkpointer.Load(p.checkpointPath())
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint: %w", err)
+	}
+	if len(checkpoint) == 0 {
+		return nil
+	}
+	if err := cp.SetCheckpoint(checkpoint); err != nil {
+		return fmt.Errorf("failed to restore checkpoint: %w", err)
+	}
+	return nil
+}
+
+// saveCheckpoint 소스 체크포인트를 저장한 뒤 CheckpointAcker 소스에 저장 완료를 통지
+// 싱크 Flush(와 Ack)가 끝난 뒤에 호출해야 한다
+func (p *Pipeline) saveCheckpoint() error {
+	cp, ok := p.source.(source.Checkpointer)
+	if !ok || p.checkpointer == nil {
+		return nil
+	}
+	checkpoint := cp.GetCheckpoint()
+	if err := p.checkpointer.Save(p.checkpointPath(), checkpoint); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	if acker, ok := cp.(source.CheckpointAcker); ok {
+		if err := acker.AckCheckpoint(checkpoint); err != nil {
+			return fmt.Errorf("checkpoint ack failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// batchDedup 중복 일괄 조회를 지원하는 실시간 dedup 저장소 (없으면 nil)
+func (p *Pipeline) batchDedup() dedup.BatchDedupService {
+	if !p.config.IsRealtime() || p.dedup == nil {
+		return nil
+	}
+	batch, _ := p.dedup.(dedup.BatchDedupService)
+	return batch
+}
+
+// collectBatch 첫 레코드에 이어 채널에 이미 도착한 레코드를 기다리지 않고 모음
+// 일괄 조회를 쓸 수 없으면 첫 레코드만 반환한다. 채널이 닫혔으면 다음 수신에서 완료를 처리한다
+func (p *Pipeline) collectBatch(first source.Record, records <-chan source.Record) []source.Record {
+	batch := []source.Record{first}
+	if p.batchDedup() == nil {
+		return batch
+	}
+	for len(batch) < dedupBatchSize {
+		select {
+		case record, ok := <-records:
+			if !ok {
+				return batch
+			}
+			batch = append(batch, record)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// lookupDuplicates 배치의 이벤트 키를 한 번에 조회 (일괄 조회를 쓸 수 없거나 실패하면 nil: 레코드별로 조회)
+func (p *Pipeline) lookupDuplicates(ctx context.Context, batch []source.Record) map[string]bool {
+	svc := p.batchDedup()
+	if svc == nil {
+		return nil
+	}
+	ids := make([]string, 0, len(batch))
+	for _, record := range batch {
+		if eventID, ok := p.eventKeys.Key(record.Data); ok {
+			ids = append(ids, eventID)
+		}
+	}
+	dups, err := svc.IsDuplicateBatch(ctx, ids)
+	if err != nil {
+		log.Printf("[pipeline] Batch dedup check failed, checking per record: %v", err)
+		return nil
+	}
+	return dups
+}
+
+// processRecord 레코드 하나를 처리
+// dups는 lookupDuplicates로 미리 조회한 중복 이벤트 키이며, nil이면 저장소에 직접 조회한다
+func (p *Pipeline) processRecord(ctx context.Context, record source.Record, dups map[string]bool) error {
 	// 실시간 모드: 중복 체크
 	if p.config.IsRealtime() && p.dedup != nil {
-		eventID := p.getField(record, p.idField)
-		if eventID != "" {
-			isDup, err := p.dedup.IsDuplicate(ctx, eventID)
+		if eventID, ok := p.eventKeys.Key(record.Data); ok {
+			isDup, err := p.isDuplicate(ctx, eventID, dups)
 			if err != nil {
 				return fmt.Errorf("dedup check failed: %w", err)
 			}
@@ -170,15 +469,99 @@ func (p *Pipeline) processRecord(ctx context.Context, record source.Record) erro
 		}
 	}
 
+	// 실시간 모드: 마지막 적용 버전보다 오래된 이벤트 버림
+	version, hasVersion, err := p.eventVersion(record)
+	if err != nil {
+		return err
+	}
+	if hasVersion {
+		entityID, _ := p.entityKeys.Key(record.Data)
+		eventType := dedup.EventType(p.getField(record, p.eventTypeField))
+		stale, err := p.versions.IsStale(ctx, entityID, version, eventType)
+		if err != nil {
+			return fmt.Errorf("version check failed: %w", err)
+		}
+		if stale {
+			p.stats.StaleCount++
+			return nil // 오래된 이벤트 스킵
+		}
+	}
+
 	// 실시간 모드: Upsert 로직
 	if p.config.IsRealtime() && p.dedup != nil && p.eventTypeField != "" {
 		record = p.applyUpsertLogic(ctx, record)
 	}
 
+	return p.processFrom(ctx, record, 0, version, hasVersion)
+}
+
+// isDuplicate 이벤트 키의 중복 여부
+// 미리 조회한 결과가 있으면 사용하고, 같은 배치에서 다시 나오는 키는 중복으로 보도록 결과에 기록한다
+func (p *Pipeline) isDuplicate(ctx context.Context, eventID string, dups map[string]bool) (bool, error) {
+	if dups == nil {
+		return p.dedup.IsDuplicate(ctx, eventID)
+	}
+	if dups[eventID] {
+		return true, nil
+	}
+	dups[eventID] = true
+	return false, nil
+}
+
+// drainProcessors 단계가 보류했던 레코드를 이후 단계와 싱크로 보냄 (force: 윈도우와 관계없이 모두)
+// 처리를 마친 레코드(이후 단계에서 대체된 레코드 포함)를 Ack 대상으로, 처음 실패한 레코드의 오류를 함께 반환한다
+func (p *Pipeline) drainProcessors(ctx context.Context, force bool) ([]source.Record, error) {
+	var settled []source.Record
+	var failed error
+	for i, proc := range p.processors {
+		d, ok := proc.(processor.Drainer)
+		if !ok {
+			continue
+		}
+		records, err := d.Drain(ctx, force)
+		if err != nil {
+			log.Printf("[pipeline] Drain error: %v", err)
+			p.stats.ErrorCount++
+			if failed == nil {
+				failed = err
+			}
+		}
+		for _, record := range records {
+			version, hasVersion, _ := p.eventVersion(record)
+			err := p.processFrom(ctx, record, i+1, version, hasVersion)
+			var held *processor.HeldError
+			switch {
+			case errors.As(err, &held):
+				if held.Superseded != nil {
+					settled = append(settled, *held.Superseded)
+				}
+			case err != nil:
+				log.Printf("[pipeline] Process error: %v", err)
+				p.stats.ErrorCount++
+				if failed == nil {
+					failed = err
+				}
+			default:
+				settled = append(settled, record)
+			}
+		}
+	}
+	return settled, failed
+}
+
+// processFrom start번째 프로세서부터 적용한 뒤 싱크에 기록하고 실시간 상태를 갱신
+// 끝나면 결과를 프로세서에 통지해 dedup keep=first가 기록된 레코드의 키만 표시하도록 함
+func (p *Pipeline) processFrom(ctx context.Context, record source.Record, start int, version int64, hasVersion bool) (err error) {
+	defer func() { p.settleProcessors(ctx, err) }()
+
 	// 프로세서 체인 실행
 	current := &record
-	for _, proc := range p.processors {
+	for _, proc := range p.processors[start:] {
 		result, err := proc.Process(ctx, *current)
+		var held *processor.HeldError
+		if errors.As(err, &held) {
+			return err // 보류됨 (Drain으로 다시 들어옴)
+		}
 		if err != nil {
 			return fmt.Errorf("processor %s failed: %w", proc.Name(), err)
 		}
@@ -198,17 +581,16 @@ func (p *Pipeline) processRecord(ctx context.Context, record source.Record) erro
 
 	// 실시간 모드: 처리 완료 표시
 	if p.config.IsRealtime() && p.dedup != nil {
-		eventID := p.getField(record, p.idField)
-		if eventID != "" {
+		if eventID, ok := p.eventKeys.Key(record.Data); ok {
 			if err := p.dedup.MarkProcessed(ctx, eventID); err != nil {
 				log.Printf("[pipeline] Warning: failed to mark processed: %v", err)
 			}
 		}
 
 		// 엔티티 상태 업데이트
-		entityID := p.getField(record, p.entityIDField)
+		entityID, hasEntity := p.entityKeys.Key(record.Data)
 		eventType := p.getField(record, p.eventTypeField)
-		if entityID != "" {
+		if hasEntity {
 			switch dedup.EventType(eventType) {
 			case dedup.EventCreate, dedup.EventUpdate:
 				_ = p.dedup.SetEntityExists(ctx, entityID)
@@ -216,17 +598,61 @@ func (p *Pipeline) processRecord(ctx context.Context, record source.Record) erro
 				_ = p.dedup.DeleteEntity(ctx, entityID)
 			}
 		}
+
+		if hasVersion {
+			if err := p.versions.ApplyVersion(ctx, entityID, version, dedup.EventType(eventType)); err != nil {
+				log.Printf("[pipeline] Warning: failed to apply version: %v", err)
+			}
+		}
 	}
 
 	return nil
 }
 
+// settleProcessors 레코드 처리 결과 통지 (기록, 필터링, 보류는 Commit, 실패는 Abandon)
+func (p *Pipeline) settleProcessors(ctx context.Context, err error) {
+	var held *processor.HeldError
+	settled := err == nil || errors.As(err, &held)
+	for _, proc := range p.processors {
+		c, ok := proc.(processor.Committer)
+		if !ok {
+			continue
+		}
+		if !settled {
+			c.Abandon()
+			continue
+		}
+		if err := c.Commit(ctx); err != nil {
+			log.Printf("[pipeline] Warning: failed to commit %s: %v", proc.Name(), err)
+		}
+	}
+}
+
+// eventVersion 레코드의 버전 필드 값 (version_field가 없거나 엔티티 ID/버전 값이 없으면 hasVersion=false)
+func (p *Pipeline) eventVersion(record source.Record) (version int64, hasVersion bool, err error) {
+	if p.versions == nil {
+		return 0, false, nil
+	}
+	if _, ok := p.entityKeys.Key(record.Data); !ok {
+		return 0, false, nil
+	}
+	raw, ok := record.Data[p.versionField]
+	if !ok || raw == nil {
+		return 0, false, nil
+	}
+	version, err = dedup.ParseVersion(raw)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s: %w", p.versionField, err)
+	}
+	return version, true, nil
+}
+
 // applyUpsertLogic UPDATE 이벤트인데 엔티티가 없으면 CREATE로 변환
 func (p *Pipeline) applyUpsertLogic(ctx context.Context, record source.Record) source.Record {
 	eventType := p.getField(record, p.eventTypeField)
-	entityID := p.getField(record, p.entityIDField)
+	entityID, hasEntity := p.entityKeys.Key(record.Data)
 
-	if eventType == string(dedup.EventUpdate) && entityID != "" {
+	if eventType == string(dedup.EventUpdate) && hasEntity {
 		exists, err := p.dedup.EntityExists(ctx, entityID)
 		if err != nil {
 			log.Printf("[pipeline] Warning: entity check failed: %v", err)
@@ -260,7 +686,20 @@ func (p *Pipeline) getField(record source.Record, field string) string {
 
 // Stats 현재 통계 반환
 func (p *Pipeline) Stats() Stats {
-	return p.stats
+	stats := p.stats
+	if reporter, ok := p.dedup.(dedup.StatsReporter); ok {
+		dedupStats := reporter.Stats()
+		stats.Dedup = &dedupStats
+	}
+	for _, proc := range p.processors {
+		if dc, ok := proc.(processor.DuplicateCounter); ok {
+			if stats.StepDuplicates == nil {
+				stats.StepDuplicates = make(map[string]int64)
+			}
+			stats.StepDuplicates[proc.Name()] = dc.Duplicates()
+		}
+	}
+	return stats
 }
 
 // Close 파이프라인 리소스 정리
@@ -268,5 +707,10 @@ func (p *Pipeline) Close() error {
 	if p.dedup != nil {
 		_ = p.dedup.Close()
 	}
+	for _, proc := range p.processors {
+		if c, ok := proc.(interface{ Close() error }); ok {
+			_ = c.Close()
+		}
+	}
 	return nil
 }
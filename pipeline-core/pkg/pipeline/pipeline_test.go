@@ -0,0 +1,396 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
+	"github.com/conduix/conduix/pipeline-core/pkg/processor"
+	"github.com/conduix/conduix/pipeline-core/pkg/sink"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+// ackSource Ack된 레코드 수를 체크포인트로 내보내는 소스
+type ackSource struct {
+	records  []source.Record
+	restored map[string]any
+	acked    int
+	log      *[]string
+}
+
+func (s *ackSource) Open(ctx context.Context) error { return nil }
+func (s *ackSource) Close() error                   { return nil }
+func (s *ackSource) Name() string                   { return "events" }
+
+func (s *ackSource) Read(ctx context.Context) (<-chan source.Record, <-chan error) {
+	out := make(chan source.Record, len(s.records))
+	for _, r := range s.records {
+		out <- r
+	}
+	close(out)
+	return out, nil
+}
+
+func (s *ackSource) Ack(ctx context.Context, records []source.Record) error {
+	s.acked += len(records)
+	*s.log = append(*s.log, fmt.Sprintf("ack %d", len(records)))
+	return nil
+}
+
+func (s *ackSource) GetCheckpoint() map[string]any {
+	return map[string]any{"acked": s.acked}
+}
+
+func (s *ackSource) SetCheckpoint(checkpoint map[string]any) error {
+	s.restored = checkpoint
+	return nil
+}
+
+func (s *ackSource) AckCheckpoint(checkpoint map[string]any) error {
+	*s.log = append(*s.log, fmt.Sprintf("checkpoint ack %v", checkpoint["acked"]))
+	return nil
+}
+
+// memorySink 기록된 레코드 수만 세는 싱크
+type memorySink struct {
+	written int
+	log     *[]string
+}
+
+func (s *memorySink) Open(ctx context.Context) error { return nil }
+func (s *memorySink) Close() error                   { return nil }
+func (s *memorySink) Name() string                   { return "memory" }
+func (s *memorySink) Stats() sink.SinkStats          { return sink.SinkStats{} }
+
+func (s *memorySink) Write(ctx context.Context, record source.Record) error {
+	s.written++
+	return nil
+}
+
+func (s *memorySink) Flush(ctx context.Context) error {
+	*s.log = append(*s.log, fmt.Sprintf("flush %d", s.written))
+	return nil
+}
+
+// memoryCheckpointer 경로별 체크포인트를 메모리에 보관
+type memoryCheckpointer struct {
+	data map[string]map[string]any
+	log  *[]string
+}
+
+func (c *memoryCheckpointer) Save(path string, data map[string]any) error {
+	c.data[path] = data
+	*c.log = append(*c.log, fmt.Sprintf("save %s %v", path, data["acked"]))
+	return nil
+}
+
+func (c *memoryCheckpointer) Load(path string) (map[string]any, error) {
+	return c.data[path], nil
+}
+
+func TestPipelineSavesCheckpointAfterAck(t *testing.T) {
+	var log []string
+	src := &ackSource{log: &log}
+	for i := 1; i <= 3; i++ {
+		src.records = append(src.records, source.Record{
+			Data:     map[string]any{"id": i},
+			Metadata: source.Metadata{Offset: strconv.Itoa(i)},
+		})
+	}
+	cp := &memoryCheckpointer{
+		data: map[string]map[string]any{"/orders/events": {"acked": 7}},
+		log:  &log,
+	}
+
+	p := &Pipeline{
+		config: &config.PipelineConfigV2{Name: "orders"},
+		source: src,
+		sink:   &memorySink{log: &log},
+	}
+	WithCheckpointer(cp)(p)
+
+	if err := p.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(src.restored, map[string]any{"acked": 7}) {
+		t.Errorf("restored checkpoint = %v", src.restored)
+	}
+	// 싱크 Flush → 레코드 Ack → 체크포인트 저장 → 저장 완료 통지 순서
+	want := []string{"flush 3", "ack 3", "save /orders/events 3", "checkpoint ack 3"}
+	if !reflect.DeepEqual(log, want) {
+		t.Errorf("call order = %v, want %v", log, want)
+	}
+}
+
+// batchDedup 일괄 조회 호출을 기록하고 레코드별 조회는 실패시키는 dedup 저장소
+type batchDedup struct {
+	dedup.DedupService
+	batches [][]string
+}
+
+func (d *batchDedup) IsDuplicate(ctx context.Context, eventID string) (bool, error) {
+	return false, fmt.Errorf("unexpected per-record lookup: %s", eventID)
+}
+
+func (d *batchDedup) IsDuplicateBatch(ctx context.Context, eventIDs []string) (map[string]bool, error) {
+	d.batches = append(d.batches, eventIDs)
+	dups := make(map[string]bool)
+	for _, id := range eventIDs {
+		if id == "1" {
+			dups[id] = true
+		}
+	}
+	return dups, nil
+}
+
+func TestPipelineBatchDedupLookup(t *testing.T) {
+	var log []string
+	src := &ackSource{log: &log}
+	for _, id := range []int{1, 2, 2, 3} {
+		src.records = append(src.records, source.Record{Data: map[string]any{"id": id}})
+	}
+	svc := &batchDedup{DedupService: dedup.NewMemoryDedupService(time.Hour)}
+	out := &memorySink{log: &log}
+
+	p := &Pipeline{
+		config:    &config.PipelineConfigV2{Name: "orders", Mode: config.ModeRealtime},
+		source:    src,
+		sink:      out,
+		dedup:     svc,
+		eventKeys: dedup.NewKeyBuilder("id"),
+	}
+	if err := p.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := [][]string{{"1", "2", "2", "3"}}; !reflect.DeepEqual(svc.batches, want) {
+		t.Errorf("batch lookups = %v, want %v", svc.batches, want)
+	}
+	// 저장소에 있던 1과 같은 배치에서 다시 나온 2는 중복
+	if out.written != 2 || p.stats.DuplicateCount != 2 {
+		t.Errorf("written = %d, duplicates = %d, want 2 and 2", out.written, p.stats.DuplicateCount)
+	}
+}
+
+func TestPipelineAcksHeldRecordsAfterDrain(t *testing.T) {
+	var log []string
+	src := &ackSource{log: &log}
+	// 보류만으로 Ack 배치 크기를 넘기도록 서로 다른 키 ackBatchSize개와 마지막 키의 중복 하나
+	for i := 0; i < ackBatchSize; i++ {
+		src.records = append(src.records, source.Record{Data: map[string]any{"id": i}})
+	}
+	src.records = append(src.records, source.Record{Data: map[string]any{"id": ackBatchSize - 1}})
+
+	proc, err := processor.NewDedupProcessor("latest", &config.DedupStepConfig{Key: ".id", Window: "1h", Keep: "last"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proc.Close()
+
+	p := &Pipeline{
+		config:     &config.PipelineConfigV2{Name: "orders"},
+		source:     src,
+		processors: []processor.Processor{proc},
+		sink:       &memorySink{log: &log},
+	}
+	if err := p.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// 보류된 레코드는 종료 시 Drain으로 기록된 뒤에만 Ack (대체된 레코드 포함)
+	want := []string{fmt.Sprintf("flush %d", ackBatchSize), fmt.Sprintf("ack %d", ackBatchSize+1)}
+	if !reflect.DeepEqual(log, want) {
+		t.Errorf("call order = %v, want %v", log, want)
+	}
+	if p.stats.FilteredCount != 0 {
+		t.Errorf("filtered = %d, want 0 (held records are not filtered)", p.stats.FilteredCount)
+	}
+}
+
+// lineSink lines 형식 레코드의 줄을 모으는 싱크 (실행 중에 조회)
+type lineSink struct {
+	mu    sync.Mutex
+	lines []any
+}
+
+func (s *lineSink) Open(ctx context.Context) error  { return nil }
+func (s *lineSink) Close() error                    { return nil }
+func (s *lineSink) Name() string                    { return "lines" }
+func (s *lineSink) Stats() sink.SinkStats           { return sink.SinkStats{} }
+func (s *lineSink) Flush(ctx context.Context) error { return nil }
+
+func (s *lineSink) Write(ctx context.Context, record source.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lines = append(s.lines, record.Data["line"])
+	return nil
+}
+
+func (s *lineSink) waitFor(t *testing.T, n int) []any {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		s.mu.Lock()
+		lines := append([]any(nil), s.lines...)
+		s.mu.Unlock()
+		if len(lines) >= n {
+			return lines
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d lines", n)
+	return nil
+}
+
+func TestPipelineTailResumesFromCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	if err := os.WriteFile(path, []byte("one\ntwo\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var log []string
+	cp := &memoryCheckpointer{data: map[string]map[string]any{}, log: &log}
+
+	// tail 실행은 끝나지 않으므로 취소로 멈추고 체크포인트가 남았는지 확인
+	run := func(want int) []any {
+		src, err := source.NewFileSource(config.SourceV2{Type: "file", Path: path, Format: "lines", Tail: true, PollInterval: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := &lineSink{}
+		p := &Pipeline{config: &config.PipelineConfigV2{Name: "logs"}, source: src, sink: out}
+		WithCheckpointer(cp)(p)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- p.Run(ctx) }()
+		lines := out.waitFor(t, want)
+		cancel()
+		if err := <-done; !errors.Is(err, context.Canceled) {
+			t.Fatalf("Run = %v, want context.Canceled", err)
+		}
+		return lines
+	}
+
+	if lines := run(2); !reflect.DeepEqual(lines, []any{"one", "two"}) {
+		t.Fatalf("first run lines = %v", lines)
+	}
+	if _, ok := cp.data["/logs/file"]; !ok {
+		t.Fatal("checkpoint was not saved on shutdown")
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("three\n")
+	f.Close()
+
+	if lines := run(1); !reflect.DeepEqual(lines, []any{"three"}) {
+		t.Errorf("resumed run lines = %v, want only the appended line", lines)
+	}
+}
+
+// orderedSource 빠짐없이 Ack된 레코드까지만 체크포인트가 전진하는 소스
+type orderedSource struct {
+	ackSource
+}
+
+func (s *orderedSource) AckInOrder() {}
+
+// failingSink id가 fail인 레코드의 기록을 실패시키는 싱크
+type failingSink struct {
+	memorySink
+	fail int
+}
+
+func (s *failingSink) Write(ctx context.Context, record source.Record) error {
+	if record.Data["id"] == s.fail {
+		return errors.New("write rejected")
+	}
+	return s.memorySink.Write(ctx, record)
+}
+
+func TestPipelineStopsOnFailedOrderedRecord(t *testing.T) {
+	var log []string
+	src := &orderedSource{ackSource{log: &log}}
+	for i := 1; i <= 3; i++ {
+		src.records = append(src.records, source.Record{
+			Data:     map[string]any{"id": i},
+			Metadata: source.Metadata{Offset: strconv.Itoa(i)},
+		})
+	}
+	cp := &memoryCheckpointer{data: map[string]map[string]any{}, log: &log}
+
+	p := &Pipeline{
+		config: &config.PipelineConfigV2{Name: "orders"},
+		source: src,
+		sink:   &failingSink{memorySink: memorySink{log: &log}, fail: 2},
+	}
+	WithCheckpointer(cp)(p)
+
+	err := p.Run(context.Background())
+	if err == nil {
+		t.Fatal("expected Run to stop on the failed record")
+	}
+	// 실패한 레코드 앞까지만 Ack/저장하고 뒤 레코드는 처리하지 않음
+	want := []string{"flush 1", "ack 1", "save /orders/events 1", "checkpoint ack 1"}
+	if !reflect.DeepEqual(log, want) {
+		t.Errorf("call order = %v, want %v", log, want)
+	}
+}
+
+// flakySink 처음 failures번의 기록을 실패시키는 싱크
+type flakySink struct {
+	memorySink
+	failures int
+}
+
+func (s *flakySink) Write(ctx context.Context, record source.Record) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("write rejected")
+	}
+	return s.memorySink.Write(ctx, record)
+}
+
+func TestPipelineDedupMarksAfterWrite(t *testing.T) {
+	var log []string
+	src := &ackSource{log: &log, records: []source.Record{
+		{Data: map[string]any{"id": 1, "attempt": 1}},
+		{Data: map[string]any{"id": 1, "attempt": 2}},
+		{Data: map[string]any{"id": 1, "attempt": 3}},
+	}}
+
+	proc, err := processor.NewDedupProcessor("first", &config.DedupStepConfig{Key: ".id", Window: "1h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proc.Close()
+
+	snk := &flakySink{memorySink: memorySink{log: &log}, failures: 1}
+	p := &Pipeline{
+		config:     &config.PipelineConfigV2{Name: "orders"},
+		source:     src,
+		processors: []processor.Processor{proc},
+		sink:       snk,
+	}
+	if err := p.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// 기록에 실패한 첫 레코드의 키는 표시되지 않아 다시 온 레코드가 기록되고, 그 뒤의 레코드만 중복
+	if snk.written != 1 || p.stats.FilteredCount != 1 || proc.Duplicates() != 1 {
+		t.Errorf("written = %d, filtered = %d, duplicates = %d, want 1, 1, 1",
+			snk.written, p.stats.FilteredCount, proc.Duplicates())
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/conduix/conduix/pipeline-core/pkg/actor"
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
 	"github.com/conduix/conduix/pipeline-core/pkg/stream"
 	"github.com/conduix/conduix/shared/types"
 )
@@ -28,6 +29,7 @@ type Runner struct {
 	logger       actor.Logger
 	slogger      *slog.Logger
 	checkpointer actor.Checkpointer
+	pipelineID   string // 저장소 키 공간 구분용 (기본: config.Name)
 }
 
 // NewRunner 새 Runner 생성
@@ -69,6 +71,21 @@ func WithRunnerCheckpointer(cp actor.Checkpointer) RunnerOption {
 	}
 }
 
+// WithRunnerPipelineID 파이프라인 ID 설정 (dedup 단계 저장소 키 공간에 사용)
+func WithRunnerPipelineID(id string) RunnerOption {
+	return func(r *Runner) {
+		r.pipelineID = id
+	}
+}
+
+// id 파이프라인 ID (설정되지 않았으면 설정 이름)
+func (r *Runner) id() string {
+	if r.pipelineID != "" {
+		return r.pipelineID
+	}
+	return r.config.Name
+}
+
 // Start 파이프라인 시작
 func (r *Runner) Start() error {
 	r.mu.Lock()
@@ -117,6 +134,22 @@ func (r *Runner) Start() error {
 	return nil
 }
 
+// restoreCheckpoint 저장된 소스 체크포인트를 소스 설정의 checkpoint로 전달
+// 소스 액터가 ActorContext.Checkpoint로 저장하는 경로(/<파이프라인>/<소스>)에서 읽는다
+func (r *Runner) restoreCheckpoint(name string, srcConfig map[string]any) error {
+	if r.checkpointer == nil {
+		return nil
+	}
+	checkpoint, err := r.checkpointer.Load(fmt.Sprintf("/%s/%s", r.config.Name, name))
+	if err != nil {
+		return fmt.Errorf("failed to load checkpoint for source %s: %w", name, err)
+	}
+	if len(checkpoint) > 0 {
+		srcConfig["checkpoint"] = checkpoint
+	}
+	return nil
+}
+
 // startFlatPipeline Flat 모드 파이프라인 시작
 func (r *Runner) startFlatPipeline() error {
 	// Sources 생성
@@ -128,6 +161,9 @@ func (r *Runner) startFlatPipeline() error {
 		for k, v := range src.Options {
 			srcConfig[k] = v
 		}
+		if err := r.restoreCheckpoint(name, srcConfig); err != nil {
+			return err
+		}
 
 		ref, err := r.system.Spawn(actor.Props{
 			Name: name,
@@ -151,6 +187,10 @@ func (r *Runner) startFlatPipeline() error {
 		for k, v := range trans.Options {
 			transConfig[k] = v
 		}
+		// dedup 단계는 파이프라인과 단계별로 저장소 키 공간을 나눔
+		if _, ok := transConfig["namespace"]; !ok && trans.Type == "dedup" {
+			transConfig["namespace"] = dedup.NamespaceOf(r.id(), name)
+		}
 
 		ref, err := r.system.Spawn(actor.Props{
 			Name: name,
@@ -252,6 +292,9 @@ func (r *Runner) startStreamPipeline() error {
 		for k, v := range src.Options {
 			srcConfig[k] = v
 		}
+		if err := r.restoreCheckpoint(name, srcConfig); err != nil {
+			return err
+		}
 
 		var err error
 		source, err = stream.NewSource(stream.SourceConfig{
@@ -3,12 +3,15 @@ package processor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
 	"github.com/conduix/conduix/pipeline-core/pkg/source"
 )
 
@@ -18,6 +21,35 @@ type Processor interface {
 	Name() string
 }
 
+// Drainer 레코드를 보류했다가 나중에 내보내는 프로세서 (dedup keep=last 등)
+// 파이프라인이 주기적으로, 그리고 소스가 끝나면 force로 호출해 보류된 레코드를 이후 단계로 보낸다
+type Drainer interface {
+	Drain(ctx context.Context, force bool) ([]source.Record, error)
+}
+
+// HeldError Drainer가 레코드를 보류했음을 알리는 Process 결과 (처리 실패가 아님)
+// 보류된 레코드는 Drain으로 다시 나오므로 싱크에 기록되기 전까지 소스에 Ack하면 안 된다.
+// 같은 윈도우의 이전 레코드를 대체했으면 Superseded에 담으며, 이 레코드는 더 이상 기록되지 않는다
+type HeldError struct {
+	Superseded *source.Record
+}
+
+func (e *HeldError) Error() string {
+	return "record held"
+}
+
+// Committer 통과시킨 레코드의 처리 결과를 통지받는 프로세서 (dedup keep=first 등)
+// 파이프라인은 레코드를 싱크에 기록하거나 이후 단계가 걸러내면 Commit, 처리에 실패하면 Abandon을 호출한다
+type Committer interface {
+	Commit(ctx context.Context) error
+	Abandon()
+}
+
+// DuplicateCounter 제거한 중복 레코드 수를 제공하는 프로세서
+type DuplicateCounter interface {
+	Duplicates() int64
+}
+
 // NewProcessor 설정에서 프로세서 생성
 func NewProcessor(step config.StepV2) (Processor, error) {
 	processors := []Processor{}
@@ -38,6 +70,15 @@ func NewProcessor(step config.StepV2) (Processor, error) {
 		})
 	}
 
+	// Dedup
+	if step.Dedup != nil {
+		proc, err := NewDedupProcessor(step.Name, step.Dedup)
+		if err != nil {
+			return nil, err
+		}
+		processors = append(processors, proc)
+	}
+
 	// Sample
 	if step.Sample > 0 {
 		processors = append(processors, &SampleProcessor{
@@ -87,8 +128,13 @@ func (p *ChainProcessor) Name() string {
 }
 
 func (p *ChainProcessor) Process(ctx context.Context, record source.Record) (*source.Record, error) {
+	return p.processFrom(ctx, record, 0)
+}
+
+// processFrom start번째 프로세서부터 적용
+func (p *ChainProcessor) processFrom(ctx context.Context, record source.Record, start int) (*source.Record, error) {
 	current := &record
-	for _, proc := range p.processors {
+	for _, proc := range p.processors[start:] {
 		result, err := proc.Process(ctx, *current)
 		if err != nil {
 			return nil, err
@@ -101,6 +147,79 @@ func (p *ChainProcessor) Process(ctx context.Context, record source.Record) (*so
 	return current, nil
 }
 
+// Drain 보류 중인 레코드를 내보내고, 보류한 프로세서 다음 단계를 이어서 적용
+func (p *ChainProcessor) Drain(ctx context.Context, force bool) ([]source.Record, error) {
+	var out []source.Record
+	var errs []error
+	for i, proc := range p.processors {
+		d, ok := proc.(Drainer)
+		if !ok {
+			continue
+		}
+		records, err := d.Drain(ctx, force)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for _, record := range records {
+			result, err := p.processFrom(ctx, record, i+1)
+			var held *HeldError
+			if errors.As(err, &held) {
+				continue // 이후 프로세서가 다시 보류 (그 프로세서의 Drain으로 나옴)
+			}
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if result != nil {
+				out = append(out, *result)
+			}
+		}
+	}
+	return out, errors.Join(errs...)
+}
+
+// Duplicates 체인 안 dedup 프로세서가 제거한 중복 수
+func (p *ChainProcessor) Duplicates() int64 {
+	var n int64
+	for _, proc := range p.processors {
+		if dc, ok := proc.(DuplicateCounter); ok {
+			n += dc.Duplicates()
+		}
+	}
+	return n
+}
+
+// Commit 체인 안 프로세서에 처리 완료 통지
+func (p *ChainProcessor) Commit(ctx context.Context) error {
+	var errs []error
+	for _, proc := range p.processors {
+		if c, ok := proc.(Committer); ok {
+			errs = append(errs, c.Commit(ctx))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Abandon 체인 안 프로세서에 처리 실패 통지
+func (p *ChainProcessor) Abandon() {
+	for _, proc := range p.processors {
+		if c, ok := proc.(Committer); ok {
+			c.Abandon()
+		}
+	}
+}
+
+// Close 체인 안 프로세서의 리소스 정리
+func (p *ChainProcessor) Close() error {
+	var errs []error
+	for _, proc := range p.processors {
+		if c, ok := proc.(interface{ Close() error }); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // NoopProcessor 아무 처리도 하지 않는 프로세서
 type NoopProcessor struct {
 	name string
@@ -311,3 +430,93 @@ func (p *ExcludeProcessor) Process(ctx context.Context, record source.Record) (*
 		Metadata: record.Metadata,
 	}, nil
 }
+
+// DedupProcessor 키와 시간 윈도우로 중복 레코드를 제거하는 프로세서
+// keep=first는 첫 발생만 통과시키고, keep=last는 윈도우가 닫힐 때 마지막 발생을 Drain으로 내보낸다
+type DedupProcessor struct {
+	name     string
+	dedup    *dedup.WindowDeduplicator
+	keepLast bool
+}
+
+// NewDedupProcessor 단계 설정으로 생성
+func NewDedupProcessor(name string, cfg *config.DedupStepConfig) (*DedupProcessor, error) {
+	window, err := time.ParseDuration(cfg.Window)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dedup window: %w", err)
+	}
+
+	d, err := dedup.NewWindow(dedup.WindowOptions{
+		Key:    cfg.Key,
+		Window: window,
+		Keep:   cfg.Keep,
+		Storage: dedup.Options{
+			Storage:        cfg.Storage,
+			MaxEntries:     cfg.MaxEntries,
+			RedisURL:       cfg.RedisURL,
+			SQLDriver:      cfg.SQLDriver,
+			SQLDSN:         cfg.SQLDSN,
+			SQLTablePrefix: cfg.SQLTablePrefix,
+			Namespace:      cfg.Namespace,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dedup processor %s: %w", name, err)
+	}
+	return &DedupProcessor{name: name, dedup: d, keepLast: cfg.Keep == dedup.KeepLast}, nil
+}
+
+func (p *DedupProcessor) Name() string {
+	return p.name
+}
+
+// Process keep=first는 중복이면 nil을 반환하고, keep=last는 레코드를 보류하며 HeldError를 반환
+func (p *DedupProcessor) Process(ctx context.Context, record source.Record) (*source.Record, error) {
+	if p.keepLast {
+		superseded, held := p.dedup.Hold(record.Data, record)
+		if !held {
+			return &record, nil
+		}
+		err := &HeldError{}
+		if prev, ok := superseded.(source.Record); ok {
+			err.Superseded = &prev
+		}
+		return nil, err
+	}
+
+	emit, err := p.dedup.Offer(ctx, record.Data, record)
+	if err != nil {
+		return nil, err
+	}
+	if !emit {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+func (p *DedupProcessor) Drain(ctx context.Context, force bool) ([]source.Record, error) {
+	released := p.dedup.Release(force)
+	records := make([]source.Record, len(released))
+	for i, r := range released {
+		records[i] = r.(source.Record)
+	}
+	return records, nil
+}
+
+// Commit keep=first로 통과시킨 키를 처리됨으로 표시
+func (p *DedupProcessor) Commit(ctx context.Context) error {
+	return p.dedup.Commit(ctx)
+}
+
+// Abandon keep=first로 통과시킨 키를 표시하지 않고 놓아줌 (다시 전달되면 통과)
+func (p *DedupProcessor) Abandon() {
+	p.dedup.Abandon()
+}
+
+func (p *DedupProcessor) Duplicates() int64 {
+	return p.dedup.Duplicates()
+}
+
+func (p *DedupProcessor) Close() error {
+	return p.dedup.Close()
+}
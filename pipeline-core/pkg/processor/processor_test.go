@@ -2,8 +2,10 @@ package processor
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
 	"github.com/conduix/conduix/pipeline-core/pkg/source"
 )
 
@@ -371,6 +373,87 @@ func TestChainProcessorWithFilter(t *testing.T) {
 	}
 }
 
+func TestDedupStepKeepFirst(t *testing.T) {
+	proc, err := NewProcessor(config.StepV2{
+		Name:  "dedup-orders",
+		Dedup: &config.DedupStepConfig{Key: ".order_id", Window: "1h"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proc.(*DedupProcessor).Close()
+
+	ctx := context.Background()
+	var passed []any
+	for _, id := range []any{1, "1", 2, 1.0} {
+		result, err := proc.Process(ctx, source.Record{Data: map[string]any{"order_id": id}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result != nil {
+			passed = append(passed, result.Data["order_id"])
+		}
+	}
+	if len(passed) != 2 || passed[0] != 1 || passed[1] != 2 {
+		t.Errorf("passed = %v", passed)
+	}
+	if n := proc.(DuplicateCounter).Duplicates(); n != 2 {
+		t.Errorf("duplicates = %d", n)
+	}
+}
+
+func TestDedupStepKeepLastInChain(t *testing.T) {
+	// dedup은 transform 다음, select 앞에서 적용되고 Drain된 레코드도 select를 거쳐야 함
+	proc, err := NewProcessor(config.StepV2{
+		Name:      "latest",
+		Transform: "seen = 'yes'",
+		Dedup:     &config.DedupStepConfig{Key: ".id", Window: "1h", Keep: "last"},
+		Select:    []string{"id", "rev", "seen"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain, ok := proc.(*ChainProcessor)
+	if !ok {
+		t.Fatalf("processor = %T, want chain", proc)
+	}
+	defer chain.Close()
+
+	ctx := context.Background()
+	for rev := 1; rev <= 3; rev++ {
+		result, err := chain.Process(ctx, source.Record{Data: map[string]any{"id": "a", "rev": rev, "extra": true}})
+		var held *HeldError
+		if !errors.As(err, &held) || result != nil {
+			t.Fatalf("Process = %v, %v (held records should not pass)", result, err)
+		}
+		// 두 번째부터는 직전 레코드를 대체
+		if rev == 1 && held.Superseded != nil {
+			t.Errorf("rev 1 superseded = %v", held.Superseded)
+		}
+		if rev > 1 && (held.Superseded == nil || held.Superseded.Data["rev"] != rev-1) {
+			t.Errorf("rev %d superseded = %v", rev, held.Superseded)
+		}
+	}
+
+	if records, _ := chain.Drain(ctx, false); len(records) != 0 {
+		t.Errorf("drained before window closed: %v", records)
+	}
+	records, err := chain.Drain(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("drained = %d records", len(records))
+	}
+	data := records[0].Data
+	if data["rev"] != 3 || data["seen"] != "yes" || data["extra"] != nil {
+		t.Errorf("drained record = %v", data)
+	}
+	if chain.Duplicates() != 2 {
+		t.Errorf("duplicates = %d", chain.Duplicates())
+	}
+}
+
 func BenchmarkTransformProcessor(b *testing.B) {
 	p := &TransformProcessor{
 		name:      "transform",
@@ -51,6 +51,8 @@ type SQLProvisioningConfig struct {
 	SSLMode   string            `json:"ssl_mode"`   // SSL 모드 (postgres)
 	Charset   string            `json:"charset"`    // 문자셋 (mysql)
 	Extra     map[string]string `json:"extra"`      // 추가 옵션
+	DSN       string            `json:"dsn"`        // 접속 문자열 (지정하면 host/port 등 대신 사용, sqlite는 필수)
+	Indexes   []SQLIndex        `json:"indexes"`    // 보조 인덱스 정의
 }
 
 // SQLColumn SQL 컬럼 정의
@@ -62,6 +64,13 @@ type SQLColumn struct {
 	Default    string `json:"default"`     // 기본값
 }
 
+// SQLIndex SQL 보조 인덱스 정의
+type SQLIndex struct {
+	Name    string   `json:"name"`    // 인덱스 이름 (postgres는 스키마 안에서 고유해야 함)
+	Columns []string `json:"columns"` // 인덱스 컬럼
+	Unique  bool     `json:"unique"`  // UNIQUE 인덱스 여부
+}
+
 func (p *SQLProvisioner) Provision(ctx context.Context, req *types.ProvisioningRequest) (*types.ProvisioningResult, error) {
 	// 외부 프로비저닝인 경우
 	if req.Type == types.ProvisioningTypeExternal {
@@ -136,10 +145,15 @@ func (p *SQLProvisioner) parseConfig(config map[string]any) (*SQLProvisioningCon
 		}
 	}
 
+	// DSN (지정하면 접속 정보 대신 사용)
+	if dsn, ok := config["dsn"].(string); ok {
+		cfg.DSN = dsn
+	}
+
 	// Host
 	if host, ok := config["host"].(string); ok && host != "" {
 		cfg.Host = host
-	} else {
+	} else if cfg.DSN == "" {
 		return nil, fmt.Errorf("host is required")
 	}
 
@@ -153,14 +167,14 @@ func (p *SQLProvisioner) parseConfig(config map[string]any) (*SQLProvisioningCon
 	// Database
 	if database, ok := config["database"].(string); ok && database != "" {
 		cfg.Database = database
-	} else {
+	} else if cfg.DSN == "" {
 		return nil, fmt.Errorf("database is required")
 	}
 
 	// Username
 	if username, ok := config["username"].(string); ok && username != "" {
 		cfg.Username = username
-	} else {
+	} else if cfg.DSN == "" {
 		return nil, fmt.Errorf("username is required")
 	}
 
@@ -201,6 +215,29 @@ func (p *SQLProvisioner) parseConfig(config map[string]any) (*SQLProvisioningCon
 		}
 	}
 
+	// Indexes
+	if indexes, ok := config["indexes"].([]any); ok {
+		for _, idx := range indexes {
+			if idxMap, ok := idx.(map[string]any); ok {
+				index := SQLIndex{}
+				if name, ok := idxMap["name"].(string); ok {
+					index.Name = name
+				}
+				if cols, ok := idxMap["columns"].([]any); ok {
+					for _, c := range cols {
+						if col, ok := c.(string); ok {
+							index.Columns = append(index.Columns, col)
+						}
+					}
+				}
+				if unique, ok := idxMap["unique"].(bool); ok {
+					index.Unique = unique
+				}
+				cfg.Indexes = append(cfg.Indexes, index)
+			}
+		}
+	}
+
 	// 컬럼이 없으면 기본 스키마 사용
 	if len(cfg.Columns) == 0 {
 		cfg.Columns = p.getDefaultColumns()
@@ -230,6 +267,9 @@ func (p *SQLProvisioner) getDefaultColumns() []SQLColumn {
 }
 
 func (p *SQLProvisioner) buildDSN(config *SQLProvisioningConfig) string {
+	if config.DSN != "" {
+		return config.DSN
+	}
 	switch config.Driver {
 	case "mysql":
 		return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=%s&parseTime=true",
@@ -259,15 +299,26 @@ func (p *SQLProvisioner) createTable(ctx context.Context, config *SQLProvisionin
 		return fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	// CREATE TABLE 쿼리 생성
-	createSQL := p.buildCreateTableSQL(config)
+	return p.Migrate(ctx, db, config)
+}
 
+// Migrate 열린 연결에 테이블과 인덱스 생성 (이미 있으면 그대로 둠)
+// 파이프라인 내부 저장소(dedup 등)가 자체 연결로 스키마를 준비할 때도 사용
+func (p *SQLProvisioner) Migrate(ctx context.Context, db *sql.DB, config *SQLProvisioningConfig) error {
 	// 테이블 생성
-	_, err = db.ExecContext(ctx, createSQL)
-	if err != nil {
+	if _, err := db.ExecContext(ctx, p.buildCreateTableSQL(config)); err != nil {
 		return fmt.Errorf("failed to create table: %w", err)
 	}
 
+	// MySQL은 CREATE TABLE 안에 인덱스를 포함하므로 나머지 드라이버만 별도 생성
+	if config.Driver == "mysql" {
+		return nil
+	}
+	for _, idx := range config.Indexes {
+		if _, err := db.ExecContext(ctx, p.buildCreateIndexSQL(config, idx)); err != nil {
+			return fmt.Errorf("failed to create index %s: %w", idx.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -307,16 +358,38 @@ func (p *SQLProvisioner) buildCreateTableSQL(config *SQLProvisioningConfig) stri
 		sb.WriteString(fmt.Sprintf(",\n  PRIMARY KEY (%s)", strings.Join(primaryKeys, ", ")))
 	}
 
+	// MySQL은 CREATE INDEX IF NOT EXISTS가 없으므로 테이블 정의에 포함
+	if config.Driver == "mysql" {
+		for _, idx := range config.Indexes {
+			kind := "INDEX"
+			if idx.Unique {
+				kind = "UNIQUE INDEX"
+			}
+			sb.WriteString(fmt.Sprintf(",\n  %s %s (%s)", kind, idx.Name, strings.Join(idx.Columns, ", ")))
+		}
+	}
+
 	sb.WriteString("\n)")
 
 	// MySQL 엔진 및 문자셋
 	if config.Driver == "mysql" {
-		sb.WriteString(fmt.Sprintf(" ENGINE=InnoDB DEFAULT CHARSET=%s", config.Charset))
+		sb.WriteString(" ENGINE=InnoDB")
+		if config.Charset != "" {
+			sb.WriteString(fmt.Sprintf(" DEFAULT CHARSET=%s", config.Charset))
+		}
 	}
 
 	return sb.String()
 }
 
+func (p *SQLProvisioner) buildCreateIndexSQL(config *SQLProvisioningConfig, idx SQLIndex) string {
+	kind := "INDEX"
+	if idx.Unique {
+		kind = "UNIQUE INDEX"
+	}
+	return fmt.Sprintf("CREATE %s IF NOT EXISTS %s ON %s (%s)", kind, idx.Name, config.TableName, strings.Join(idx.Columns, ", "))
+}
+
 func (p *SQLProvisioner) Validate(config map[string]any) error {
 	_, err := p.parseConfig(config)
 	return err
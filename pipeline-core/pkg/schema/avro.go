@@ -0,0 +1,235 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// avroNode Avro 스키마의 복합 타입 정의
+type avroNode struct {
+	Type        json.RawMessage `json:"type"`
+	Name        string          `json:"name"`
+	Namespace   string          `json:"namespace"`
+	Doc         string          `json:"doc"`
+	Fields      []avroField     `json:"fields"`
+	Items       json.RawMessage `json:"items"`
+	Symbols     []string        `json:"symbols"`
+	LogicalType string          `json:"logicalType"`
+}
+
+type avroField struct {
+	Name    string          `json:"name"`
+	Doc     string          `json:"doc"`
+	Type    json.RawMessage `json:"type"`
+	Default json.RawMessage `json:"default"`
+}
+
+// FromAvro Avro record 스키마를 DataSchema로 변환
+// null 유니온(["null", T])이거나 default가 있는 필드는 필수로 보지 않으며,
+// 여러 타입의 유니온은 any, map은 object, enum은 symbols를 enum으로 가진 string이 된다
+func FromAvro(definition []byte) (*DataSchema, error) {
+	var root avroNode
+	if err := json.Unmarshal(definition, &root); err != nil {
+		return nil, fmt.Errorf("invalid avro schema: %w", err)
+	}
+	if avroTypeName(root.Type) != "record" {
+		return nil, fmt.Errorf("avro schema root must be a record")
+	}
+
+	c := &avroConverter{named: make(map[string]FieldSchema)}
+	field, _, err := c.convert("", definition, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataSchema{
+		Name:        root.Name,
+		Description: root.Doc,
+		Fields:      field.Properties,
+	}, nil
+}
+
+type avroConverter struct {
+	named map[string]FieldSchema // 정의된 record/enum/fixed (전체 이름과 짧은 이름)
+}
+
+// convert Avro 타입을 필드로 변환 (optional: null 허용 여부)
+func (c *avroConverter) convert(name string, raw json.RawMessage, namespace string) (FieldSchema, bool, error) {
+	field := FieldSchema{Name: name, Type: FieldTypeAny}
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return field, false, fmt.Errorf("%s: type is required", fieldLabel(name))
+	}
+
+	switch raw[0] {
+	case '"':
+		var typeName string
+		if err := json.Unmarshal(raw, &typeName); err != nil {
+			return field, false, err
+		}
+		return c.reference(name, typeName, namespace)
+
+	case '[':
+		var members []json.RawMessage
+		if err := json.Unmarshal(raw, &members); err != nil {
+			return field, false, fmt.Errorf("%s: invalid union: %w", fieldLabel(name), err)
+		}
+		var rest []json.RawMessage
+		optional := false
+		for _, m := range members {
+			if string(bytes.TrimSpace(m)) == `"null"` {
+				optional = true
+				continue
+			}
+			rest = append(rest, m)
+		}
+		if len(rest) != 1 {
+			return field, optional, nil
+		}
+		converted, _, err := c.convert(name, rest[0], namespace)
+		return converted, optional, err
+
+	case '{':
+		var node avroNode
+		if err := json.Unmarshal(raw, &node); err != nil {
+			return field, false, fmt.Errorf("%s: invalid type: %w", fieldLabel(name), err)
+		}
+		return c.complex(name, &node, namespace)
+	}
+
+	return field, false, fmt.Errorf("%s: invalid type: %s", fieldLabel(name), raw)
+}
+
+// reference 원시 타입 이름 또는 앞서 정의된 이름 있는 타입
+func (c *avroConverter) reference(name, typeName, namespace string) (FieldSchema, bool, error) {
+	field := FieldSchema{Name: name}
+	switch typeName {
+	case "null":
+		field.Type = FieldTypeAny
+		return field, true, nil
+	case "boolean":
+		field.Type = FieldTypeBoolean
+	case "int", "long":
+		field.Type = FieldTypeInteger
+	case "float", "double":
+		field.Type = FieldTypeNumber
+	case "string", "bytes":
+		field.Type = FieldTypeString
+	default:
+		def, ok := c.named[typeName]
+		if !ok && namespace != "" && !strings.Contains(typeName, ".") {
+			def, ok = c.named[namespace+"."+typeName]
+		}
+		if !ok {
+			return field, false, fmt.Errorf("%s: unknown avro type %s", fieldLabel(name), typeName)
+		}
+		def.Name = name
+		return def, false, nil
+	}
+	return field, false, nil
+}
+
+func (c *avroConverter) complex(name string, node *avroNode, namespace string) (FieldSchema, bool, error) {
+	field := FieldSchema{Name: name, Type: FieldTypeAny, Description: node.Doc}
+	if node.Namespace != "" {
+		namespace = node.Namespace
+	}
+
+	typeName := avroTypeName(node.Type)
+	switch typeName {
+	case "record", "error":
+		field.Type = FieldTypeObject
+		// 자기 참조를 위해 먼저 any로 등록
+		c.register(node.Name, namespace, FieldSchema{Type: FieldTypeAny})
+		for _, f := range node.Fields {
+			prop, optional, err := c.convert(f.Name, f.Type, namespace)
+			if err != nil {
+				if name != "" {
+					return field, false, fmt.Errorf("%s.%w", name, err)
+				}
+				return field, false, err
+			}
+			if f.Doc != "" {
+				prop.Description = f.Doc
+			}
+			prop.Required = !optional && len(f.Default) == 0
+			if len(f.Default) > 0 {
+				if err := json.Unmarshal(f.Default, &prop.Default); err != nil {
+					return field, false, fmt.Errorf("%s: invalid default: %w", fieldLabel(f.Name), err)
+				}
+			}
+			field.Properties = append(field.Properties, prop)
+		}
+		c.register(node.Name, namespace, field)
+
+	case "enum":
+		field.Type = FieldTypeString
+		for _, s := range node.Symbols {
+			field.Enum = append(field.Enum, s)
+		}
+		c.register(node.Name, namespace, field)
+
+	case "fixed":
+		field.Type = FieldTypeString
+		c.register(node.Name, namespace, field)
+
+	case "array":
+		field.Type = FieldTypeArray
+		item, _, err := c.convert("", node.Items, namespace)
+		if err != nil {
+			return field, false, fmt.Errorf("%s: items: %w", fieldLabel(name), err)
+		}
+		field.Items = &item
+
+	case "map":
+		field.Type = FieldTypeObject
+
+	case "":
+		// {"type": {...}} 또는 {"type": [...]} 처럼 타입이 중첩된 경우
+		converted, optional, err := c.convert(name, node.Type, namespace)
+		if node.Doc != "" {
+			converted.Description = node.Doc
+		}
+		return converted, optional, err
+
+	default:
+		converted, optional, err := c.reference(name, typeName, namespace)
+		if err != nil {
+			return field, false, err
+		}
+		switch node.LogicalType {
+		case "decimal":
+			converted.Type = FieldTypeNumber
+		case "uuid":
+			converted.Format = "uuid"
+		}
+		if node.Doc != "" {
+			converted.Description = node.Doc
+		}
+		return converted, optional, nil
+	}
+
+	return field, false, nil
+}
+
+// register 이름 있는 타입 등록 (참조 시 필드 이름만 바꿔 재사용)
+func (c *avroConverter) register(name, namespace string, field FieldSchema) {
+	if name == "" {
+		return
+	}
+	c.named[name] = field
+	if namespace != "" && !strings.Contains(name, ".") {
+		c.named[namespace+"."+name] = field
+	}
+}
+
+// avroTypeName type 값이 문자열이면 그 이름 (복합 타입이면 빈 문자열)
+func avroTypeName(raw json.RawMessage) string {
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return ""
+	}
+	return name
+}
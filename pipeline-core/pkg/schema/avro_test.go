@@ -0,0 +1,125 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+const userAvroSchema = `{
+  "type": "record",
+  "name": "User",
+  "namespace": "com.example",
+  "doc": "사용자",
+  "fields": [
+    {"name": "id", "type": {"type": "string", "logicalType": "uuid"}},
+    {"name": "age", "type": "int"},
+    {"name": "score", "type": "double", "default": 0},
+    {"name": "email", "type": ["null", "string"], "doc": "연락처"},
+    {"name": "active", "type": "boolean"},
+    {"name": "tier", "type": {"type": "enum", "name": "Tier", "symbols": ["FREE", "PRO"]}},
+    {"name": "tags", "type": {"type": "array", "items": "string"}},
+    {"name": "attrs", "type": {"type": "map", "values": "string"}},
+    {"name": "address", "type": {
+      "type": "record", "name": "Address",
+      "fields": [{"name": "city", "type": "string"}, {"name": "zip", "type": ["null", "string"], "default": null}]
+    }},
+    {"name": "previous", "type": ["null", "com.example.Address"]},
+    {"name": "balance", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+    {"name": "payload", "type": ["string", "long"]},
+    {"name": "parent", "type": ["null", "User"]}
+  ]
+}`
+
+func TestFromAvro(t *testing.T) {
+	s, err := FromAvro([]byte(userAvroSchema))
+	if err != nil {
+		t.Fatalf("FromAvro failed: %v", err)
+	}
+	if s.Name != "User" || s.Description != "사용자" {
+		t.Errorf("schema = %+v", s)
+	}
+
+	fields := make(map[string]FieldSchema)
+	for _, f := range s.Fields {
+		fields[f.Name] = f
+	}
+
+	tests := []struct {
+		name     string
+		typ      FieldType
+		required bool
+	}{
+		{"id", FieldTypeString, true},
+		{"age", FieldTypeInteger, true},
+		{"score", FieldTypeNumber, false},
+		{"email", FieldTypeString, false},
+		{"active", FieldTypeBoolean, true},
+		{"tier", FieldTypeString, true},
+		{"tags", FieldTypeArray, true},
+		{"attrs", FieldTypeObject, true},
+		{"address", FieldTypeObject, true},
+		{"previous", FieldTypeObject, false},
+		{"balance", FieldTypeNumber, true},
+		{"payload", FieldTypeAny, true},
+		{"parent", FieldTypeAny, false},
+	}
+	for _, tt := range tests {
+		f, ok := fields[tt.name]
+		if !ok {
+			t.Errorf("%s: missing", tt.name)
+			continue
+		}
+		if f.Type != tt.typ || f.Required != tt.required {
+			t.Errorf("%s: type=%s required=%v, want %s %v", tt.name, f.Type, f.Required, tt.typ, tt.required)
+		}
+	}
+
+	if fields["id"].Format != "uuid" {
+		t.Errorf("id format = %q", fields["id"].Format)
+	}
+	if fields["email"].Description != "연락처" {
+		t.Errorf("email description = %q", fields["email"].Description)
+	}
+	if !reflect.DeepEqual(fields["tier"].Enum, []any{"FREE", "PRO"}) {
+		t.Errorf("tier enum = %v", fields["tier"].Enum)
+	}
+	if items := fields["tags"].Items; items == nil || items.Type != FieldTypeString {
+		t.Errorf("tags items = %+v", items)
+	}
+	// 앞서 정의한 이름 있는 record 참조
+	if props := fields["previous"].Properties; len(props) != 2 || props[0].Name != "city" || props[1].Required {
+		t.Errorf("previous properties = %+v", props)
+	}
+
+	record := map[string]any{
+		"id": "123e4567-e89b-12d3-a456-426614174000", "age": 30.0, "active": true, "tier": "PRO",
+		"tags": []any{"a"}, "attrs": map[string]any{"k": "v"}, "address": map[string]any{"city": "Seoul"},
+		"balance": 12.5, "payload": "x",
+	}
+	if err := s.Validate(record); err != nil {
+		t.Errorf("valid record rejected: %v", err)
+	}
+	record["tier"] = "ENTERPRISE"
+	if err := s.Validate(record); err == nil {
+		t.Error("invalid enum accepted")
+	}
+}
+
+func TestFromAvroErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+	}{
+		{"invalid json", `{`},
+		{"root not record", `{"type": "enum", "name": "E", "symbols": ["A"]}`},
+		{"primitive root", `"string"`},
+		{"unknown type", `{"type": "record", "name": "R", "fields": [{"name": "a", "type": "Missing"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FromAvro([]byte(tt.definition)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
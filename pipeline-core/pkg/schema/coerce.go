@@ -0,0 +1,237 @@
+package schema
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CoercionAction 필드 변환 종류
+type CoercionAction string
+
+const (
+	// CoercionConverted 값의 타입 변환 ("42" → 42, 42 → "42", 날짜 정규화)
+	CoercionConverted CoercionAction = "converted"
+	// CoercionDefaulted 누락되거나 null인 필드에 기본값 적용
+	CoercionDefaulted CoercionAction = "defaulted"
+)
+
+// FieldCoercion 필드별 변환 결과
+type FieldCoercion struct {
+	Field  string         `json:"field"`
+	Action CoercionAction `json:"action"`
+	From   any            `json:"from,omitempty"` // 변환 전 값 (기본값 적용 시 없음)
+	To     any            `json:"to"`
+}
+
+// dateTimeLayouts date-time 형식으로 읽을 수 있는 문자열 레이아웃 (시간대가 없으면 UTC)
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.ANSIC,
+	"2006-01-02",
+	"2006/01/02 15:04:05",
+	"2006/01/02",
+}
+
+// Coerce 스키마에 맞게 data의 값을 변환하고 누락된 필드에 기본값을 채운 뒤 검증
+// 문자열→number/integer/boolean, number/boolean→string, 날짜 문자열과 epoch 숫자→date-time/date 형식을 변환한다.
+// data를 제자리에서 수정하며 필드별 변환 내역과 검증 오류(*ValidationErrors)를 반환한다.
+// 변환할 수 없는 값은 그대로 두므로 검증 오류로 보고된다
+func (s *DataSchema) Coerce(data map[string]any) ([]FieldCoercion, error) {
+	c := &coercer{}
+	for i := range s.Fields {
+		field := &s.Fields[i]
+		value, exists := getNestedField(data, field.Name)
+		if next, changed := c.value(field.Name, field, value, exists); changed {
+			setNestedField(data, field.Name, next)
+		}
+	}
+	return c.results, s.Validate(data)
+}
+
+type coercer struct {
+	results []FieldCoercion
+}
+
+// value path 위치의 값을 변환 (changed: 값을 바꿔 넣어야 하는지)
+func (c *coercer) value(path string, field *FieldSchema, value any, exists bool) (any, bool) {
+	if !exists || value == nil {
+		if field.Default == nil {
+			return value, false
+		}
+		def := cloneValue(field.Default)
+		c.results = append(c.results, FieldCoercion{Field: path, Action: CoercionDefaulted, To: def})
+		return def, true
+	}
+
+	switch field.Type {
+	case FieldTypeObject:
+		// 객체와 배열은 제자리에서 하위 값을 변환
+		if obj, ok := value.(map[string]any); ok {
+			for i := range field.Properties {
+				prop := &field.Properties[i]
+				propValue, propExists := obj[prop.Name]
+				if next, changed := c.value(joinPath(path, prop.Name), prop, propValue, propExists); changed {
+					obj[prop.Name] = next
+				}
+			}
+		}
+		return value, false
+
+	case FieldTypeArray:
+		if arr, ok := value.([]any); ok && field.Items != nil {
+			for i, item := range arr {
+				if next, changed := c.value(path+"["+strconv.Itoa(i)+"]", field.Items, item, true); changed {
+					arr[i] = next
+				}
+			}
+		}
+		return value, false
+	}
+
+	next, ok := convertScalar(field, value)
+	if !ok {
+		return value, false
+	}
+	c.results = append(c.results, FieldCoercion{Field: path, Action: CoercionConverted, From: value, To: next})
+	return next, true
+}
+
+// convertScalar 스칼라 값을 필드 타입으로 변환 (ok: 변환했는지, 이미 맞는 값이면 false)
+func convertScalar(field *FieldSchema, value any) (any, bool) {
+	switch field.Type {
+	case FieldTypeInteger:
+		s, isString := value.(string)
+		if !isString {
+			return nil, false
+		}
+		s = strings.TrimSpace(s)
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, true
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil && f == math.Trunc(f) && !math.IsInf(f, 0) {
+			return int64(f), true
+		}
+
+	case FieldTypeNumber:
+		s, isString := value.(string)
+		if !isString {
+			return nil, false
+		}
+		if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+			return f, true
+		}
+
+	case FieldTypeBoolean:
+		s, isString := value.(string)
+		if !isString {
+			return nil, false
+		}
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "true", "t", "1", "yes", "y", "on":
+			return true, true
+		case "false", "f", "0", "no", "n", "off":
+			return false, true
+		}
+
+	case FieldTypeString:
+		if field.Format == "date-time" || field.Format == "date" {
+			if t, ok := parseTimeValue(value); ok {
+				layout := time.RFC3339Nano
+				if field.Format == "date" {
+					layout = "2006-01-02"
+				}
+				if formatted := t.Format(layout); formatted != value {
+					return formatted, true
+				}
+				return nil, false
+			}
+		}
+		switch v := value.(type) {
+		case bool:
+			return strconv.FormatBool(v), true
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), true
+		case float32:
+			return strconv.FormatFloat(float64(v), 'f', -1, 32), true
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			b, _ := json.Marshal(v)
+			return string(b), true
+		}
+	}
+	return nil, false
+}
+
+// parseTimeValue 날짜 문자열 또는 epoch 숫자(초, 1e11 이상이면 밀리초)를 시각으로 변환
+// 이미 RFC3339 형식인 문자열은 원래 시간대를 유지한다
+func parseTimeValue(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case string:
+		s := strings.TrimSpace(v)
+		for _, layout := range dateTimeLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, true
+			}
+		}
+		return time.Time{}, false
+	case float64:
+		return epochTime(v), true
+	case int64:
+		return epochTime(float64(v)), true
+	case int:
+		return epochTime(float64(v)), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func epochTime(v float64) time.Time {
+	if math.Abs(v) >= 1e11 {
+		return time.UnixMilli(int64(v)).UTC()
+	}
+	sec, frac := math.Modf(v)
+	return time.Unix(int64(sec), int64(frac*1e9)).UTC()
+}
+
+// setNestedField 점 경로에 값을 설정 (중간 객체가 없으면 생성)
+func setNestedField(data map[string]any, fieldPath string, value any) {
+	parts := strings.Split(fieldPath, ".")
+	current := data
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			current[part] = next
+		}
+		current = next
+	}
+	current[parts[len(parts)-1]] = value
+}
+
+// cloneValue 기본값이 레코드 사이에 공유되지 않도록 map/slice 복사
+func cloneValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(t))
+		for k, e := range t {
+			c[k] = cloneValue(e)
+		}
+		return c
+	case []any:
+		c := make([]any, len(t))
+		for i, e := range t {
+			c[i] = cloneValue(e)
+		}
+		return c
+	default:
+		return v
+	}
+}
@@ -0,0 +1,185 @@
+package schema
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCoerceScalars(t *testing.T) {
+	tests := []struct {
+		name  string
+		field FieldSchema
+		value any
+		want  any
+	}{
+		{"string to integer", FieldSchema{Type: FieldTypeInteger}, "42", int64(42)},
+		{"float string to integer", FieldSchema{Type: FieldTypeInteger}, " 42.0 ", int64(42)},
+		{"string to number", FieldSchema{Type: FieldTypeNumber}, "3.5", 3.5},
+		{"string to boolean", FieldSchema{Type: FieldTypeBoolean}, "Yes", true},
+		{"string to false", FieldSchema{Type: FieldTypeBoolean}, "0", false},
+		{"number to string", FieldSchema{Type: FieldTypeString}, 12.5, "12.5"},
+		{"integer to string", FieldSchema{Type: FieldTypeString}, int64(7), "7"},
+		{"boolean to string", FieldSchema{Type: FieldTypeString}, true, "true"},
+		{"date-time layout", FieldSchema{Type: FieldTypeString, Format: "date-time"}, "2024-01-02 03:04:05", "2024-01-02T03:04:05Z"},
+		{"epoch seconds", FieldSchema{Type: FieldTypeString, Format: "date-time"}, float64(1704164645), "2024-01-02T03:04:05Z"},
+		{"epoch millis", FieldSchema{Type: FieldTypeString, Format: "date-time"}, float64(1704164645123), "2024-01-02T03:04:05.123Z"},
+		{"date from date-time", FieldSchema{Type: FieldTypeString, Format: "date"}, "2024-01-02T03:04:05Z", "2024-01-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.field.Name = "v"
+			s := &DataSchema{Fields: []FieldSchema{tt.field}}
+			data := map[string]any{"v": tt.value}
+
+			coercions, err := s.Coerce(data)
+			if err != nil {
+				t.Fatalf("Coerce failed: %v", err)
+			}
+			if data["v"] != tt.want {
+				t.Errorf("value = %#v, want %#v", data["v"], tt.want)
+			}
+			want := []FieldCoercion{{Field: "v", Action: CoercionConverted, From: tt.value, To: tt.want}}
+			if !reflect.DeepEqual(coercions, want) {
+				t.Errorf("coercions = %+v", coercions)
+			}
+		})
+	}
+}
+
+func TestCoerceUnchanged(t *testing.T) {
+	s := &DataSchema{Fields: []FieldSchema{
+		{Name: "id", Type: FieldTypeInteger},
+		{Name: "at", Type: FieldTypeString, Format: "date-time"},
+		{Name: "any", Type: FieldTypeAny},
+	}}
+	data := map[string]any{"id": float64(1), "at": "2024-01-02T03:04:05+09:00", "any": "x"}
+
+	coercions, err := s.Coerce(data)
+	if err != nil || len(coercions) != 0 {
+		t.Errorf("coercions = %+v, err = %v", coercions, err)
+	}
+}
+
+func TestCoerceDefaults(t *testing.T) {
+	s := &DataSchema{Fields: []FieldSchema{
+		{Name: "status", Type: FieldTypeString, Required: true, Default: "new"},
+		{Name: "tags", Type: FieldTypeArray, Default: []any{"a"}},
+		{Name: "meta.source", Type: FieldTypeString, Default: "api"},
+		{Name: "customer", Type: FieldTypeObject, Properties: []FieldSchema{
+			{Name: "tier", Type: FieldTypeString, Default: "basic"},
+			{Name: "age", Type: FieldTypeInteger},
+		}},
+		{Name: "items", Type: FieldTypeArray, Items: &FieldSchema{Type: FieldTypeObject, Properties: []FieldSchema{
+			{Name: "qty", Type: FieldTypeInteger, Default: int64(1)},
+		}}},
+	}}
+
+	data := map[string]any{
+		"status":   nil,
+		"customer": map[string]any{"age": "30"},
+		"items":    []any{map[string]any{}, map[string]any{"qty": "2"}},
+	}
+	coercions, err := s.Coerce(data)
+	if err != nil {
+		t.Fatalf("Coerce failed: %v", err)
+	}
+
+	want := map[string]any{
+		"status":   "new",
+		"tags":     []any{"a"},
+		"meta":     map[string]any{"source": "api"},
+		"customer": map[string]any{"tier": "basic", "age": int64(30)},
+		"items":    []any{map[string]any{"qty": int64(1)}, map[string]any{"qty": int64(2)}},
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("data = %#v", data)
+	}
+
+	fields := make(map[string]CoercionAction)
+	for _, c := range coercions {
+		fields[c.Field] = c.Action
+	}
+	wantFields := map[string]CoercionAction{
+		"status":        CoercionDefaulted,
+		"tags":          CoercionDefaulted,
+		"meta.source":   CoercionDefaulted,
+		"customer.tier": CoercionDefaulted,
+		"customer.age":  CoercionConverted,
+		"items[0].qty":  CoercionDefaulted,
+		"items[1].qty":  CoercionConverted,
+	}
+	if !reflect.DeepEqual(fields, wantFields) {
+		t.Errorf("coercions = %+v", coercions)
+	}
+
+	// 기본값은 레코드 사이에 공유되지 않음
+	data["tags"].([]any)[0] = "changed"
+	if s.Fields[1].Default.([]any)[0] != "a" {
+		t.Error("default value was modified")
+	}
+}
+
+func TestCoerceReportsAllErrors(t *testing.T) {
+	s := &DataSchema{Fields: []FieldSchema{
+		{Name: "id", Type: FieldTypeInteger, Required: true},
+		{Name: "active", Type: FieldTypeBoolean},
+		{Name: "customer", Type: FieldTypeObject, Properties: []FieldSchema{
+			{Name: "email", Type: FieldTypeString, Format: "email", Required: true},
+			{Name: "name", Type: FieldTypeString, Required: true},
+		}},
+	}}
+
+	data := map[string]any{"id": "abc", "active": "maybe", "customer": map[string]any{"email": "nope"}}
+	coercions, err := s.Coerce(data)
+	if len(coercions) != 0 {
+		t.Errorf("coercions = %+v", coercions)
+	}
+
+	var verrs *ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("error = %v", err)
+	}
+	var fields []string
+	for _, fe := range verrs.Errors() {
+		fields = append(fields, fe.Field)
+	}
+	want := []string{"id", "active", "customer.email", "customer.name"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("error fields = %v, want %v", fields, want)
+	}
+	// 변환할 수 없는 값은 그대로 유지
+	if data["id"] != "abc" {
+		t.Errorf("id = %v", data["id"])
+	}
+}
+
+func TestDefaultFromDefinitions(t *testing.T) {
+	fromJSON, err := FromJSONSchema([]byte(`{"type": "object", "properties": {"status": {"type": "string", "default": "new"}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromAvro, err := FromAvro([]byte(`{"type": "record", "name": "R", "fields": [{"name": "status", "type": "string", "default": "new"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromConfig, err := NewDataSchemaFromConfig(map[string]any{"fields": []any{map[string]any{"name": "status", "type": "string", "default": "new"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []*DataSchema{fromJSON, fromAvro, fromConfig} {
+		if s.Fields[0].Default != "new" {
+			t.Errorf("default = %v", s.Fields[0].Default)
+		}
+	}
+
+	out, err := fromJSON.ToJSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip, err := FromJSONSchema(out)
+	if err != nil || roundTrip.Fields[0].Default != "new" {
+		t.Errorf("round trip = %s, %v", out, err)
+	}
+}
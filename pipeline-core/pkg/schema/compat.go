@@ -0,0 +1,196 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// CompatibilityMode 스키마 변경 호환성 모드 (Avro 스키마 레지스트리 규칙)
+type CompatibilityMode string
+
+const (
+	// CompatibilityNone 호환성 검사 안 함
+	CompatibilityNone CompatibilityMode = "none"
+	// CompatibilityBackward 새 스키마로 이전 스키마의 데이터를 읽을 수 있어야 함 (기본값)
+	CompatibilityBackward CompatibilityMode = "backward"
+	// CompatibilityForward 이전 스키마로 새 스키마의 데이터를 읽을 수 있어야 함
+	CompatibilityForward CompatibilityMode = "forward"
+	// CompatibilityFull backward와 forward 모두 만족
+	CompatibilityFull CompatibilityMode = "full"
+)
+
+// ParseCompatibilityMode 문자열을 호환성 모드로 변환 (빈 값은 backward)
+func ParseCompatibilityMode(s string) (CompatibilityMode, error) {
+	switch mode := CompatibilityMode(strings.ToLower(s)); mode {
+	case "":
+		return CompatibilityBackward, nil
+	case CompatibilityNone, CompatibilityBackward, CompatibilityForward, CompatibilityFull:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unsupported compatibility mode: %s", s)
+	}
+}
+
+// CompatibilityIssue 호환되지 않는 변경
+type CompatibilityIssue struct {
+	Direction CompatibilityMode `json:"direction"` // backward 또는 forward
+	Field     string            `json:"field"`
+	Message   string            `json:"message"`
+}
+
+// String 이슈 문자열 표현
+func (i CompatibilityIssue) String() string {
+	return fmt.Sprintf("%s %s: %s", i.Direction, i.Field, i.Message)
+}
+
+// CheckCompatibility 이전 스키마(old)에서 새 스키마(next)로의 변경이 mode를 만족하는지 검사
+// backward는 next가 old로 쓰인 데이터를, forward는 old가 next로 쓰인 데이터를 읽을 수 있는지 본다.
+// 읽는 쪽의 기본값 없는 필수 필드가 쓰는 쪽에서 선택이거나 없으면, 타입이 integer→number 확장 외로 바뀌면,
+// enum·형식·패턴·범위 제약이 쓰는 쪽보다 좁으면 호환되지 않는다
+func CheckCompatibility(old, next *DataSchema, mode CompatibilityMode) []CompatibilityIssue {
+	var issues []CompatibilityIssue
+	if mode == CompatibilityBackward || mode == CompatibilityFull {
+		issues = append(issues, readable(next, old, CompatibilityBackward)...)
+	}
+	if mode == CompatibilityForward || mode == CompatibilityFull {
+		issues = append(issues, readable(old, next, CompatibilityForward)...)
+	}
+	return issues
+}
+
+// readable reader 스키마로 writer 스키마의 데이터를 읽을 수 있는지 검사
+func readable(reader, writer *DataSchema, direction CompatibilityMode) []CompatibilityIssue {
+	c := &compatChecker{direction: direction}
+	c.fields("", reader.Fields, writer.Fields)
+
+	// Strict 스키마는 정의되지 않은 필드를 거부하므로 쓰는 쪽에만 있는 필드도 문제
+	if reader.Strict {
+		for _, wf := range writer.Fields {
+			if findField(reader.Fields, wf.Name) == nil {
+				c.add(wf.Name, "strict 스키마에 정의되지 않은 필드입니다")
+			}
+		}
+	}
+	return c.issues
+}
+
+type compatChecker struct {
+	direction CompatibilityMode
+	issues    []CompatibilityIssue
+}
+
+func (c *compatChecker) add(field, format string, args ...any) {
+	c.issues = append(c.issues, CompatibilityIssue{
+		Direction: c.direction,
+		Field:     field,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *compatChecker) fields(prefix string, reader, writer []FieldSchema) {
+	for i := range reader {
+		rf := &reader[i]
+		path := joinPath(prefix, rf.Name)
+		wf := findField(writer, rf.Name)
+		// 기본값이 있는 필드는 쓰는 쪽에 없거나 선택이어도 Coerce로 채울 수 있음
+		if wf == nil {
+			if rf.Required && rf.Default == nil {
+				c.add(path, "필수 필드가 쓰는 스키마에 없습니다")
+			}
+			continue
+		}
+		if rf.Required && !wf.Required && rf.Default == nil {
+			c.add(path, "쓰는 스키마에서는 선택 필드입니다")
+		}
+		c.field(path, rf, wf)
+	}
+}
+
+func (c *compatChecker) field(path string, reader, writer *FieldSchema) {
+	if !typeReadable(reader.Type, writer.Type) {
+		c.add(path, "타입 %s를 %s로 읽을 수 없습니다", writer.Type, reader.Type)
+		return
+	}
+
+	if len(reader.Enum) > 0 {
+		if len(writer.Enum) == 0 {
+			c.add(path, "쓰는 스키마에 없는 enum 제약입니다")
+		} else {
+			for _, v := range writer.Enum {
+				if !containsValue(reader.Enum, v) {
+					c.add(path, "enum 값 %v를 읽을 수 없습니다", v)
+				}
+			}
+		}
+	}
+	if reader.Format != "" && reader.Format != writer.Format {
+		c.add(path, "형식 '%s'가 쓰는 스키마와 다릅니다", reader.Format)
+	}
+	if reader.Pattern != "" && reader.Pattern != writer.Pattern {
+		c.add(path, "패턴 '%s'가 쓰는 스키마와 다릅니다", reader.Pattern)
+	}
+	if reader.Min != nil && (writer.Min == nil || *writer.Min < *reader.Min) {
+		c.add(path, "최소값 %v 제약이 쓰는 스키마보다 좁습니다", *reader.Min)
+	}
+	if reader.Max != nil && (writer.Max == nil || *writer.Max > *reader.Max) {
+		c.add(path, "최대값 %v 제약이 쓰는 스키마보다 좁습니다", *reader.Max)
+	}
+	if reader.MinLength != nil && (writer.MinLength == nil || *writer.MinLength < *reader.MinLength) {
+		c.add(path, "최소 길이 %d 제약이 쓰는 스키마보다 좁습니다", *reader.MinLength)
+	}
+	if reader.MaxLength != nil && (writer.MaxLength == nil || *writer.MaxLength > *reader.MaxLength) {
+		c.add(path, "최대 길이 %d 제약이 쓰는 스키마보다 좁습니다", *reader.MaxLength)
+	}
+
+	switch reader.Type {
+	case FieldTypeObject:
+		c.fields(path, reader.Properties, writer.Properties)
+	case FieldTypeArray:
+		if reader.Items != nil {
+			// 아이템 스키마가 없는 배열은 어떤 값이든 담을 수 있음
+			writerItems := writer.Items
+			if writerItems == nil {
+				writerItems = &FieldSchema{Type: FieldTypeAny}
+			}
+			c.field(path+"[]", reader.Items, writerItems)
+		}
+	}
+}
+
+// typeReadable writer 타입의 값을 reader 타입으로 읽을 수 있는지 여부
+func typeReadable(reader, writer FieldType) bool {
+	switch {
+	case reader == writer, reader == FieldTypeAny:
+		return true
+	case reader == FieldTypeNumber && writer == FieldTypeInteger:
+		return true
+	default:
+		return false
+	}
+}
+
+func findField(fields []FieldSchema, name string) *FieldSchema {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+func containsValue(values []any, v any) bool {
+	for _, e := range values {
+		if reflect.DeepEqual(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
@@ -0,0 +1,133 @@
+package schema
+
+import "testing"
+
+func TestCheckCompatibility(t *testing.T) {
+	max := 100.0
+	base := &DataSchema{
+		Name: "order",
+		Fields: []FieldSchema{
+			{Name: "id", Type: FieldTypeString, Required: true},
+			{Name: "amount", Type: FieldTypeInteger, Required: true},
+			{Name: "status", Type: FieldTypeString, Enum: []any{"new", "paid"}},
+			{Name: "note", Type: FieldTypeString},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		change   func(s *DataSchema)
+		backward bool
+		forward  bool
+	}{
+		{"unchanged", func(s *DataSchema) {}, true, true},
+		{"add optional field", func(s *DataSchema) {
+			s.Fields = append(s.Fields, FieldSchema{Name: "tag", Type: FieldTypeString})
+		}, true, true},
+		{"add required field", func(s *DataSchema) {
+			s.Fields = append(s.Fields, FieldSchema{Name: "tag", Type: FieldTypeString, Required: true})
+		}, false, true},
+		{"remove optional field", func(s *DataSchema) { s.Fields = s.Fields[:3] }, true, true},
+		{"remove required field", func(s *DataSchema) { s.Fields = s.Fields[1:] }, true, false},
+		{"make optional required", func(s *DataSchema) { s.Fields[3].Required = true }, false, true},
+		{"widen integer to number", func(s *DataSchema) { s.Fields[1].Type = FieldTypeNumber }, true, false},
+		{"change type", func(s *DataSchema) { s.Fields[0].Type = FieldTypeInteger }, false, false},
+		{"add enum value", func(s *DataSchema) { s.Fields[2].Enum = []any{"new", "paid", "shipped"} }, true, false},
+		{"add format", func(s *DataSchema) { s.Fields[0].Format = "uuid" }, false, true},
+		{"add max", func(s *DataSchema) { s.Fields[1].Max = &max }, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := cloneSchema(base)
+			tt.change(next)
+
+			backward := CheckCompatibility(base, next, CompatibilityBackward)
+			if (len(backward) == 0) != tt.backward {
+				t.Errorf("backward issues = %v", backward)
+			}
+			forward := CheckCompatibility(base, next, CompatibilityForward)
+			if (len(forward) == 0) != tt.forward {
+				t.Errorf("forward issues = %v", forward)
+			}
+			full := CheckCompatibility(base, next, CompatibilityFull)
+			if len(full) != len(backward)+len(forward) {
+				t.Errorf("full issues = %v", full)
+			}
+			if none := CheckCompatibility(base, next, CompatibilityNone); len(none) != 0 {
+				t.Errorf("none issues = %v", none)
+			}
+		})
+	}
+}
+
+func TestCheckCompatibilityNested(t *testing.T) {
+	old := &DataSchema{Fields: []FieldSchema{
+		{Name: "customer", Type: FieldTypeObject, Properties: []FieldSchema{
+			{Name: "name", Type: FieldTypeString},
+		}},
+		{Name: "items", Type: FieldTypeArray, Items: &FieldSchema{Type: FieldTypeInteger}},
+	}}
+	next := &DataSchema{Fields: []FieldSchema{
+		{Name: "customer", Type: FieldTypeObject, Properties: []FieldSchema{
+			{Name: "name", Type: FieldTypeString},
+			{Name: "tier", Type: FieldTypeString, Required: true},
+		}},
+		{Name: "items", Type: FieldTypeArray, Items: &FieldSchema{Type: FieldTypeString}},
+	}}
+
+	issues := CheckCompatibility(old, next, CompatibilityBackward)
+	if len(issues) != 2 || issues[0].Field != "customer.tier" || issues[1].Field != "items[]" {
+		t.Errorf("issues = %v", issues)
+	}
+	if issues[0].Direction != CompatibilityBackward {
+		t.Errorf("direction = %s", issues[0].Direction)
+	}
+}
+
+func TestCheckCompatibilityStrict(t *testing.T) {
+	old := &DataSchema{Strict: true, Fields: []FieldSchema{{Name: "id", Type: FieldTypeString}}}
+	next := &DataSchema{Strict: true, Fields: []FieldSchema{
+		{Name: "id", Type: FieldTypeString},
+		{Name: "note", Type: FieldTypeString},
+	}}
+
+	// 새 필드는 이전 strict 스키마가 거부하므로 forward 위반
+	if issues := CheckCompatibility(old, next, CompatibilityBackward); len(issues) != 0 {
+		t.Errorf("backward issues = %v", issues)
+	}
+	if issues := CheckCompatibility(old, next, CompatibilityForward); len(issues) != 1 || issues[0].Field != "note" {
+		t.Errorf("forward issues = %v", issues)
+	}
+}
+
+func TestCheckCompatibilityDefault(t *testing.T) {
+	old := &DataSchema{Fields: []FieldSchema{{Name: "id", Type: FieldTypeString, Required: true}}}
+	next := &DataSchema{Fields: []FieldSchema{
+		{Name: "id", Type: FieldTypeString, Required: true},
+		{Name: "status", Type: FieldTypeString, Required: true, Default: "new"},
+	}}
+	// 기본값이 있는 필수 필드 추가는 backward 호환
+	if issues := CheckCompatibility(old, next, CompatibilityBackward); len(issues) != 0 {
+		t.Errorf("issues = %v", issues)
+	}
+}
+
+func TestParseCompatibilityMode(t *testing.T) {
+	if mode, err := ParseCompatibilityMode(""); err != nil || mode != CompatibilityBackward {
+		t.Errorf("default = %s, %v", mode, err)
+	}
+	if mode, err := ParseCompatibilityMode("FULL"); err != nil || mode != CompatibilityFull {
+		t.Errorf("FULL = %s, %v", mode, err)
+	}
+	if _, err := ParseCompatibilityMode("transitive"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func cloneSchema(s *DataSchema) *DataSchema {
+	c := *s
+	c.Fields = make([]FieldSchema, len(s.Fields))
+	copy(c.Fields, s.Fields)
+	return &c
+}
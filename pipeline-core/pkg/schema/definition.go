@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/conduix/conduix/shared/types"
+)
+
+// 스키마 정의 형식 (types.DataTypeSchema.Type)
+const (
+	DefinitionJSONSchema = "json_schema"
+	DefinitionAvro       = "avro"
+	DefinitionInfer      = "infer" // 샘플 레코드에서 추론, 추론 결과는 JSON Schema로 저장
+)
+
+// NewDataSchemaFromDefinition 형식별 스키마 정의를 DataSchema로 변환
+// definition은 JSON 문자열/바이트 또는 YAML/JSON에서 읽은 객체
+func NewDataSchemaFromDefinition(typ string, definition any) (*DataSchema, error) {
+	raw, err := definitionBytes(definition)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typ {
+	case DefinitionJSONSchema, DefinitionInfer:
+		return FromJSONSchema(raw)
+	case DefinitionAvro:
+		return FromAvro(raw)
+	default:
+		return nil, fmt.Errorf("unsupported schema definition type: %s", typ)
+	}
+}
+
+func definitionBytes(definition any) ([]byte, error) {
+	switch v := definition.(type) {
+	case nil:
+		return nil, fmt.Errorf("schema definition is required")
+	case string:
+		if v == "" {
+			return nil, fmt.Errorf("schema definition is required")
+		}
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema definition: %w", err)
+		}
+		return b, nil
+	}
+}
+
+// NewDataSchemaFromDataType DataType 스키마를 DataSchema로 변환
+// definition이 없으면 간단한 필드 목록(Fields)을 사용한다
+func NewDataSchemaFromDataType(name string, dts *types.DataTypeSchema) (*DataSchema, error) {
+	if dts == nil {
+		return nil, fmt.Errorf("data type %s has no schema", name)
+	}
+
+	if dts.Definition == "" {
+		if dts.Type == DefinitionInfer {
+			return nil, fmt.Errorf("data type %s schema has not been inferred yet", name)
+		}
+		if dts.Type != "" && len(dts.Fields) == 0 {
+			return nil, fmt.Errorf("data type %s: %s schema definition is required", name, dts.Type)
+		}
+		return &DataSchema{Name: name, Fields: dataTypeFields(dts.Fields)}, nil
+	}
+
+	s, err := NewDataSchemaFromDefinition(dts.Type, dts.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("data type %s: %w", name, err)
+	}
+	if s.Name == "" {
+		s.Name = name
+	}
+	return s, nil
+}
+
+// dataTypeFields DataTypeField 목록 변환 (string, int, float, bool, datetime, json)
+func dataTypeFields(fields []types.DataTypeField) []FieldSchema {
+	result := make([]FieldSchema, 0, len(fields))
+	for _, f := range fields {
+		field := FieldSchema{Name: f.Name, Required: f.Required, Description: f.Description, Default: f.Default}
+		switch f.Type {
+		case "string":
+			field.Type = FieldTypeString
+		case "int", "integer":
+			field.Type = FieldTypeInteger
+		case "float", "number":
+			field.Type = FieldTypeNumber
+		case "bool", "boolean":
+			field.Type = FieldTypeBoolean
+		case "datetime":
+			field.Type = FieldTypeString
+			field.Format = "date-time"
+		default: // json
+			field.Type = FieldTypeAny
+		}
+		result = append(result, field)
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/conduix/conduix/shared/types"
+)
+
+func TestNewDataSchemaFromConfigDefinition(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]any
+	}{
+		{"json schema string", map[string]any{
+			"type":       "json_schema",
+			"definition": `{"type": "object", "required": ["id"], "properties": {"id": {"type": "integer"}}}`,
+		}},
+		{"json schema object", map[string]any{
+			"type": "json_schema",
+			"definition": map[string]any{
+				"type":       "object",
+				"required":   []any{"id"},
+				"properties": map[string]any{"id": map[string]any{"type": "integer"}},
+			},
+		}},
+		{"avro", map[string]any{
+			"type":       "avro",
+			"definition": `{"type": "record", "name": "R", "fields": [{"name": "id", "type": "long"}]}`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewDataSchemaFromConfig(tt.config)
+			if err != nil {
+				t.Fatalf("NewDataSchemaFromConfig failed: %v", err)
+			}
+			if len(s.Fields) != 1 || s.Fields[0].Type != FieldTypeInteger || !s.Fields[0].Required {
+				t.Errorf("fields = %+v", s.Fields)
+			}
+			if err := s.Validate(map[string]any{}); err == nil {
+				t.Error("missing id accepted")
+			}
+		})
+	}
+
+	if _, err := NewDataSchemaFromConfig(map[string]any{"type": "protobuf", "definition": "{}"}); err == nil {
+		t.Error("expected error for unsupported type")
+	}
+	if _, err := NewDataSchemaFromConfig(map[string]any{"type": "avro"}); err == nil {
+		t.Error("expected error for missing definition")
+	}
+}
+
+func TestNewDataSchemaFromDataType(t *testing.T) {
+	s, err := NewDataSchemaFromDataType("user", &types.DataTypeSchema{
+		Fields: []types.DataTypeField{
+			{Name: "id", Type: "int", Required: true},
+			{Name: "joined_at", Type: "datetime"},
+			{Name: "profile", Type: "json"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != "user" || s.Fields[0].Type != FieldTypeInteger || s.Fields[1].Format != "date-time" || s.Fields[2].Type != FieldTypeAny {
+		t.Errorf("schema = %+v", s)
+	}
+
+	s, err = NewDataSchemaFromDataType("order", &types.DataTypeSchema{
+		Type:       "json_schema",
+		Definition: `{"type": "object", "properties": {"id": {"type": "string"}}}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != "order" || len(s.Fields) != 1 {
+		t.Errorf("schema = %+v", s)
+	}
+
+	invalid := []*types.DataTypeSchema{
+		nil,
+		{Type: "infer"},
+		{Type: "avro"},
+		{Type: "json_schema", Definition: `{"type": "array"}`},
+	}
+	for _, dts := range invalid {
+		if _, err := NewDataSchemaFromDataType("x", dts); err == nil {
+			t.Errorf("expected error for %+v", dts)
+		}
+	}
+}
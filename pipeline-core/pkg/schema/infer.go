@@ -0,0 +1,235 @@
+package schema
+
+import "sort"
+
+// InferOptions 스키마 추론 옵션
+type InferOptions struct {
+	MaxEnumValues  int // enum 후보로 볼 최대 고유 값 수 (default: 10, 음수: enum 추론 안 함)
+	MinEnumSamples int // enum 추론에 필요한 최소 값 수 (default: 20)
+}
+
+// inferFormats 문자열 형식 추론 순서 (앞의 형식이 우선)
+var inferFormats = []string{"uuid", "date-time", "date", "email", "ipv4", "ipv6", "uri"}
+
+// Inferrer 샘플 레코드에서 DataSchema 추론
+// 필드 타입(정수/실수 혼합은 number, 서로 다른 타입은 any), 필수 여부(모든 레코드에 null이 아닌 값),
+// 중첩 객체/배열 구조, 문자열 형식, 적은 수의 고유 값을 가진 문자열의 enum 후보를 추론한다
+type Inferrer struct {
+	opts    InferOptions
+	records int
+	root    *fieldStats
+}
+
+// fieldStats 필드별 관측 통계
+type fieldStats struct {
+	present int // 값이 있는 횟수 (null 포함)
+	nulls   int
+	types   map[FieldType]int
+
+	formats  map[string]int // 형식별 일치한 문자열 수
+	strings  int
+	distinct map[string]bool // enum 후보 (MaxEnumValues 초과 시 nil)
+
+	order    []string // 객체 프로퍼티 관측 순서
+	children map[string]*fieldStats
+	objects  int // 객체로 관측된 횟수 (프로퍼티 필수 여부 판단)
+	items    *fieldStats
+}
+
+// NewInferrer 추론기 생성
+func NewInferrer(opts InferOptions) *Inferrer {
+	if opts.MaxEnumValues == 0 {
+		opts.MaxEnumValues = 10
+	}
+	if opts.MinEnumSamples <= 0 {
+		opts.MinEnumSamples = 20
+	}
+	return &Inferrer{opts: opts, root: newFieldStats()}
+}
+
+func newFieldStats() *fieldStats {
+	return &fieldStats{
+		types:    make(map[FieldType]int),
+		formats:  make(map[string]int),
+		distinct: make(map[string]bool),
+	}
+}
+
+// Add 레코드 하나 관측
+func (in *Inferrer) Add(record map[string]any) {
+	in.records++
+	in.observe(in.root, record)
+}
+
+// Count 관측한 레코드 수
+func (in *Inferrer) Count() int {
+	return in.records
+}
+
+// Schema 지금까지 관측한 레코드로 스키마 생성
+func (in *Inferrer) Schema(name string) *DataSchema {
+	return &DataSchema{Name: name, Fields: in.properties(in.root)}
+}
+
+// Infer 레코드 목록에서 스키마 추론
+func Infer(name string, records []map[string]any, opts InferOptions) *DataSchema {
+	in := NewInferrer(opts)
+	for _, r := range records {
+		in.Add(r)
+	}
+	return in.Schema(name)
+}
+
+func (in *Inferrer) observe(st *fieldStats, value any) {
+	st.present++
+	if value == nil {
+		st.nulls++
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		st.types[FieldTypeObject]++
+		st.objects++
+		if st.children == nil {
+			st.children = make(map[string]*fieldStats)
+		}
+		// 키 순서가 없는 map이므로 처음 관측된 레코드 안에서는 이름순으로 추가
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child, ok := st.children[k]
+			if !ok {
+				child = newFieldStats()
+				st.children[k] = child
+				st.order = append(st.order, k)
+			}
+			in.observe(child, v[k])
+		}
+
+	case []any:
+		st.types[FieldTypeArray]++
+		if st.items == nil {
+			st.items = newFieldStats()
+		}
+		for _, item := range v {
+			in.observe(st.items, item)
+		}
+
+	case string:
+		st.types[FieldTypeString]++
+		st.strings++
+		for _, format := range inferFormats {
+			if matchFormat(format, v) {
+				st.formats[format]++
+			}
+		}
+		if st.distinct != nil {
+			st.distinct[v] = true
+			if in.opts.MaxEnumValues < 0 || len(st.distinct) > in.opts.MaxEnumValues {
+				st.distinct = nil
+			}
+		}
+
+	case bool:
+		st.types[FieldTypeBoolean]++
+
+	case float64:
+		if v == float64(int64(v)) {
+			st.types[FieldTypeInteger]++
+		} else {
+			st.types[FieldTypeNumber]++
+		}
+	case float32:
+		st.types[FieldTypeNumber]++
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		st.types[FieldTypeInteger]++
+
+	default:
+		st.types[FieldTypeAny]++
+	}
+}
+
+// properties 객체 통계의 프로퍼티 필드 목록
+func (in *Inferrer) properties(st *fieldStats) []FieldSchema {
+	total := in.records
+	if st != in.root {
+		total = st.objects
+	}
+
+	fields := make([]FieldSchema, 0, len(st.order))
+	for _, name := range st.order {
+		child := st.children[name]
+		field := in.field(child)
+		field.Name = name
+		field.Required = child.present == total && child.nulls == 0
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func (in *Inferrer) field(st *fieldStats) FieldSchema {
+	field := FieldSchema{Type: resolveType(st.types)}
+
+	switch field.Type {
+	case FieldTypeObject:
+		field.Properties = in.properties(st)
+
+	case FieldTypeArray:
+		if st.items != nil && st.items.present > 0 {
+			items := in.field(st.items)
+			field.Items = &items
+		}
+
+	case FieldTypeString:
+		for _, format := range inferFormats {
+			if st.formats[format] == st.strings {
+				field.Format = format
+				break
+			}
+		}
+		// 형식이 있는 값(uuid, 날짜 등)은 enum 후보에서 제외
+		if field.Format == "" && st.distinct != nil && st.strings >= in.opts.MinEnumSamples && len(st.distinct) < st.strings {
+			values := make([]string, 0, len(st.distinct))
+			for v := range st.distinct {
+				values = append(values, v)
+			}
+			sort.Strings(values)
+			for _, v := range values {
+				field.Enum = append(field.Enum, v)
+			}
+		}
+	}
+	return field
+}
+
+// resolveType 관측된 타입에서 필드 타입 결정
+func resolveType(types map[FieldType]int) FieldType {
+	if types[FieldTypeNumber] > 0 && types[FieldTypeInteger] > 0 {
+		types = copyTypes(types)
+		types[FieldTypeNumber] += types[FieldTypeInteger]
+		delete(types, FieldTypeInteger)
+	}
+
+	var found []FieldType
+	for t, n := range types {
+		if n > 0 {
+			found = append(found, t)
+		}
+	}
+	if len(found) != 1 {
+		return FieldTypeAny
+	}
+	return found[0]
+}
+
+func copyTypes(types map[FieldType]int) map[FieldType]int {
+	c := make(map[FieldType]int, len(types))
+	for t, n := range types {
+		c[t] = n
+	}
+	return c
+}
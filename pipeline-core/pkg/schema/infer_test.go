@@ -0,0 +1,140 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestInfer(t *testing.T) {
+	var records []map[string]any
+	statuses := []string{"new", "paid", "shipped"}
+	for i := 0; i < 30; i++ {
+		r := map[string]any{
+			"id":         fmt.Sprintf("123e4567-e89b-12d3-a456-4266141740%02d", i),
+			"status":     statuses[i%3],
+			"amount":     float64(i) + 0.5,
+			"quantity":   float64(i),
+			"email":      fmt.Sprintf("user%d@example.com", i),
+			"created_at": "2024-01-02T03:04:05Z",
+			"active":     i%2 == 0,
+			"customer":   map[string]any{"name": fmt.Sprintf("user-%d", i), "vip": i%5 == 0},
+			"items":      []any{map[string]any{"sku": "A", "qty": float64(1)}},
+			"mixed":      "x",
+		}
+		if i%2 == 0 {
+			r["note"] = nil
+			r["amount"] = float64(i) // 정수와 실수가 섞이면 number
+			r["mixed"] = float64(i)  // 서로 다른 타입은 any
+		} else {
+			r["note"] = "memo"
+			r["customer"].(map[string]any)["tier"] = "gold"
+		}
+		records = append(records, r)
+	}
+
+	s := Infer("orders", records, InferOptions{})
+	if s.Name != "orders" {
+		t.Errorf("name = %q", s.Name)
+	}
+
+	fields := make(map[string]FieldSchema)
+	for _, f := range s.Fields {
+		fields[f.Name] = f
+	}
+
+	tests := []struct {
+		name     string
+		typ      FieldType
+		required bool
+		format   string
+	}{
+		{"id", FieldTypeString, true, "uuid"},
+		{"status", FieldTypeString, true, ""},
+		{"amount", FieldTypeNumber, true, ""},
+		{"quantity", FieldTypeInteger, true, ""},
+		{"email", FieldTypeString, true, "email"},
+		{"created_at", FieldTypeString, true, "date-time"},
+		{"active", FieldTypeBoolean, true, ""},
+		{"customer", FieldTypeObject, true, ""},
+		{"items", FieldTypeArray, true, ""},
+		{"mixed", FieldTypeAny, true, ""},
+		{"note", FieldTypeString, false, ""},
+	}
+	for _, tt := range tests {
+		f, ok := fields[tt.name]
+		if !ok {
+			t.Errorf("%s: missing", tt.name)
+			continue
+		}
+		if f.Type != tt.typ || f.Required != tt.required || f.Format != tt.format {
+			t.Errorf("%s: type=%s required=%v format=%q, want %s %v %q", tt.name, f.Type, f.Required, f.Format, tt.typ, tt.required, tt.format)
+		}
+	}
+
+	if got := fields["status"].Enum; !reflect.DeepEqual(got, []any{"new", "paid", "shipped"}) {
+		t.Errorf("status enum = %v", got)
+	}
+	// 고유 값이 많거나 형식이 있는 필드는 enum 후보가 아님
+	for _, name := range []string{"id", "email", "created_at"} {
+		if len(fields[name].Enum) > 0 {
+			t.Errorf("%s enum = %v", name, fields[name].Enum)
+		}
+	}
+	// note는 15개 값이 모두 같지만 최소 샘플 수(20) 미만
+	if len(fields["note"].Enum) > 0 {
+		t.Errorf("note enum = %v", fields["note"].Enum)
+	}
+
+	customer := fields["customer"].Properties
+	if len(customer) != 3 {
+		t.Fatalf("customer properties = %+v", customer)
+	}
+	for _, p := range customer {
+		wantRequired := p.Name != "tier"
+		if p.Required != wantRequired {
+			t.Errorf("customer.%s required = %v", p.Name, p.Required)
+		}
+	}
+
+	items := fields["items"].Items
+	if items == nil || items.Type != FieldTypeObject || len(items.Properties) != 2 || !items.Properties[0].Required {
+		t.Errorf("items = %+v", items)
+	}
+
+	// 추론한 스키마로 샘플 레코드가 모두 통과
+	for i, r := range records {
+		if err := s.Validate(r); err != nil {
+			t.Fatalf("record %d rejected: %v", i, err)
+		}
+	}
+}
+
+func TestInferrerIncremental(t *testing.T) {
+	in := NewInferrer(InferOptions{MaxEnumValues: -1})
+	in.Add(map[string]any{"a": float64(1)})
+	in.Add(map[string]any{"a": float64(2), "b": "x"})
+
+	if in.Count() != 2 {
+		t.Errorf("count = %d", in.Count())
+	}
+	s := in.Schema("t")
+	want := []FieldSchema{
+		{Name: "a", Type: FieldTypeInteger, Required: true},
+		{Name: "b", Type: FieldTypeString},
+	}
+	if !reflect.DeepEqual(s.Fields, want) {
+		t.Errorf("fields = %+v", s.Fields)
+	}
+}
+
+func TestInferEmpty(t *testing.T) {
+	s := Infer("empty", nil, InferOptions{})
+	if len(s.Fields) != 0 {
+		t.Errorf("fields = %+v", s.Fields)
+	}
+	onlyNull := Infer("nulls", []map[string]any{{"a": nil}}, InferOptions{})
+	if f := onlyNull.Fields[0]; f.Type != FieldTypeAny || f.Required {
+		t.Errorf("null-only field = %+v", f)
+	}
+}
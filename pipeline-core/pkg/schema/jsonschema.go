@@ -0,0 +1,435 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// jsonSchemaDraft 내보내는 JSON Schema 버전
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchemaNode 지원하는 JSON Schema 키워드 (draft 2020-12 일부)
+type jsonSchemaNode struct {
+	Ref                  string                     `json:"$ref"`
+	Defs                 map[string]json.RawMessage `json:"$defs"`
+	Definitions          map[string]json.RawMessage `json:"definitions"`
+	Title                string                     `json:"title"`
+	Description          string                     `json:"description"`
+	Type                 json.RawMessage            `json:"type"` // 문자열 또는 문자열 배열
+	Properties           json.RawMessage            `json:"properties"`
+	Required             []string                   `json:"required"`
+	AdditionalProperties json.RawMessage            `json:"additionalProperties"`
+	Items                json.RawMessage            `json:"items"`
+	Enum                 []any                      `json:"enum"`
+	Const                json.RawMessage            `json:"const"`
+	Default              json.RawMessage            `json:"default"`
+	Pattern              string                     `json:"pattern"`
+	Format               string                     `json:"format"`
+	MinLength            *int                       `json:"minLength"`
+	MaxLength            *int                       `json:"maxLength"`
+	Minimum              *float64                   `json:"minimum"`
+	Maximum              *float64                   `json:"maximum"`
+	AnyOf                []json.RawMessage          `json:"anyOf"`
+	OneOf                []json.RawMessage          `json:"oneOf"`
+	AllOf                []json.RawMessage          `json:"allOf"`
+}
+
+// FromJSONSchema JSON Schema 객체 정의를 DataSchema로 변환
+// type, required, enum/const, default, pattern, format, min/max(Length), 중첩 객체/배열, 로컬 $ref($defs)를 지원한다.
+// null을 허용하는 필드(type에 "null" 포함, anyOf/oneOf의 null 분기)는 필수로 보지 않는다
+func FromJSONSchema(definition []byte) (*DataSchema, error) {
+	var root jsonSchemaNode
+	if err := json.Unmarshal(definition, &root); err != nil {
+		return nil, fmt.Errorf("invalid json schema: %w", err)
+	}
+
+	c := &jsonSchemaConverter{defs: make(map[string]json.RawMessage), resolving: make(map[string]bool)}
+	for name, def := range root.Defs {
+		c.defs["#/$defs/"+name] = def
+	}
+	for name, def := range root.Definitions {
+		c.defs["#/definitions/"+name] = def
+	}
+
+	field, _, err := c.field("", definition)
+	if err != nil {
+		return nil, err
+	}
+	if field.Type != FieldTypeObject {
+		return nil, fmt.Errorf("json schema root must be an object, got %s", field.Type)
+	}
+
+	return &DataSchema{
+		Name:        root.Title,
+		Description: root.Description,
+		Fields:      field.Properties,
+		Strict:      string(root.AdditionalProperties) == "false",
+	}, nil
+}
+
+type jsonSchemaConverter struct {
+	defs      map[string]json.RawMessage
+	resolving map[string]bool // 재귀 $ref 감지
+}
+
+// field 스키마 노드를 필드로 변환 (nullable: null 값 허용 여부)
+func (c *jsonSchemaConverter) field(name string, raw json.RawMessage) (FieldSchema, bool, error) {
+	field := FieldSchema{Name: name, Type: FieldTypeAny}
+
+	// true/false 스키마
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) > 0 && trimmed[0] != '{' {
+		return field, false, nil
+	}
+
+	var node jsonSchemaNode
+	if err := json.Unmarshal(raw, &node); err != nil {
+		return field, false, fmt.Errorf("%s: invalid schema: %w", fieldLabel(name), err)
+	}
+
+	if node.Ref != "" {
+		def, ok := c.defs[node.Ref]
+		if !ok {
+			return field, false, fmt.Errorf("%s: unresolved $ref %s", fieldLabel(name), node.Ref)
+		}
+		if c.resolving[node.Ref] {
+			return field, false, nil // 재귀 구조는 any로 끊음
+		}
+		c.resolving[node.Ref] = true
+		defer delete(c.resolving, node.Ref)
+
+		resolved, nullable, err := c.field(name, def)
+		if node.Description != "" {
+			resolved.Description = node.Description
+		}
+		return resolved, nullable, err
+	}
+
+	if alternatives := append(node.AnyOf, node.OneOf...); len(alternatives) > 0 {
+		return c.alternatives(name, node.Description, alternatives)
+	}
+	if len(node.AllOf) == 1 {
+		return c.field(name, node.AllOf[0])
+	}
+
+	types, nullable, err := jsonSchemaTypes(node.Type)
+	if err != nil {
+		return field, false, fmt.Errorf("%s: %w", fieldLabel(name), err)
+	}
+	switch {
+	case len(types) == 1:
+		field.Type = types[0]
+	case len(types) == 0 && len(node.Properties) > 0:
+		field.Type = FieldTypeObject
+	case len(types) == 0 && len(node.Items) > 0:
+		field.Type = FieldTypeArray
+	}
+
+	field.Description = node.Description
+	field.Pattern = node.Pattern
+	field.Format = node.Format
+	field.MinLength = node.MinLength
+	field.MaxLength = node.MaxLength
+	field.Min = node.Minimum
+	field.Max = node.Maximum
+	field.Enum = node.Enum
+	if len(node.Const) > 0 {
+		var v any
+		if err := json.Unmarshal(node.Const, &v); err != nil {
+			return field, false, fmt.Errorf("%s: invalid const: %w", fieldLabel(name), err)
+		}
+		field.Enum = []any{v}
+	}
+	if len(node.Default) > 0 {
+		if err := json.Unmarshal(node.Default, &field.Default); err != nil {
+			return field, false, fmt.Errorf("%s: invalid default: %w", fieldLabel(name), err)
+		}
+	}
+
+	switch field.Type {
+	case FieldTypeObject:
+		if field.Properties, err = c.properties(name, node.Properties, node.Required); err != nil {
+			return field, false, err
+		}
+	case FieldTypeArray:
+		// 2020-12 items는 단일 스키마 (배열 형태의 튜플 items는 지원하지 않음)
+		if trimmed := bytes.TrimSpace(node.Items); len(trimmed) > 0 && trimmed[0] == '{' {
+			item, _, err := c.field("", node.Items)
+			if err != nil {
+				return field, false, fmt.Errorf("%s: items: %w", fieldLabel(name), err)
+			}
+			field.Items = &item
+		}
+	}
+
+	return field, nullable, nil
+}
+
+// alternatives anyOf/oneOf에서 null을 뺀 분기가 하나면 그 스키마, 여러 개면 any
+func (c *jsonSchemaConverter) alternatives(name, description string, alternatives []json.RawMessage) (FieldSchema, bool, error) {
+	var rest []json.RawMessage
+	nullable := false
+	for _, alt := range alternatives {
+		var node jsonSchemaNode
+		if err := json.Unmarshal(alt, &node); err == nil && string(bytes.TrimSpace(node.Type)) == `"null"` {
+			nullable = true
+			continue
+		}
+		rest = append(rest, alt)
+	}
+
+	if len(rest) != 1 {
+		return FieldSchema{Name: name, Type: FieldTypeAny, Description: description}, nullable, nil
+	}
+	field, altNullable, err := c.field(name, rest[0])
+	if description != "" {
+		field.Description = description
+	}
+	return field, nullable || altNullable, err
+}
+
+// properties 정의 순서를 유지하며 객체 프로퍼티 변환
+func (c *jsonSchemaConverter) properties(parent string, raw json.RawMessage, required []string) ([]FieldSchema, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	keys, members, err := orderedMembers(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid properties: %w", fieldLabel(parent), err)
+	}
+
+	requiredSet := make(map[string]bool, len(required))
+	for _, r := range required {
+		requiredSet[r] = true
+	}
+
+	fields := make([]FieldSchema, 0, len(keys))
+	for _, key := range keys {
+		field, nullable, err := c.field(key, members[key])
+		if err != nil {
+			if parent != "" {
+				return nil, fmt.Errorf("%s.%w", parent, err)
+			}
+			return nil, err
+		}
+		field.Required = requiredSet[key] && !nullable
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// jsonSchemaTypes type 키워드 해석 ("null"은 nullable로 분리)
+func jsonSchemaTypes(raw json.RawMessage) ([]FieldType, bool, error) {
+	if len(raw) == 0 {
+		return nil, false, nil
+	}
+
+	var names []string
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		names = []string{single}
+	} else if err := json.Unmarshal(raw, &names); err != nil {
+		return nil, false, fmt.Errorf("invalid type: %s", raw)
+	}
+
+	var types []FieldType
+	nullable := false
+	for _, name := range names {
+		switch FieldType(name) {
+		case FieldTypeString, FieldTypeNumber, FieldTypeInteger, FieldTypeBoolean, FieldTypeObject, FieldTypeArray:
+			types = append(types, FieldType(name))
+		case "null":
+			nullable = true
+		default:
+			return nil, false, fmt.Errorf("unsupported type: %s", name)
+		}
+	}
+	if len(types) > 1 {
+		return []FieldType{FieldTypeAny}, nullable, nil
+	}
+	return types, nullable, nil
+}
+
+// orderedMembers JSON 객체의 키를 정의 순서대로 반환
+func orderedMembers(raw json.RawMessage) ([]string, map[string]json.RawMessage, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return nil, nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil { // {
+		return nil, nil, err
+	}
+	keys := make([]string, 0, len(members))
+	seen := make(map[string]bool, len(members))
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, _ := tok.(string)
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil, nil, err
+		}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys, members, nil
+}
+
+func fieldLabel(name string) string {
+	if name == "" {
+		return "schema"
+	}
+	return name
+}
+
+// ToJSONSchema DataSchema를 JSON Schema(draft 2020-12)로 내보냄 (UI 폼 생성용)
+// 필드 순서를 유지하며, 점(.)으로 구분된 중첩 필드 이름은 중첩 객체 프로퍼티로 펼친다
+func (s *DataSchema) ToJSONSchema() ([]byte, error) {
+	root := orderedObject{{"$schema", jsonSchemaDraft}}
+	if s.Name != "" {
+		root = append(root, orderedEntry{"title", s.Name})
+	}
+	if s.Description != "" {
+		root = append(root, orderedEntry{"description", s.Description})
+	}
+	root = append(root, objectSchema(nestFields(s.Fields))...)
+	if s.Strict {
+		root = append(root, orderedEntry{"additionalProperties", false})
+	}
+	return json.Marshal(root)
+}
+
+// objectSchema 객체 타입의 type/properties/required 항목
+func objectSchema(fields []FieldSchema) orderedObject {
+	props := make(orderedObject, 0, len(fields))
+	var required []string
+	for i := range fields {
+		props = append(props, orderedEntry{fields[i].Name, fieldJSONSchema(&fields[i])})
+		if fields[i].Required {
+			required = append(required, fields[i].Name)
+		}
+	}
+
+	obj := orderedObject{{"type", "object"}, {"properties", props}}
+	if len(required) > 0 {
+		obj = append(obj, orderedEntry{"required", required})
+	}
+	return obj
+}
+
+func fieldJSONSchema(f *FieldSchema) orderedObject {
+	var obj orderedObject
+	switch f.Type {
+	case FieldTypeObject:
+		obj = objectSchema(nestFields(f.Properties))
+	case FieldTypeAny, "":
+	default:
+		obj = orderedObject{{"type", string(f.Type)}}
+	}
+
+	if f.Description != "" {
+		obj = append(obj, orderedEntry{"description", f.Description})
+	}
+	if len(f.Enum) > 0 {
+		obj = append(obj, orderedEntry{"enum", f.Enum})
+	}
+	if f.Default != nil {
+		obj = append(obj, orderedEntry{"default", f.Default})
+	}
+	if f.Pattern != "" {
+		obj = append(obj, orderedEntry{"pattern", f.Pattern})
+	}
+	if f.Format != "" {
+		obj = append(obj, orderedEntry{"format", f.Format})
+	}
+	if f.MinLength != nil {
+		obj = append(obj, orderedEntry{"minLength", *f.MinLength})
+	}
+	if f.MaxLength != nil {
+		obj = append(obj, orderedEntry{"maxLength", *f.MaxLength})
+	}
+	if f.Min != nil {
+		obj = append(obj, orderedEntry{"minimum", *f.Min})
+	}
+	if f.Max != nil {
+		obj = append(obj, orderedEntry{"maximum", *f.Max})
+	}
+	if f.Type == FieldTypeArray && f.Items != nil {
+		obj = append(obj, orderedEntry{"items", fieldJSONSchema(f.Items)})
+	}
+	return obj
+}
+
+// nestFields "a.b" 형태의 필드를 객체 a의 프로퍼티 b로 변환 (하위 필드가 필수면 상위 객체도 필수)
+func nestFields(fields []FieldSchema) []FieldSchema {
+	var out []FieldSchema
+	index := make(map[string]int)
+
+	for _, f := range fields {
+		// 원본 스키마의 슬라이스를 공유하지 않도록 복사
+		f.Properties = append([]FieldSchema(nil), f.Properties...)
+
+		parent, child, nested := strings.Cut(f.Name, ".")
+		if !nested {
+			if i, ok := index[f.Name]; ok && out[i].Type == FieldTypeObject {
+				// 중첩 필드로 먼저 만들어진 객체에 정의를 합침
+				props := out[i].Properties
+				out[i] = f
+				out[i].Properties = append(f.Properties, props...)
+				continue
+			}
+			index[f.Name] = len(out)
+			out = append(out, f)
+			continue
+		}
+
+		i, ok := index[parent]
+		if !ok {
+			i = len(out)
+			index[parent] = i
+			out = append(out, FieldSchema{Name: parent, Type: FieldTypeObject})
+		}
+		f.Name = child
+		out[i].Properties = append(out[i].Properties, f)
+		out[i].Required = out[i].Required || f.Required
+	}
+	return out
+}
+
+// orderedObject 키 순서를 유지하는 JSON 객체
+type orderedObject []orderedEntry
+
+type orderedEntry struct {
+	key   string
+	value any
+}
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, e := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(e.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
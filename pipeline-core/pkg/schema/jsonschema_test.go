@@ -0,0 +1,209 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const orderJSONSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "order",
+  "description": "주문",
+  "type": "object",
+  "additionalProperties": false,
+  "required": ["order_id", "status", "customer", "note"],
+  "properties": {
+    "order_id": {"type": "string", "format": "uuid"},
+    "status": {"enum": ["new", "paid"]},
+    "amount": {"type": "number", "minimum": 0, "maximum": 1000000},
+    "quantity": {"type": "integer"},
+    "note": {"type": ["string", "null"], "maxLength": 200},
+    "customer": {"$ref": "#/$defs/customer"},
+    "items": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "required": ["sku"],
+        "properties": {
+          "sku": {"type": "string", "pattern": "^[A-Z]{3}-\\d+$"},
+          "price": {"type": "number"}
+        }
+      }
+    },
+    "channel": {"const": "web"},
+    "coupon": {"anyOf": [{"type": "string", "minLength": 4}, {"type": "null"}]}
+  },
+  "$defs": {
+    "customer": {
+      "type": "object",
+      "required": ["email"],
+      "properties": {
+        "email": {"type": "string", "format": "email"},
+        "name": {"type": "string"}
+      }
+    }
+  }
+}`
+
+func TestFromJSONSchema(t *testing.T) {
+	s, err := FromJSONSchema([]byte(orderJSONSchema))
+	if err != nil {
+		t.Fatalf("FromJSONSchema failed: %v", err)
+	}
+
+	if s.Name != "order" || s.Description != "주문" || !s.Strict {
+		t.Errorf("schema = %+v", s)
+	}
+
+	var names []string
+	for _, f := range s.Fields {
+		names = append(names, f.Name)
+	}
+	want := []string{"order_id", "status", "amount", "quantity", "note", "customer", "items", "channel", "coupon"}
+	if !reflect.DeepEqual(names, want) {
+		t.Fatalf("field order = %v", names)
+	}
+
+	fields := make(map[string]FieldSchema)
+	for _, f := range s.Fields {
+		fields[f.Name] = f
+	}
+	if f := fields["order_id"]; f.Type != FieldTypeString || f.Format != "uuid" || !f.Required {
+		t.Errorf("order_id = %+v", f)
+	}
+	if f := fields["status"]; f.Type != FieldTypeAny || !reflect.DeepEqual(f.Enum, []any{"new", "paid"}) {
+		t.Errorf("status = %+v", f)
+	}
+	if f := fields["amount"]; f.Type != FieldTypeNumber || *f.Min != 0 || *f.Max != 1000000 || f.Required {
+		t.Errorf("amount = %+v", f)
+	}
+	// 필수지만 null 허용이면 필수로 보지 않음
+	if f := fields["note"]; f.Type != FieldTypeString || f.Required || *f.MaxLength != 200 {
+		t.Errorf("note = %+v", f)
+	}
+	if f := fields["customer"]; f.Type != FieldTypeObject || !f.Required || len(f.Properties) != 2 || !f.Properties[0].Required {
+		t.Errorf("customer = %+v", f)
+	}
+	if f := fields["items"]; f.Type != FieldTypeArray || f.Items == nil || f.Items.Properties[0].Pattern != `^[A-Z]{3}-\d+$` {
+		t.Errorf("items = %+v", f)
+	}
+	if f := fields["channel"]; !reflect.DeepEqual(f.Enum, []any{"web"}) {
+		t.Errorf("channel = %+v", f)
+	}
+	if f := fields["coupon"]; f.Type != FieldTypeString || *f.MinLength != 4 {
+		t.Errorf("coupon = %+v", f)
+	}
+
+	valid := map[string]any{
+		"order_id": "123e4567-e89b-12d3-a456-426614174000",
+		"status":   "paid",
+		"note":     nil,
+		"customer": map[string]any{"email": "kim@example.com"},
+		"items":    []any{map[string]any{"sku": "ABC-1", "price": 10.0}},
+		"channel":  "web",
+	}
+	if err := s.Validate(valid); err != nil {
+		t.Errorf("valid record rejected: %v", err)
+	}
+
+	invalid := map[string]any{
+		"order_id": "not-a-uuid",
+		"status":   "cancelled",
+		"customer": map[string]any{"email": "kim@example.com"},
+		"items":    []any{map[string]any{"sku": "abc"}},
+		"extra":    true,
+	}
+	err = s.Validate(invalid)
+	if err == nil {
+		t.Fatal("invalid record accepted")
+	}
+	for _, field := range []string{"order_id", "status", "items[0].sku", "extra"} {
+		if !strings.Contains(err.Error(), field+":") {
+			t.Errorf("error %q does not mention %s", err, field)
+		}
+	}
+}
+
+func TestFromJSONSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+	}{
+		{"invalid json", `{`},
+		{"root not object", `{"type": "string"}`},
+		{"unsupported type", `{"type": "object", "properties": {"a": {"type": "decimal"}}}`},
+		{"unresolved ref", `{"type": "object", "properties": {"a": {"$ref": "#/$defs/missing"}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := FromJSONSchema([]byte(tt.definition)); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestFromJSONSchemaRecursiveRef(t *testing.T) {
+	s, err := FromJSONSchema([]byte(`{
+		"type": "object",
+		"properties": {"root": {"$ref": "#/$defs/node"}},
+		"$defs": {"node": {"type": "object", "properties": {"children": {"type": "array", "items": {"$ref": "#/$defs/node"}}}}}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	children := s.Fields[0].Properties[0]
+	if children.Type != FieldTypeArray || children.Items.Type != FieldTypeAny {
+		t.Errorf("children = %+v", children)
+	}
+}
+
+func TestToJSONSchemaRoundTrip(t *testing.T) {
+	original, err := FromJSONSchema([]byte(orderJSONSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := original.ToJSONSchema()
+	if err != nil {
+		t.Fatalf("ToJSONSchema failed: %v", err)
+	}
+	if !strings.HasPrefix(string(exported), `{"$schema":"https://json-schema.org/draft/2020-12/schema","title":"order"`) {
+		t.Errorf("exported = %s", exported)
+	}
+
+	imported, err := FromJSONSchema(exported)
+	if err != nil {
+		t.Fatalf("re-import failed: %v", err)
+	}
+	if !reflect.DeepEqual(original, imported) {
+		a, _ := json.Marshal(original)
+		b, _ := json.Marshal(imported)
+		t.Errorf("round trip differs:\n%s\n%s", a, b)
+	}
+}
+
+func TestToJSONSchemaNestedNames(t *testing.T) {
+	s := &DataSchema{Fields: []FieldSchema{
+		{Name: "id", Type: FieldTypeString, Required: true},
+		{Name: "meta.source", Type: FieldTypeString, Required: true},
+		{Name: "meta.tags", Type: FieldTypeArray, Items: &FieldSchema{Type: FieldTypeString}},
+	}}
+
+	exported, err := s.ToJSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object","properties":{` +
+		`"id":{"type":"string"},` +
+		`"meta":{"type":"object","properties":{"source":{"type":"string"},"tags":{"type":"array","items":{"type":"string"}}},"required":["source"]}},` +
+		`"required":["id","meta"]}`
+	if string(exported) != want {
+		t.Errorf("exported =\n%s\nwant\n%s", exported, want)
+	}
+	if s.Fields[1].Name != "meta.source" {
+		t.Error("ToJSONSchema modified the schema")
+	}
+}
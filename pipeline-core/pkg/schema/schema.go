@@ -3,9 +3,12 @@ package schema
 
 import (
 	"fmt"
-	"reflect"
+	"net"
+	"net/mail"
+	"net/url"
 	"regexp"
 	"strings"
+	"time"
 )
 
 // Schema 스키마 인터페이스
@@ -34,11 +37,13 @@ type FieldSchema struct {
 	Required    bool          `json:"required" yaml:"required"`
 	Description string        `json:"description,omitempty" yaml:"description,omitempty"`
 	Pattern     string        `json:"pattern,omitempty" yaml:"pattern,omitempty"`       // 정규식 패턴 (string 타입)
+	Format      string        `json:"format,omitempty" yaml:"format,omitempty"`         // 문자열 형식 (date-time, date, email, uuid, uri, ipv4, ipv6)
 	MinLength   *int          `json:"min_length,omitempty" yaml:"min_length,omitempty"` // 최소 길이
 	MaxLength   *int          `json:"max_length,omitempty" yaml:"max_length,omitempty"` // 최대 길이
 	Min         *float64      `json:"min,omitempty" yaml:"min,omitempty"`               // 최소값 (number 타입)
 	Max         *float64      `json:"max,omitempty" yaml:"max,omitempty"`               // 최대값 (number 타입)
 	Enum        []any         `json:"enum,omitempty" yaml:"enum,omitempty"`             // 허용 값 목록
+	Default     any           `json:"default,omitempty" yaml:"default,omitempty"`       // 기본값 (Coerce 시 누락/null 필드에 적용)
 	Items       *FieldSchema  `json:"items,omitempty" yaml:"items,omitempty"`           // 배열 아이템 스키마
 	Properties  []FieldSchema `json:"properties,omitempty" yaml:"properties,omitempty"` // 객체 프로퍼티
 }
@@ -52,6 +57,7 @@ type DataSchema struct {
 }
 
 // Validate 데이터 검증
+// 실패하면 중첩 필드까지 모든 오류를 경로(customer.name, items[0])별로 담은 *ValidationErrors를 반환
 func (s *DataSchema) Validate(data map[string]any) error {
 	errors := &ValidationErrors{}
 
@@ -65,9 +71,7 @@ func (s *DataSchema) Validate(data map[string]any) error {
 		}
 
 		if exists {
-			if err := s.validateField(&field, value); err != nil {
-				errors.Add(field.Name, err.Error())
-			}
+			validateValue(errors, field.Name, &field, value)
 		}
 	}
 
@@ -95,23 +99,30 @@ func (s *DataSchema) Validate(data map[string]any) error {
 func (s *DataSchema) ValidateField(fieldName string, value any) error {
 	for _, field := range s.Fields {
 		if field.Name == fieldName {
-			return s.validateField(&field, value)
+			errors := &ValidationErrors{}
+			validateValue(errors, field.Name, &field, value)
+			if errors.HasErrors() {
+				return errors
+			}
+			return nil
 		}
 	}
 	return fmt.Errorf("필드 '%s'가 스키마에 정의되지 않았습니다", fieldName)
 }
 
-func (s *DataSchema) validateField(field *FieldSchema, value any) error {
+// validateValue path 위치의 값을 검증하고 오류를 errors에 추가
+func validateValue(errors *ValidationErrors, path string, field *FieldSchema, value any) {
 	if value == nil {
 		if field.Required {
-			return fmt.Errorf("null 값은 허용되지 않습니다")
+			errors.Add(path, "null 값은 허용되지 않습니다")
 		}
-		return nil
+		return
 	}
 
 	// 타입 검증
 	if err := validateType(field.Type, value); err != nil {
-		return err
+		errors.Add(path, err.Error())
+		return
 	}
 
 	// 추가 검증
@@ -119,78 +130,58 @@ func (s *DataSchema) validateField(field *FieldSchema, value any) error {
 	case FieldTypeString:
 		str, _ := value.(string)
 		if field.MinLength != nil && len(str) < *field.MinLength {
-			return fmt.Errorf("최소 길이 %d 이상이어야 합니다", *field.MinLength)
+			errors.Add(path, fmt.Sprintf("최소 길이 %d 이상이어야 합니다", *field.MinLength))
 		}
 		if field.MaxLength != nil && len(str) > *field.MaxLength {
-			return fmt.Errorf("최대 길이 %d 이하여야 합니다", *field.MaxLength)
+			errors.Add(path, fmt.Sprintf("최대 길이 %d 이하여야 합니다", *field.MaxLength))
 		}
 		if field.Pattern != "" {
 			matched, err := regexp.MatchString(field.Pattern, str)
 			if err != nil {
-				return fmt.Errorf("패턴 검증 오류: %w", err)
-			}
-			if !matched {
-				return fmt.Errorf("패턴 '%s'와 일치하지 않습니다", field.Pattern)
+				errors.Add(path, fmt.Sprintf("패턴 검증 오류: %v", err))
+			} else if !matched {
+				errors.Add(path, fmt.Sprintf("패턴 '%s'와 일치하지 않습니다", field.Pattern))
 			}
 		}
+		if field.Format != "" && !matchFormat(field.Format, str) {
+			errors.Add(path, fmt.Sprintf("형식 '%s'와 일치하지 않습니다", field.Format))
+		}
 
 	case FieldTypeNumber, FieldTypeInteger:
 		num := toFloat64(value)
 		if field.Min != nil && num < *field.Min {
-			return fmt.Errorf("최소값 %v 이상이어야 합니다", *field.Min)
+			errors.Add(path, fmt.Sprintf("최소값 %v 이상이어야 합니다", *field.Min))
 		}
 		if field.Max != nil && num > *field.Max {
-			return fmt.Errorf("최대값 %v 이하여야 합니다", *field.Max)
+			errors.Add(path, fmt.Sprintf("최대값 %v 이하여야 합니다", *field.Max))
 		}
 
 	case FieldTypeArray:
-		arr, ok := value.([]any)
-		if !ok {
-			return fmt.Errorf("배열이 아닙니다")
-		}
 		if field.Items != nil {
-			for i, item := range arr {
-				if err := s.validateField(field.Items, item); err != nil {
-					return fmt.Errorf("배열[%d]: %w", i, err)
-				}
+			for i, item := range value.([]any) {
+				validateValue(errors, fmt.Sprintf("%s[%d]", path, i), field.Items, item)
 			}
 		}
 
 	case FieldTypeObject:
-		obj, ok := value.(map[string]any)
-		if !ok {
-			return fmt.Errorf("객체가 아닙니다")
-		}
-		if len(field.Properties) > 0 {
-			for _, prop := range field.Properties {
-				propValue, exists := obj[prop.Name]
-				if prop.Required && !exists {
-					return fmt.Errorf("속성 '%s' 누락", prop.Name)
-				}
-				if exists {
-					if err := s.validateField(&prop, propValue); err != nil {
-						return fmt.Errorf("속성 '%s': %w", prop.Name, err)
-					}
-				}
+		obj := value.(map[string]any)
+		for i := range field.Properties {
+			prop := &field.Properties[i]
+			propValue, exists := obj[prop.Name]
+			if prop.Required && !exists {
+				errors.Add(joinPath(path, prop.Name), "필수 필드가 누락되었습니다")
+				continue
+			}
+			if exists {
+				validateValue(errors, joinPath(path, prop.Name), prop, propValue)
 			}
 		}
 	}
 
 	// Enum 검증
-	if len(field.Enum) > 0 {
-		found := false
-		for _, enumVal := range field.Enum {
-			if reflect.DeepEqual(value, enumVal) {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return fmt.Errorf("허용되지 않는 값입니다. 허용 값: %v", field.Enum)
-		}
+	if len(field.Enum) > 0 && !containsValue(field.Enum, value) {
+		errors.Add(path, fmt.Sprintf("허용되지 않는 값입니다. 허용 값: %v", field.Enum))
 	}
-
-	return nil
 }
 
 func validateType(expectedType FieldType, value any) error {
@@ -255,6 +246,36 @@ func toFloat64(value any) float64 {
 	}
 }
 
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// matchFormat 문자열 형식 검사 (알 수 없는 형식은 JSON Schema처럼 주석으로 보고 통과)
+func matchFormat(format, value string) bool {
+	switch format {
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	case "date":
+		_, err := time.Parse("2006-01-02", value)
+		return err == nil
+	case "email":
+		addr, err := mail.ParseAddress(value)
+		return err == nil && addr.Address == value
+	case "uuid":
+		return uuidPattern.MatchString(value)
+	case "uri":
+		u, err := url.Parse(value)
+		return err == nil && u.Scheme != ""
+	case "ipv4":
+		ip := net.ParseIP(value)
+		return ip != nil && ip.To4() != nil && !strings.Contains(value, ":")
+	case "ipv6":
+		ip := net.ParseIP(value)
+		return ip != nil && strings.Contains(value, ":")
+	default:
+		return true
+	}
+}
+
 func getNestedField(data map[string]any, fieldPath string) (any, bool) {
 	parts := strings.Split(fieldPath, ".")
 	current := any(data)
@@ -315,6 +336,11 @@ func (e *ValidationErrors) Errors() []FieldError {
 
 // NewDataSchemaFromConfig 설정에서 DataSchema 생성
 func NewDataSchemaFromConfig(config map[string]any) (*DataSchema, error) {
+	// JSON Schema / Avro 정의 (type: json_schema|avro, definition: 문자열 또는 객체)
+	if typ, ok := config["type"].(string); ok && typ != "" {
+		return NewDataSchemaFromDefinition(typ, config["definition"])
+	}
+
 	schema := &DataSchema{}
 
 	if name, ok := config["name"].(string); ok {
@@ -360,6 +386,9 @@ func parseFieldSchema(config map[string]any) (*FieldSchema, error) {
 	if pattern, ok := config["pattern"].(string); ok {
 		field.Pattern = pattern
 	}
+	if format, ok := config["format"].(string); ok {
+		field.Format = format
+	}
 	if minLen, ok := config["min_length"].(float64); ok {
 		v := int(minLen)
 		field.MinLength = &v
@@ -377,6 +406,9 @@ func parseFieldSchema(config map[string]any) (*FieldSchema, error) {
 	if enum, ok := config["enum"].([]any); ok {
 		field.Enum = enum
 	}
+	if def, ok := config["default"]; ok {
+		field.Default = def
+	}
 
 	// 중첩 items (배열용)
 	if items, ok := config["items"].(map[string]any); ok {
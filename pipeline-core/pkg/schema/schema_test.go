@@ -148,6 +148,38 @@ func TestFieldSchemaStringValidation(t *testing.T) {
 	}
 }
 
+func TestFieldSchemaFormatValidation(t *testing.T) {
+	tests := []struct {
+		format    string
+		value     string
+		wantError bool
+	}{
+		{"date-time", "2024-01-02T03:04:05Z", false},
+		{"date-time", "2024-01-02", true},
+		{"date", "2024-01-02", false},
+		{"email", "kim@example.com", false},
+		{"email", "Kim <kim@example.com>", true},
+		{"uuid", "123e4567-e89b-12d3-a456-426614174000", false},
+		{"uuid", "123e4567", true},
+		{"uri", "https://example.com/a", false},
+		{"uri", "example.com", true},
+		{"ipv4", "10.0.0.1", false},
+		{"ipv4", "::1", true},
+		{"ipv6", "::1", false},
+		{"unknown-format", "anything", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+"/"+tt.value, func(t *testing.T) {
+			schema := DataSchema{Fields: []FieldSchema{{Name: "v", Type: FieldTypeString, Format: tt.format}}}
+			err := schema.Validate(map[string]any{"v": tt.value})
+			if (err != nil) != tt.wantError {
+				t.Errorf("Validate() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
 func TestFieldSchemaNumberValidation(t *testing.T) {
 	min := float64(0)
 	max := float64(100)
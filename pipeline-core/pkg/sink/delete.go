@@ -0,0 +1,254 @@
+package sink
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+	"github.com/conduix/conduix/shared/types"
+)
+
+// 삭제 감지 방식 (types.DeleteDetectionConfig.Method)
+const (
+	DetectNullBody  = "null_body"
+	DetectFlagField = "flag_field"
+	DetectEventType = "event_type"
+)
+
+// OperationKind 레코드를 싱크에 반영하는 방식
+type OperationKind string
+
+const (
+	OpWrite      OperationKind = "write"       // 일반 쓰기 (ID 필드가 있으면 upsert)
+	OpDelete     OperationKind = "delete"      // 물리 삭제
+	OpSoftDelete OperationKind = "soft_delete" // 삭제 플래그 필드만 갱신
+	OpSkip       OperationKind = "skip"        // 삭제 이벤트 무시
+)
+
+// Operation 레코드 하나에 대한 싱크 작업
+type Operation struct {
+	Kind   OperationKind
+	Key    map[string]any // ID 필드 값 (ID 필드가 없으면 nil)
+	Fields map[string]any // OpWrite: 쓸 필드 전체, OpSoftDelete: 갱신할 플래그 필드
+}
+
+// DeleteHandler DataType 삭제 전략에 따라 삭제 이벤트를 감지하고 싱크 작업으로 변환
+type DeleteHandler struct {
+	strategy *types.DeleteStrategy
+	idFields []string
+}
+
+// NewDeleteHandler 삭제 전략 핸들러 생성 (strategy가 nil이면 모든 레코드를 일반 쓰기로 처리)
+func NewDeleteHandler(strategy *types.DeleteStrategy, idFields []string) (*DeleteHandler, error) {
+	if err := ValidateDeleteStrategy(strategy, idFields); err != nil {
+		return nil, err
+	}
+	return &DeleteHandler{strategy: strategy, idFields: idFields}, nil
+}
+
+// ValidateDeleteStrategy 삭제 전략 설정 검증
+func ValidateDeleteStrategy(strategy *types.DeleteStrategy, idFields []string) error {
+	if strategy == nil {
+		return nil
+	}
+
+	switch strategy.Mode {
+	case types.DeleteModePhysical, types.DeleteModeSoft:
+		if len(idFields) == 0 {
+			return fmt.Errorf("id_fields are required for %s delete", strategy.Mode)
+		}
+	case types.DeleteModeIgnore:
+	default:
+		return fmt.Errorf("unsupported delete mode: %s", strategy.Mode)
+	}
+
+	if strategy.Mode == types.DeleteModeSoft {
+		soft := strategy.SoftDelete
+		if soft == nil || soft.FieldName == "" {
+			return fmt.Errorf("soft_delete field_name is required for soft delete")
+		}
+		switch soft.FieldType {
+		case types.SoftDeleteFieldTimestamp, types.SoftDeleteFieldStatus:
+		case types.SoftDeleteFieldBoolean:
+			if _, err := parseBoolValue(soft.DeleteValue, true); err != nil {
+				return fmt.Errorf("invalid soft_delete delete_value: %w", err)
+			}
+			if _, err := parseBoolValue(soft.ActiveValue, false); err != nil {
+				return fmt.Errorf("invalid soft_delete active_value: %w", err)
+			}
+		case types.SoftDeleteFieldCustom:
+			if soft.DeleteValue == "" {
+				return fmt.Errorf("soft_delete delete_value is required for custom field type")
+			}
+		default:
+			return fmt.Errorf("unsupported soft_delete field_type: %s", soft.FieldType)
+		}
+	}
+
+	if d := strategy.Detection; d != nil {
+		switch d.Method {
+		case "", DetectNullBody:
+		case DetectFlagField:
+			if d.FlagField == "" || d.FlagValue == "" {
+				return fmt.Errorf("detection flag_field and flag_value are required for flag_field method")
+			}
+		case DetectEventType:
+			if d.EventTypeField == "" || d.DeleteEventType == "" {
+				return fmt.Errorf("detection event_type_field and delete_event_type are required for event_type method")
+			}
+		default:
+			return fmt.Errorf("unsupported delete detection method: %s", d.Method)
+		}
+	}
+	return nil
+}
+
+// Operation 레코드를 싱크 작업으로 변환
+// 삭제로 감지된 레코드는 모드에 따라 물리 삭제, 플래그 갱신, 무시로 바뀌고
+// 일반 레코드는 논리 삭제 필드가 없으면 활성 값을 채워 쓴다 (삭제 후 재생성된 엔티티 복구)
+func (h *DeleteHandler) Operation(record source.Record) (Operation, error) {
+	if h == nil {
+		return Operation{Kind: OpWrite, Fields: record.Data}, nil
+	}
+
+	if h.strategy == nil || !h.isDelete(record.Data) {
+		op := Operation{Kind: OpWrite, Fields: record.Data}
+		if len(h.idFields) > 0 {
+			key, err := h.key(record.Data)
+			if err != nil {
+				return Operation{}, err
+			}
+			op.Key = key
+		}
+		if field, value, ok := h.activeValue(); ok {
+			if _, exists := record.Data[field]; !exists {
+				op.Fields = make(map[string]any, len(record.Data)+1)
+				for k, v := range record.Data {
+					op.Fields[k] = v
+				}
+				op.Fields[field] = value
+			}
+		}
+		return op, nil
+	}
+
+	switch h.strategy.Mode {
+	case types.DeleteModeIgnore:
+		return Operation{Kind: OpSkip}, nil
+	case types.DeleteModePhysical:
+		key, err := h.key(record.Data)
+		if err != nil {
+			return Operation{}, err
+		}
+		return Operation{Kind: OpDelete, Key: key}, nil
+	default: // soft
+		key, err := h.key(record.Data)
+		if err != nil {
+			return Operation{}, err
+		}
+		soft := h.strategy.SoftDelete
+		return Operation{Kind: OpSoftDelete, Key: key, Fields: map[string]any{soft.FieldName: h.deleteValue()}}, nil
+	}
+}
+
+// isDelete 감지 방식에 따라 삭제 이벤트인지 확인 (감지 설정이 없으면 null_body)
+func (h *DeleteHandler) isDelete(data map[string]any) bool {
+	d := h.strategy.Detection
+	if d == nil {
+		d = &types.DeleteDetectionConfig{Method: DetectNullBody}
+	}
+
+	switch d.Method {
+	case DetectFlagField:
+		v, ok := data[d.FlagField]
+		return ok && v != nil && strings.EqualFold(fmt.Sprint(v), d.FlagValue)
+	case DetectEventType:
+		v, ok := data[d.EventTypeField]
+		return ok && v != nil && strings.EqualFold(fmt.Sprint(v), d.DeleteEventType)
+	default: // null_body: ID 필드 외에 값이 있는 필드가 없음
+		if len(data) == 0 {
+			return false
+		}
+		ids := make(map[string]bool, len(h.idFields))
+		for _, f := range h.idFields {
+			ids[f] = true
+		}
+		hasID := false
+		for k, v := range data {
+			if ids[k] {
+				hasID = hasID || v != nil
+				continue
+			}
+			if v != nil {
+				return false
+			}
+		}
+		return hasID
+	}
+}
+
+// key 레코드의 ID 필드 값
+func (h *DeleteHandler) key(data map[string]any) (map[string]any, error) {
+	key := make(map[string]any, len(h.idFields))
+	for _, f := range h.idFields {
+		v, ok := data[f]
+		if !ok || v == nil {
+			return nil, fmt.Errorf("id field %s is missing", f)
+		}
+		key[f] = v
+	}
+	return key, nil
+}
+
+// deleteValue 논리 삭제 시 플래그 필드에 쓸 값
+func (h *DeleteHandler) deleteValue() any {
+	soft := h.strategy.SoftDelete
+	switch soft.FieldType {
+	case types.SoftDeleteFieldTimestamp:
+		return time.Now().UTC()
+	case types.SoftDeleteFieldBoolean:
+		v, _ := parseBoolValue(soft.DeleteValue, true)
+		return v
+	case types.SoftDeleteFieldStatus:
+		if soft.DeleteValue == "" {
+			return "D"
+		}
+		return soft.DeleteValue
+	default:
+		return soft.DeleteValue
+	}
+}
+
+// activeValue 일반 쓰기에서 논리 삭제 필드에 채울 활성 값
+func (h *DeleteHandler) activeValue() (string, any, bool) {
+	if h.strategy == nil || h.strategy.Mode != types.DeleteModeSoft {
+		return "", nil, false
+	}
+	soft := h.strategy.SoftDelete
+	switch soft.FieldType {
+	case types.SoftDeleteFieldTimestamp:
+		return soft.FieldName, nil, true
+	case types.SoftDeleteFieldBoolean:
+		v, _ := parseBoolValue(soft.ActiveValue, false)
+		return soft.FieldName, v, true
+	case types.SoftDeleteFieldStatus:
+		if soft.ActiveValue == "" {
+			return soft.FieldName, "A", true
+		}
+		return soft.FieldName, soft.ActiveValue, true
+	default:
+		if soft.ActiveValue == "" {
+			return "", nil, false
+		}
+		return soft.FieldName, soft.ActiveValue, true
+	}
+}
+
+func parseBoolValue(s string, def bool) (bool, error) {
+	if s == "" {
+		return def, nil
+	}
+	return strconv.ParseBool(s)
+}
@@ -0,0 +1,166 @@
+package sink
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+	"github.com/conduix/conduix/shared/types"
+)
+
+func TestDeleteHandlerDetection(t *testing.T) {
+	physical := func(d *types.DeleteDetectionConfig) *types.DeleteStrategy {
+		return &types.DeleteStrategy{Mode: types.DeleteModePhysical, Detection: d}
+	}
+
+	tests := []struct {
+		name     string
+		strategy *types.DeleteStrategy
+		data     map[string]any
+		want     OperationKind
+	}{
+		{"no strategy", nil, map[string]any{"id": 1}, OpWrite},
+		{"null body", physical(nil), map[string]any{"id": 1}, OpDelete},
+		{"null body with null fields", physical(&types.DeleteDetectionConfig{Method: DetectNullBody}), map[string]any{"id": 1, "name": nil}, OpDelete},
+		{"body present", physical(nil), map[string]any{"id": 1, "name": "a"}, OpWrite},
+		{"flag field", physical(&types.DeleteDetectionConfig{Method: DetectFlagField, FlagField: "deleted", FlagValue: "true"}),
+			map[string]any{"id": 1, "name": "a", "deleted": true}, OpDelete},
+		{"flag field not set", physical(&types.DeleteDetectionConfig{Method: DetectFlagField, FlagField: "deleted", FlagValue: "true"}),
+			map[string]any{"id": 1, "deleted": false}, OpWrite},
+		{"event type", physical(&types.DeleteDetectionConfig{Method: DetectEventType, EventTypeField: "__op", DeleteEventType: "d"}),
+			map[string]any{"id": 1, "name": "a", "__op": "d"}, OpDelete},
+		{"event type update", physical(&types.DeleteDetectionConfig{Method: DetectEventType, EventTypeField: "__op", DeleteEventType: "d"}),
+			map[string]any{"id": 1, "__op": "u"}, OpWrite},
+		{"ignore", &types.DeleteStrategy{Mode: types.DeleteModeIgnore}, map[string]any{"id": 1}, OpSkip},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewDeleteHandler(tt.strategy, []string{"id"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			op, err := h.Operation(source.Record{Data: tt.data})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if op.Kind != tt.want {
+				t.Errorf("kind = %s, want %s", op.Kind, tt.want)
+			}
+			if op.Kind == OpDelete && op.Key["id"] != 1 {
+				t.Errorf("key = %v", op.Key)
+			}
+		})
+	}
+}
+
+func TestDeleteHandlerSoftDelete(t *testing.T) {
+	tests := []struct {
+		name       string
+		soft       *types.SoftDeleteConfig
+		wantDelete any
+		wantActive any
+	}{
+		{"boolean", &types.SoftDeleteConfig{FieldType: types.SoftDeleteFieldBoolean, FieldName: "is_deleted"}, true, false},
+		{"status", &types.SoftDeleteConfig{FieldType: types.SoftDeleteFieldStatus, FieldName: "status"}, "D", "A"},
+		{"custom", &types.SoftDeleteConfig{FieldType: types.SoftDeleteFieldCustom, FieldName: "state", DeleteValue: "removed", ActiveValue: "live"}, "removed", "live"},
+		{"timestamp", &types.SoftDeleteConfig{FieldType: types.SoftDeleteFieldTimestamp, FieldName: "deleted_at"}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := NewDeleteHandler(&types.DeleteStrategy{Mode: types.DeleteModeSoft, SoftDelete: tt.soft}, []string{"id"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			op, err := h.Operation(source.Record{Data: map[string]any{"id": 7}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if op.Kind != OpSoftDelete || op.Key["id"] != 7 || len(op.Fields) != 1 {
+				t.Fatalf("op = %+v", op)
+			}
+			got := op.Fields[tt.soft.FieldName]
+			if tt.soft.FieldType == types.SoftDeleteFieldTimestamp {
+				if ts, ok := got.(time.Time); !ok || time.Since(ts) > time.Minute {
+					t.Errorf("deleted_at = %v", got)
+				}
+			} else if got != tt.wantDelete {
+				t.Errorf("delete value = %v, want %v", got, tt.wantDelete)
+			}
+
+			// 일반 쓰기는 활성 값을 채움 (레코드에 이미 있으면 유지)
+			op, _ = h.Operation(source.Record{Data: map[string]any{"id": 7, "name": "a"}})
+			if v, ok := op.Fields[tt.soft.FieldName]; op.Kind != OpWrite || !ok || v != tt.wantActive {
+				t.Errorf("write op = %+v", op)
+			}
+			op, _ = h.Operation(source.Record{Data: map[string]any{"id": 7, "name": "a", tt.soft.FieldName: "kept"}})
+			if op.Fields[tt.soft.FieldName] != "kept" {
+				t.Errorf("existing flag overwritten: %+v", op)
+			}
+		})
+	}
+}
+
+func TestValidateDeleteStrategy(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy *types.DeleteStrategy
+		idFields []string
+		wantErr  bool
+	}{
+		{"none", nil, nil, false},
+		{"physical without id fields", &types.DeleteStrategy{Mode: types.DeleteModePhysical}, nil, true},
+		{"ignore without id fields", &types.DeleteStrategy{Mode: types.DeleteModeIgnore}, nil, false},
+		{"soft without config", &types.DeleteStrategy{Mode: types.DeleteModeSoft}, []string{"id"}, true},
+		{"bad boolean", &types.DeleteStrategy{Mode: types.DeleteModeSoft, SoftDelete: &types.SoftDeleteConfig{
+			FieldType: types.SoftDeleteFieldBoolean, FieldName: "is_deleted", DeleteValue: "yes please"}}, []string{"id"}, true},
+		{"flag without value", &types.DeleteStrategy{Mode: types.DeleteModePhysical, Detection: &types.DeleteDetectionConfig{
+			Method: DetectFlagField, FlagField: "deleted"}}, []string{"id"}, true},
+		{"unknown method", &types.DeleteStrategy{Mode: types.DeleteModePhysical, Detection: &types.DeleteDetectionConfig{
+			Method: "tombstone"}}, []string{"id"}, true},
+		{"unknown mode", &types.DeleteStrategy{Mode: "archive"}, []string{"id"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateDeleteStrategy(tt.strategy, tt.idFields); (err != nil) != tt.wantErr {
+				t.Errorf("err = %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteStrategyPresetsValid(t *testing.T) {
+	for _, preset := range types.DefaultDeleteStrategyPresets {
+		if err := ValidateDeleteStrategy(preset.Strategy, []string{"id"}); err != nil {
+			t.Errorf("preset %s: %v", preset.ID, err)
+		}
+	}
+}
+
+func TestDeleteHandlerMissingKey(t *testing.T) {
+	h, _ := NewDeleteHandler(&types.DeleteStrategy{Mode: types.DeleteModePhysical}, []string{"order_id", "item_id"})
+	if _, err := h.Operation(source.Record{Data: map[string]any{"order_id": 1, "name": "a"}}); err == nil {
+		t.Error("expected missing key error")
+	}
+}
+
+func TestDeleteHandlerKeyWithoutStrategy(t *testing.T) {
+	h, err := NewDeleteHandler(nil, []string{"order_id", "item_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	op, err := h.Operation(source.Record{Data: map[string]any{"order_id": 1, "item_id": 2, "deleted": true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Kind != OpWrite {
+		t.Errorf("kind = %s, want %s", op.Kind, OpWrite)
+	}
+	if want := map[string]any{"order_id": 1, "item_id": 2}; !reflect.DeepEqual(op.Key, want) {
+		t.Errorf("key = %v, want %v", op.Key, want)
+	}
+}
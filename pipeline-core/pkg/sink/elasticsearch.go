@@ -0,0 +1,252 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+// ElasticsearchSink 인덱스 출력 (bulk API)
+// id_fields가 있으면 문서 _id로 써서 같은 키를 덮어쓰고, 삭제 이벤트는 delete 또는 부분 update(논리 삭제)로 보낸다
+type ElasticsearchSink struct {
+	cfg       elasticsearch.Config
+	index     string
+	idFields  []string
+	deletes   *DeleteHandler
+	batchSize int
+
+	mu      sync.Mutex
+	client  *elasticsearch.Client
+	body    bytes.Buffer
+	pending int64
+
+	stats SinkStats
+}
+
+// NewElasticsearchSink Elasticsearch 싱크 생성
+func NewElasticsearchSink(cfg config.OutputConfig) (*ElasticsearchSink, error) {
+	if len(cfg.Addresses) == 0 || cfg.Index == "" {
+		return nil, fmt.Errorf("elasticsearch sink addresses and index are required")
+	}
+	deletes, err := NewDeleteHandler(cfg.DeleteStrategy, cfg.IDFields)
+	if err != nil {
+		return nil, err
+	}
+
+	esCfg := elasticsearch.Config{Addresses: cfg.Addresses}
+	if cfg.APIKey != "" {
+		esCfg.APIKey = cfg.APIKey
+	} else if cfg.Username != "" {
+		esCfg.Username = cfg.Username
+		esCfg.Password = cfg.Password
+	}
+
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	return &ElasticsearchSink{
+		cfg:       esCfg,
+		index:     cfg.Index,
+		idFields:  cfg.IDFields,
+		deletes:   deletes,
+		batchSize: batchSize,
+	}, nil
+}
+
+func (s *ElasticsearchSink) Name() string {
+	return "elasticsearch"
+}
+
+func (s *ElasticsearchSink) Open(ctx context.Context) error {
+	client, err := elasticsearch.NewClient(s.cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create elasticsearch client: %w", err)
+	}
+
+	s.mu.Lock()
+	s.client = client
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *ElasticsearchSink) Write(ctx context.Context, record source.Record) error {
+	atomic.AddInt64(&s.stats.TotalRecords, 1)
+
+	op, err := s.deletes.Operation(record)
+	if err == nil && op.Kind != OpSkip {
+		err = s.append(op)
+	}
+	if err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return err
+	}
+	if op.Kind == OpSkip {
+		atomic.AddInt64(&s.stats.SuccessRecords, 1)
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending >= int64(s.batchSize) {
+		return s.exec(ctx)
+	}
+	return nil
+}
+
+// append 작업을 bulk 요청 본문에 추가
+func (s *ElasticsearchSink) append(op Operation) error {
+	meta := map[string]any{"_index": s.index}
+	if op.Key != nil {
+		meta["_id"] = documentID(s.idFields, op.Key)
+	}
+
+	var action string
+	var doc any
+	switch op.Kind {
+	case OpDelete:
+		action = "delete"
+	case OpSoftDelete:
+		action, doc = "update", map[string]any{"doc": op.Fields}
+	default:
+		action, doc = "index", op.Fields
+	}
+
+	line, err := json.Marshal(map[string]any{action: meta})
+	if err != nil {
+		return fmt.Errorf("failed to encode bulk action: %w", err)
+	}
+	var docLine []byte
+	if doc != nil {
+		if docLine, err = json.Marshal(doc); err != nil {
+			return fmt.Errorf("failed to encode document: %w", err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return fmt.Errorf("elasticsearch sink is not open")
+	}
+	s.body.Write(line)
+	s.body.WriteByte('\n')
+	if docLine != nil {
+		s.body.Write(docLine)
+		s.body.WriteByte('\n')
+	}
+	s.pending++
+	return nil
+}
+
+// exec 쌓인 작업을 bulk 요청으로 전송 (mu를 잡은 상태에서 호출)
+// 항목별 실패는 레코드 단위로 세고, 없는 문서의 delete/update(404)는 SQL처럼 대상 없음으로 보고 성공으로 센다
+func (s *ElasticsearchSink) exec(ctx context.Context) error {
+	if s.pending == 0 {
+		return nil
+	}
+	count := s.pending
+	body := bytes.NewReader(append([]byte(nil), s.body.Bytes()...))
+	s.pending = 0
+	s.body.Reset()
+
+	res, err := s.client.Bulk(body, s.client.Bulk.WithContext(ctx))
+	if err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, count)
+		return fmt.Errorf("elasticsearch bulk failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		msg, _ := io.ReadAll(res.Body)
+		atomic.AddInt64(&s.stats.ErrorRecords, count)
+		return fmt.Errorf("elasticsearch bulk failed: %s: %s", res.Status(), strings.TrimSpace(string(msg)))
+	}
+
+	var result struct {
+		Errors bool                        `json:"errors"`
+		Items  []map[string]map[string]any `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, count)
+		return fmt.Errorf("failed to decode bulk response: %w", err)
+	}
+
+	var failed int64
+	var firstErr string
+	if result.Errors {
+		for _, item := range result.Items {
+			for action, detail := range item {
+				status, _ := detail["status"].(float64)
+				if status < 300 || (action != "index" && status == http.StatusNotFound) {
+					continue
+				}
+				failed++
+				if firstErr == "" {
+					errJSON, _ := json.Marshal(detail["error"])
+					firstErr = fmt.Sprintf("%s %v: %s", action, detail["_id"], errJSON)
+				}
+			}
+		}
+	}
+
+	atomic.AddInt64(&s.stats.SuccessRecords, count-failed)
+	atomic.AddInt64(&s.stats.ErrorRecords, failed)
+	s.stats.LastWriteTime = time.Now()
+	if failed > 0 {
+		return fmt.Errorf("elasticsearch bulk: %d of %d items failed (first: %s)", failed, count, firstErr)
+	}
+	return nil
+}
+
+func (s *ElasticsearchSink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+	return s.exec(ctx)
+}
+
+// Close 남은 작업을 전송
+func (s *ElasticsearchSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+	err := s.exec(context.Background())
+	s.client = nil
+	return err
+}
+
+func (s *ElasticsearchSink) Stats() SinkStats {
+	return s.stats
+}
+
+// documentID ID 필드 값으로 문서 ID 생성
+// 중복 제거 키와 같은 정규 인코딩을 써서 소스마다 숫자/문자열 타입이 달라도 같은 문서를 가리킨다
+func documentID(idFields []string, key map[string]any) string {
+	values := make([]any, len(idFields))
+	for i, f := range idFields {
+		values[i] = key[f]
+	}
+	return dedup.CanonicalKey(values...)
+}
@@ -0,0 +1,141 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+	"github.com/conduix/conduix/shared/types"
+)
+
+// fakeBulk bulk 요청의 action 줄을 기록하고 지정한 _id의 항목은 실패로 응답
+type fakeBulk struct {
+	mu      sync.Mutex
+	actions []map[string]map[string]any
+	docs    []map[string]any
+	fail    map[string]int // _id → status
+}
+
+func (f *fakeBulk) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+
+	var items []any
+	hasErrors := false
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		var action map[string]map[string]any
+		_ = json.Unmarshal(scanner.Bytes(), &action)
+		f.actions = append(f.actions, action)
+
+		for name, meta := range action {
+			if name != "delete" && scanner.Scan() {
+				var doc map[string]any
+				_ = json.Unmarshal(scanner.Bytes(), &doc)
+				f.docs = append(f.docs, doc)
+			}
+			status := 200
+			if s, ok := f.fail[meta["_id"].(string)]; ok {
+				status, hasErrors = s, true
+			}
+			items = append(items, map[string]any{name: map[string]any{"_id": meta["_id"], "status": status}})
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{"errors": hasErrors, "items": items})
+}
+
+func TestElasticsearchSinkDeleteStrategy(t *testing.T) {
+	fake := &fakeBulk{fail: map[string]int{"3|b": 404}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	sink, err := NewSink(config.OutputConfig{
+		Type:      "elasticsearch",
+		Addresses: []string{server.URL},
+		Index:     "orders",
+		IDFields:  []string{"order_id", "item"},
+		DeleteStrategy: &types.DeleteStrategy{
+			Mode:       types.DeleteModeSoft,
+			SoftDelete: &types.SoftDeleteConfig{FieldType: types.SoftDeleteFieldBoolean, FieldName: "is_deleted"},
+			Detection:  &types.DeleteDetectionConfig{Method: DetectFlagField, FlagField: "op", FlagValue: "D"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := sink.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, data := range []map[string]any{
+		{"order_id": 1, "item": "a", "qty": 2},
+		{"order_id": "1", "item": "a", "op": "d"}, // 문자열 키도 같은 문서
+		{"order_id": 3, "item": "b", "op": "D"},   // 없는 문서의 update(404)는 성공
+	} {
+		if err := sink.Write(ctx, source.Record{Data: data}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fake.actions) != 3 {
+		t.Fatalf("actions = %v", fake.actions)
+	}
+	if meta := fake.actions[0]["index"]; meta["_id"] != "1|a" || meta["_index"] != "orders" {
+		t.Errorf("index action = %v", fake.actions[0])
+	}
+	if meta := fake.actions[1]["update"]; meta["_id"] != "1|a" {
+		t.Errorf("update action = %v", fake.actions[1])
+	}
+	if fake.docs[0]["is_deleted"] != false {
+		t.Errorf("active flag = %v", fake.docs[0])
+	}
+	if doc, _ := fake.docs[1]["doc"].(map[string]any); doc["is_deleted"] != true || len(doc) != 1 {
+		t.Errorf("soft delete doc = %v", fake.docs[1])
+	}
+	if stats := sink.Stats(); stats.SuccessRecords != 3 || stats.ErrorRecords != 0 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestElasticsearchSinkItemErrors(t *testing.T) {
+	fake := &fakeBulk{fail: map[string]int{"2": 400}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	sink, _ := NewSink(config.OutputConfig{
+		Type:           "elasticsearch",
+		Addresses:      []string{server.URL},
+		Index:          "users",
+		IDFields:       []string{"id"},
+		DeleteStrategy: &types.DeleteStrategy{Mode: types.DeleteModePhysical},
+	})
+	ctx := context.Background()
+	_ = sink.Open(ctx)
+	_ = sink.Write(ctx, source.Record{Data: map[string]any{"id": 1}})
+	_ = sink.Write(ctx, source.Record{Data: map[string]any{"id": 2, "name": "b"}})
+
+	err := sink.Flush(ctx)
+	if err == nil || !strings.Contains(err.Error(), "1 of 2") {
+		t.Errorf("err = %v", err)
+	}
+	if _, ok := fake.actions[0]["delete"]; !ok {
+		t.Errorf("first action = %v", fake.actions[0])
+	}
+	if stats := sink.Stats(); stats.SuccessRecords != 1 || stats.ErrorRecords != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
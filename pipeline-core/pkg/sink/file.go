@@ -0,0 +1,138 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/codec"
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+// FileSink 파일 출력 (ndjson, parquet, avro + gzip/zstd 압축)
+type FileSink struct {
+	path        string
+	format      string
+	compression string
+
+	mu         sync.Mutex
+	file       *os.File
+	compressor io.WriteCloser
+	encoder    codec.Encoder
+
+	stats SinkStats
+}
+
+// NewFileSink 파일 싱크 생성
+func NewFileSink(cfg config.OutputConfig) (*FileSink, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("file sink path is required")
+	}
+
+	format := cfg.Format
+	if format == "" {
+		format = codec.FormatNDJSON
+	}
+
+	compression := cfg.Compression
+	if compression == "" {
+		compression = codec.CompressionNone
+	}
+
+	return &FileSink{
+		path:        cfg.Path,
+		format:      format,
+		compression: compression,
+	}, nil
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+func (s *FileSink) Open(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.Create(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", s.path, err)
+	}
+
+	compressor, err := codec.NewCompressor(file, s.compression)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	encoder, err := codec.NewEncoder(s.format, compressor, codec.Options{})
+	if err != nil {
+		compressor.Close()
+		file.Close()
+		return err
+	}
+
+	s.file = file
+	s.compressor = compressor
+	s.encoder = encoder
+	return nil
+}
+
+func (s *FileSink) Write(ctx context.Context, record source.Record) error {
+	atomic.AddInt64(&s.stats.TotalRecords, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.encoder == nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return fmt.Errorf("file sink is not open")
+	}
+	if err := s.encoder.Encode(codec.NormalizeRecord(record.Data)); err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return fmt.Errorf("failed to encode record: %w", err)
+	}
+
+	atomic.AddInt64(&s.stats.SuccessRecords, 1)
+	s.stats.LastWriteTime = time.Now()
+	return nil
+}
+
+// Flush parquet/avro는 Close 시점에 블록이 기록되므로 파일 동기화만 수행
+func (s *FileSink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Sync()
+}
+
+// Close 인코더, 압축, 파일 순서로 닫음
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.encoder == nil {
+		return nil
+	}
+
+	var err error
+	for _, closer := range []io.Closer{s.encoder, s.compressor, s.file} {
+		if cerr := closer.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	s.encoder, s.compressor, s.file = nil, nil, nil
+	return err
+}
+
+func (s *FileSink) Stats() SinkStats {
+	return s.stats
+}
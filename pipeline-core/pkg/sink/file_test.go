@@ -0,0 +1,69 @@
+package sink
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/codec"
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+func TestFileSinkRoundTrip(t *testing.T) {
+	tests := []struct {
+		format      string
+		compression string
+	}{
+		{codec.FormatNDJSON, codec.CompressionGzip},
+		{codec.FormatParquet, codec.CompressionNone},
+		{codec.FormatAvro, codec.CompressionZstd},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format+"/"+tt.compression, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "out")
+			sink, err := NewSink(config.OutputConfig{Type: "file", Path: path, Format: tt.format, Compression: tt.compression})
+			if err != nil {
+				t.Fatalf("NewSink failed: %v", err)
+			}
+
+			ctx := context.Background()
+			if err := sink.Open(ctx); err != nil {
+				t.Fatalf("open failed: %v", err)
+			}
+			for i := 1; i <= 3; i++ {
+				if err := sink.Write(ctx, source.Record{Data: map[string]any{"id": i, "name": "n"}}); err != nil {
+					t.Fatalf("write failed: %v", err)
+				}
+			}
+			if err := sink.Close(); err != nil {
+				t.Fatalf("close failed: %v", err)
+			}
+
+			f, _ := os.Open(path)
+			defer f.Close()
+			rc, err := codec.NewDecompressor(f, tt.compression)
+			if err != nil {
+				t.Fatalf("NewDecompressor failed: %v", err)
+			}
+			defer rc.Close()
+
+			dec, err := codec.NewDecoder(tt.format, rc, codec.Options{})
+			if err != nil {
+				t.Fatalf("NewDecoder failed: %v", err)
+			}
+			count := 0
+			for {
+				if _, err := dec.Decode(); err != nil {
+					break
+				}
+				count++
+			}
+			if count != 3 || sink.Stats().SuccessRecords != 3 {
+				t.Errorf("expected 3 records, got %d (stats %+v)", count, sink.Stats())
+			}
+		})
+	}
+}
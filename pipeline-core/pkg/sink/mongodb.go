@@ -0,0 +1,185 @@
+package sink
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+// MongoDBSink 컬렉션 출력 (bulk write)
+// id_fields가 있으면 키 필터로 문서를 교체(upsert)하고, 삭제 이벤트는 deleteOne 또는 $set(논리 삭제)으로 바꾼다.
+// 작업은 batch_size개씩 순서 보장(ordered) bulk write로 보낸다
+type MongoDBSink struct {
+	uri        string
+	database   string
+	collection string
+	idFields   []string
+	deletes    *DeleteHandler
+	batchSize  int
+
+	mu      sync.Mutex
+	client  *mongo.Client
+	pending []mongo.WriteModel
+
+	stats SinkStats
+}
+
+// NewMongoDBSink MongoDB 싱크 생성
+func NewMongoDBSink(cfg config.OutputConfig) (*MongoDBSink, error) {
+	if cfg.URI == "" || cfg.Database == "" || cfg.Collection == "" {
+		return nil, fmt.Errorf("mongodb sink uri, database and collection are required")
+	}
+	deletes, err := NewDeleteHandler(cfg.DeleteStrategy, cfg.IDFields)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	return &MongoDBSink{
+		uri:        cfg.URI,
+		database:   cfg.Database,
+		collection: cfg.Collection,
+		idFields:   cfg.IDFields,
+		deletes:    deletes,
+		batchSize:  batchSize,
+	}, nil
+}
+
+func (s *MongoDBSink) Name() string {
+	return "mongodb"
+}
+
+func (s *MongoDBSink) Open(ctx context.Context) error {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(s.uri))
+	if err != nil {
+		return fmt.Errorf("failed to connect to mongodb: %w", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		_ = client.Disconnect(context.Background())
+		return fmt.Errorf("failed to ping mongodb: %w", err)
+	}
+
+	s.mu.Lock()
+	s.client = client
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MongoDBSink) Write(ctx context.Context, record source.Record) error {
+	atomic.AddInt64(&s.stats.TotalRecords, 1)
+
+	op, err := s.deletes.Operation(record)
+	if err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return err
+	}
+	if op.Kind == OpSkip {
+		atomic.AddInt64(&s.stats.SuccessRecords, 1)
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return fmt.Errorf("mongodb sink is not open")
+	}
+
+	s.pending = append(s.pending, s.writeModel(op))
+	if len(s.pending) >= s.batchSize {
+		return s.exec(ctx)
+	}
+	return nil
+}
+
+// writeModel 작업에 해당하는 bulk write 모델
+func (s *MongoDBSink) writeModel(op Operation) mongo.WriteModel {
+	filter := bson.D{}
+	for _, f := range s.idFields {
+		filter = append(filter, bson.E{Key: f, Value: op.Key[f]})
+	}
+
+	switch op.Kind {
+	case OpDelete:
+		return mongo.NewDeleteOneModel().SetFilter(filter)
+	case OpSoftDelete:
+		return mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(bson.M{"$set": op.Fields})
+	default:
+		if op.Key == nil {
+			return mongo.NewInsertOneModel().SetDocument(op.Fields)
+		}
+		return mongo.NewReplaceOneModel().SetFilter(filter).SetReplacement(op.Fields).SetUpsert(true)
+	}
+}
+
+// exec 쌓인 작업을 bulk write로 전송 (mu를 잡은 상태에서 호출)
+// ordered bulk write는 첫 실패에서 멈추므로 실패 이후의 작업도 실패로 센다
+func (s *MongoDBSink) exec(ctx context.Context) error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	models := s.pending
+	s.pending = nil
+
+	coll := s.client.Database(s.database).Collection(s.collection)
+	_, err := coll.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(true))
+	if err != nil {
+		failed := int64(len(models))
+		var bulkErr mongo.BulkWriteException
+		if errors.As(err, &bulkErr) && len(bulkErr.WriteErrors) > 0 {
+			failed = int64(len(models) - bulkErr.WriteErrors[0].Index)
+		}
+		atomic.AddInt64(&s.stats.SuccessRecords, int64(len(models))-failed)
+		atomic.AddInt64(&s.stats.ErrorRecords, failed)
+		return fmt.Errorf("mongodb bulk write failed: %w", err)
+	}
+
+	atomic.AddInt64(&s.stats.SuccessRecords, int64(len(models)))
+	s.stats.LastWriteTime = time.Now()
+	return nil
+}
+
+func (s *MongoDBSink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+	return s.exec(ctx)
+}
+
+// Close 남은 작업을 전송하고 연결을 닫음
+func (s *MongoDBSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+	err := s.exec(context.Background())
+	if derr := s.client.Disconnect(context.Background()); derr != nil && err == nil {
+		err = derr
+	}
+	s.client = nil
+	return err
+}
+
+func (s *MongoDBSink) Stats() SinkStats {
+	return s.stats
+}
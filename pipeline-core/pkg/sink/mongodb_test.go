@@ -0,0 +1,33 @@
+package sink
+
+import (
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestMongoDBSinkWriteModel(t *testing.T) {
+	s := &MongoDBSink{idFields: []string{"tenant", "id"}}
+	key := map[string]any{"tenant": "t1", "id": 7}
+	filter := bson.D{{Key: "tenant", Value: "t1"}, {Key: "id", Value: 7}}
+
+	replace, ok := s.writeModel(Operation{Kind: OpWrite, Key: key, Fields: map[string]any{"tenant": "t1", "id": 7, "name": "a"}}).(*mongo.ReplaceOneModel)
+	if !ok || !reflect.DeepEqual(replace.Filter, filter) || replace.Upsert == nil || !*replace.Upsert {
+		t.Errorf("write model = %#v", replace)
+	}
+
+	if insert, ok := s.writeModel(Operation{Kind: OpWrite, Fields: map[string]any{"name": "a"}}).(*mongo.InsertOneModel); !ok || insert.Document == nil {
+		t.Errorf("insert model = %#v", insert)
+	}
+
+	if del, ok := s.writeModel(Operation{Kind: OpDelete, Key: key}).(*mongo.DeleteOneModel); !ok || !reflect.DeepEqual(del.Filter, filter) {
+		t.Errorf("delete model = %#v", del)
+	}
+
+	update, ok := s.writeModel(Operation{Kind: OpSoftDelete, Key: key, Fields: map[string]any{"status": "D"}}).(*mongo.UpdateOneModel)
+	if !ok || !reflect.DeepEqual(update.Update, bson.M{"$set": map[string]any{"status": "D"}}) {
+		t.Errorf("update model = %#v", update)
+	}
+}
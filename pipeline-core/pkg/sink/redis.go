@@ -0,0 +1,257 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+// RedisSink Redis 출력 (xadd, hset, lpush, rpush)
+// 명령은 batch_size개씩 파이프라인으로 묶어 보내며, Flush 시 남은 명령을 모두 보낸다
+type RedisSink struct {
+	options   *redis.Options
+	command   string
+	key       string
+	keyTmpl   *template.Template // key에 {{ }}가 있을 때만 사용
+	maxLen    int64
+	ttl       time.Duration
+	batchSize int
+
+	mu      sync.Mutex
+	client  *redis.Client
+	pipe    redis.Pipeliner
+	pending int64 // 파이프라인에 쌓인 레코드 수
+
+	stats SinkStats
+}
+
+// NewRedisSink Redis 싱크 생성
+func NewRedisSink(cfg config.OutputConfig) (*RedisSink, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("redis sink url is required")
+	}
+	if cfg.Key == "" {
+		return nil, fmt.Errorf("redis sink key is required")
+	}
+
+	options, err := redis.ParseURL(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+	if cfg.Password != "" {
+		options.Password = cfg.Password
+	}
+
+	command := cfg.Command
+	if command == "" {
+		command = "xadd"
+	}
+	switch command {
+	case "xadd", "hset", "lpush", "rpush":
+	default:
+		return nil, fmt.Errorf("unsupported redis command: %s", command)
+	}
+
+	var keyTmpl *template.Template
+	if strings.Contains(cfg.Key, "{{") {
+		keyTmpl, err = template.New("key").Option("missingkey=error").Parse(cfg.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redis key template: %w", err)
+		}
+	}
+
+	var ttl time.Duration
+	if cfg.TTL != "" {
+		if ttl, err = time.ParseDuration(cfg.TTL); err != nil {
+			return nil, fmt.Errorf("invalid redis ttl: %w", err)
+		}
+	}
+
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	return &RedisSink{
+		options:   options,
+		command:   command,
+		key:       cfg.Key,
+		keyTmpl:   keyTmpl,
+		maxLen:    cfg.MaxLen,
+		ttl:       ttl,
+		batchSize: batchSize,
+	}, nil
+}
+
+func (s *RedisSink) Name() string {
+	return "redis"
+}
+
+func (s *RedisSink) Open(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	client := redis.NewClient(s.options)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	s.client = client
+	s.pipe = client.Pipeline()
+	return nil
+}
+
+func (s *RedisSink) Write(ctx context.Context, record source.Record) error {
+	atomic.AddInt64(&s.stats.TotalRecords, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pipe == nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return fmt.Errorf("redis sink is not open")
+	}
+
+	key, err := s.recordKey(record.Data)
+	if err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return err
+	}
+
+	switch s.command {
+	case "xadd":
+		s.pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: key,
+			MaxLen: s.maxLen,
+			Approx: s.maxLen > 0,
+			Values: flattenValues(record.Data),
+		})
+	case "hset":
+		s.pipe.HSet(ctx, key, flattenValues(record.Data))
+	case "lpush", "rpush":
+		payload, err := json.Marshal(record.Data)
+		if err != nil {
+			atomic.AddInt64(&s.stats.ErrorRecords, 1)
+			return fmt.Errorf("failed to encode record: %w", err)
+		}
+		if s.command == "lpush" {
+			s.pipe.LPush(ctx, key, payload)
+		} else {
+			s.pipe.RPush(ctx, key, payload)
+		}
+	}
+	if s.ttl > 0 && s.command != "xadd" {
+		s.pipe.Expire(ctx, key, s.ttl)
+	}
+
+	s.pending++
+	if s.pending >= int64(s.batchSize) {
+		return s.exec(ctx)
+	}
+	return nil
+}
+
+// recordKey 레코드 필드로 키 템플릿을 채움
+func (s *RedisSink) recordKey(data map[string]any) (string, error) {
+	if s.keyTmpl == nil {
+		return s.key, nil
+	}
+	var b strings.Builder
+	if err := s.keyTmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render redis key: %w", err)
+	}
+	return b.String(), nil
+}
+
+// exec 쌓인 명령을 한 번에 전송 (mu를 잡은 상태에서 호출)
+// 파이프라인 중 하나라도 실패하면 그 배치의 레코드 전체를 실패로 센다
+func (s *RedisSink) exec(ctx context.Context) error {
+	if s.pending == 0 {
+		return nil
+	}
+	count := s.pending
+	s.pending = 0
+
+	if _, err := s.pipe.Exec(ctx); err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, count)
+		return fmt.Errorf("redis pipeline failed: %w", err)
+	}
+	atomic.AddInt64(&s.stats.SuccessRecords, count)
+	s.stats.LastWriteTime = time.Now()
+	return nil
+}
+
+func (s *RedisSink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pipe == nil {
+		return nil
+	}
+	return s.exec(ctx)
+}
+
+// Close 남은 명령을 보내고 연결을 닫음
+func (s *RedisSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client == nil {
+		return nil
+	}
+	err := s.exec(context.Background())
+	if cerr := s.client.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	s.client, s.pipe = nil, nil
+	return err
+}
+
+func (s *RedisSink) Stats() SinkStats {
+	return s.stats
+}
+
+// flattenValues 최상위 필드를 Redis 필드 값으로 변환 (중첩 값은 JSON 문자열)
+func flattenValues(data map[string]any) map[string]any {
+	values := make(map[string]any, len(data))
+	for k, v := range data {
+		switch val := v.(type) {
+		case nil:
+			values[k] = ""
+		case string:
+			values[k] = val
+		case []byte:
+			values[k] = val
+		case bool:
+			values[k] = strconv.FormatBool(val)
+		case float64:
+			values[k] = strconv.FormatFloat(val, 'f', -1, 64)
+		case float32:
+			values[k] = strconv.FormatFloat(float64(val), 'f', -1, 32)
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+			values[k] = fmt.Sprint(val)
+		case time.Time:
+			values[k] = val.Format(time.RFC3339Nano)
+		default:
+			b, err := json.Marshal(val)
+			if err != nil {
+				values[k] = fmt.Sprint(val)
+				continue
+			}
+			values[k] = string(b)
+		}
+	}
+	return values
+}
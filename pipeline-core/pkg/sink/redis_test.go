@@ -0,0 +1,144 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+func writeRedisRecords(t *testing.T, cfg config.OutputConfig, records ...map[string]any) Sink {
+	t.Helper()
+
+	cfg.Type = "redis"
+	sink, err := NewSink(cfg)
+	if err != nil {
+		t.Fatalf("NewSink failed: %v", err)
+	}
+	ctx := context.Background()
+	if err := sink.Open(ctx); err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	for _, data := range records {
+		if err := sink.Write(ctx, source.Record{Data: data}); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+	return sink
+}
+
+func TestRedisSinkXAdd(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	sink := writeRedisRecords(t, config.OutputConfig{URL: "redis://" + mr.Addr(), Key: "events", BatchSize: 2},
+		map[string]any{"id": 1, "amount": 12.5, "tags": []any{"a"}},
+		map[string]any{"id": 2, "ok": true},
+		map[string]any{"id": 3},
+	)
+
+	// batch_size 2 → 두 건은 전송되고 세 번째는 Flush까지 대기
+	entries, _ := mr.Stream("events")
+	if len(entries) != 2 {
+		t.Fatalf("entries before flush = %d", len(entries))
+	}
+	if err := sink.Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	entries, _ = mr.Stream("events")
+	if len(entries) != 3 {
+		t.Fatalf("entries after flush = %d", len(entries))
+	}
+
+	got := make(map[string]string)
+	for i := 0; i+1 < len(entries[0].Values); i += 2 {
+		got[entries[0].Values[i]] = entries[0].Values[i+1]
+	}
+	want := map[string]string{"id": "1", "amount": "12.5", "tags": `["a"]`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fields = %v", got)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if stats := sink.Stats(); stats.TotalRecords != 3 || stats.SuccessRecords != 3 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestRedisSinkHSet(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	sink := writeRedisRecords(t, config.OutputConfig{URL: "redis://" + mr.Addr(), Command: "hset", Key: "user:{{.id}}", TTL: "1h"},
+		map[string]any{"id": "u1", "name": "kim"},
+		map[string]any{"id": "u2", "name": "lee"},
+	)
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := mr.HGet("user:u2", "name"); got != "lee" {
+		t.Errorf("user:u2 name = %q", got)
+	}
+	if ttl := mr.TTL("user:u1"); ttl <= 0 {
+		t.Errorf("ttl = %v", ttl)
+	}
+
+	// 템플릿 필드가 없는 레코드는 쓰기 오류
+	if err := sink.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+	if err := sink.Write(context.Background(), source.Record{Data: map[string]any{"name": "x"}}); err == nil {
+		t.Error("expected error for missing key field")
+	}
+}
+
+func TestRedisSinkListPush(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	sink := writeRedisRecords(t, config.OutputConfig{URL: "redis://" + mr.Addr(), Command: "rpush", Key: "queue"},
+		map[string]any{"id": 1, "nested": map[string]any{"a": 1}},
+		map[string]any{"id": 2},
+	)
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	items, err := mr.List("queue")
+	if err != nil || len(items) != 2 {
+		t.Fatalf("list = %v, %v", items, err)
+	}
+	var first map[string]any
+	if err := json.Unmarshal([]byte(items[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if first["id"] != float64(1) || first["nested"].(map[string]any)["a"] != float64(1) {
+		t.Errorf("first = %v", first)
+	}
+}
+
+func TestNewRedisSinkInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.OutputConfig
+	}{
+		{"no url", config.OutputConfig{Key: "k"}},
+		{"no key", config.OutputConfig{URL: "redis://localhost:6379"}},
+		{"bad command", config.OutputConfig{URL: "redis://localhost:6379", Key: "k", Command: "set"}},
+		{"bad template", config.OutputConfig{URL: "redis://localhost:6379", Key: "k:{{.id"}},
+		{"bad ttl", config.OutputConfig{URL: "redis://localhost:6379", Key: "k", TTL: "soon"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRedisSink(tt.cfg); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -150,6 +150,16 @@ func NewSink(cfg config.OutputConfig) (Sink, error) {
 	switch cfg.Type {
 	case "stub", "":
 		return NewStubSink(cfg)
+	case "file":
+		return NewFileSink(cfg)
+	case "redis":
+		return NewRedisSink(cfg)
+	case "sql":
+		return NewSQLSink(cfg)
+	case "elasticsearch":
+		return NewElasticsearchSink(cfg)
+	case "mongodb":
+		return NewMongoDBSink(cfg)
 	default:
 		return nil, fmt.Errorf("unsupported sink type: %s", cfg.Type)
 	}
@@ -0,0 +1,308 @@
+package sink
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+)
+
+// SQLSink 테이블 출력 (mysql, postgres, sqlite)
+// id_fields가 있으면 키 기준 upsert로 쓰고, delete_strategy에 따라 삭제 이벤트를 DELETE 또는 플래그 UPDATE로 바꾼다.
+// 작업은 batch_size개씩 모아 하나의 트랜잭션으로 도착 순서대로 실행한다
+type SQLSink struct {
+	driver    string
+	dsn       string
+	table     string
+	idFields  []string
+	deletes   *DeleteHandler
+	batchSize int
+
+	mu      sync.Mutex
+	db      *sql.DB
+	pending []Operation
+
+	stats SinkStats
+}
+
+// NewSQLSink SQL 싱크 생성
+func NewSQLSink(cfg config.OutputConfig) (*SQLSink, error) {
+	if cfg.Driver == "" || cfg.DSN == "" || cfg.Table == "" {
+		return nil, fmt.Errorf("sql sink driver, dsn and table are required")
+	}
+	deletes, err := NewDeleteHandler(cfg.DeleteStrategy, cfg.IDFields)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+
+	return &SQLSink{
+		driver:    cfg.Driver,
+		dsn:       cfg.DSN,
+		table:     cfg.Table,
+		idFields:  cfg.IDFields,
+		deletes:   deletes,
+		batchSize: batchSize,
+	}, nil
+}
+
+func (s *SQLSink) Name() string {
+	return "sql"
+}
+
+func (s *SQLSink) Open(ctx context.Context) error {
+	db, err := sql.Open(s.driver, s.dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	s.mu.Lock()
+	s.db = db
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *SQLSink) Write(ctx context.Context, record source.Record) error {
+	atomic.AddInt64(&s.stats.TotalRecords, 1)
+
+	op, err := s.deletes.Operation(record)
+	if err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return err
+	}
+	if op.Kind == OpSkip {
+		atomic.AddInt64(&s.stats.SuccessRecords, 1)
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.db == nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, 1)
+		return fmt.Errorf("sql sink is not open")
+	}
+
+	s.pending = append(s.pending, op)
+	if len(s.pending) >= s.batchSize {
+		return s.exec(ctx)
+	}
+	return nil
+}
+
+// exec 쌓인 작업을 하나의 트랜잭션으로 실행 (mu를 잡은 상태에서 호출)
+// 하나라도 실패하면 롤백하고 배치의 레코드 전체를 실패로 센다
+func (s *SQLSink) exec(ctx context.Context) error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	ops := s.pending
+	s.pending = nil
+
+	err := s.execTx(ctx, ops)
+	if err != nil {
+		atomic.AddInt64(&s.stats.ErrorRecords, int64(len(ops)))
+		return err
+	}
+	atomic.AddInt64(&s.stats.SuccessRecords, int64(len(ops)))
+	s.stats.LastWriteTime = time.Now()
+	return nil
+}
+
+func (s *SQLSink) execTx(ctx context.Context, ops []Operation) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	for _, op := range ops {
+		query, args := s.statement(op)
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("sql %s failed: %w", op.Kind, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit: %w", err)
+	}
+	return nil
+}
+
+// statement 작업에 해당하는 SQL과 바인드 값
+func (s *SQLSink) statement(op Operation) (string, []any) {
+	switch op.Kind {
+	case OpDelete:
+		where, args := s.whereKey(op.Key, 1)
+		return fmt.Sprintf("DELETE FROM %s WHERE %s", s.quote(s.table), where), args
+
+	case OpSoftDelete:
+		columns := sortedKeys(op.Fields)
+		sets := make([]string, len(columns))
+		args := make([]any, 0, len(columns)+len(op.Key))
+		for i, col := range columns {
+			sets[i] = fmt.Sprintf("%s = %s", s.quote(col), sqlPlaceholder(s.driver, i+1))
+			args = append(args, sqlValue(op.Fields[col]))
+		}
+		where, keyArgs := s.whereKey(op.Key, len(columns)+1)
+		return fmt.Sprintf("UPDATE %s SET %s WHERE %s", s.quote(s.table), strings.Join(sets, ", "), where), append(args, keyArgs...)
+
+	default:
+		columns := sortedKeys(op.Fields)
+		quoted := make([]string, len(columns))
+		holders := make([]string, len(columns))
+		args := make([]any, len(columns))
+		for i, col := range columns {
+			quoted[i] = s.quote(col)
+			holders[i] = sqlPlaceholder(s.driver, i+1)
+			args[i] = sqlValue(op.Fields[col])
+		}
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", s.quote(s.table), strings.Join(quoted, ", "), strings.Join(holders, ", "))
+		if op.Key != nil {
+			query += s.upsertClause(columns)
+		}
+		return query, args
+	}
+}
+
+// upsertClause 키 충돌 시 나머지 컬럼을 갱신하는 구문 (드라이버별)
+func (s *SQLSink) upsertClause(columns []string) string {
+	isID := make(map[string]bool, len(s.idFields))
+	for _, f := range s.idFields {
+		isID[f] = true
+	}
+
+	var sets []string
+	for _, col := range columns {
+		if isID[col] {
+			continue
+		}
+		if s.driver == "mysql" {
+			sets = append(sets, fmt.Sprintf("%s = VALUES(%s)", s.quote(col), s.quote(col)))
+		} else {
+			sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", s.quote(col), s.quote(col)))
+		}
+	}
+
+	if s.driver == "mysql" {
+		if len(sets) == 0 {
+			id := s.quote(s.idFields[0])
+			return fmt.Sprintf(" ON DUPLICATE KEY UPDATE %s = %s", id, id)
+		}
+		return " ON DUPLICATE KEY UPDATE " + strings.Join(sets, ", ")
+	}
+
+	keys := make([]string, len(s.idFields))
+	for i, f := range s.idFields {
+		keys[i] = s.quote(f)
+	}
+	if len(sets) == 0 {
+		return fmt.Sprintf(" ON CONFLICT (%s) DO NOTHING", strings.Join(keys, ", "))
+	}
+	return fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", strings.Join(keys, ", "), strings.Join(sets, ", "))
+}
+
+// whereKey ID 필드 조건 (start: 첫 바인드 파라미터 번호)
+func (s *SQLSink) whereKey(key map[string]any, start int) (string, []any) {
+	conds := make([]string, len(s.idFields))
+	args := make([]any, len(s.idFields))
+	for i, f := range s.idFields {
+		conds[i] = fmt.Sprintf("%s = %s", s.quote(f), sqlPlaceholder(s.driver, start+i))
+		args[i] = sqlValue(key[f])
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// quote 식별자 인용 (점으로 구분된 스키마.테이블 지원)
+func (s *SQLSink) quote(ident string) string {
+	q := `"`
+	if s.driver == "mysql" {
+		q = "`"
+	}
+	parts := strings.Split(ident, ".")
+	for i, p := range parts {
+		parts[i] = q + strings.ReplaceAll(p, q, q+q) + q
+	}
+	return strings.Join(parts, ".")
+}
+
+func (s *SQLSink) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.db == nil {
+		return nil
+	}
+	return s.exec(ctx)
+}
+
+// Close 남은 작업을 실행하고 연결을 닫음
+func (s *SQLSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.db == nil {
+		return nil
+	}
+	err := s.exec(context.Background())
+	if cerr := s.db.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	s.db = nil
+	return err
+}
+
+func (s *SQLSink) Stats() SinkStats {
+	return s.stats
+}
+
+// sqlPlaceholder n번째 바인드 파라미터 표기 (드라이버별)
+func sqlPlaceholder(driver string, n int) string {
+	switch driver {
+	case "postgres", "pgx":
+		return fmt.Sprintf("$%d", n)
+	default:
+		return "?"
+	}
+}
+
+// sqlValue 중첩 값(map, slice)은 JSON 문자열로 변환
+func sqlValue(v any) any {
+	switch v.(type) {
+	case map[string]any, []any:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	default:
+		return v
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,127 @@
+package sink
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
+	"github.com/conduix/conduix/shared/types"
+)
+
+func openSQLSinkTest(t *testing.T, strategy *types.DeleteStrategy) (Sink, *sql.DB) {
+	t.Helper()
+
+	dsn := filepath.Join(t.TempDir(), "sink.db")
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT, tags TEXT, deleted_at TIMESTAMP, status TEXT)`); err != nil {
+		t.Fatal(err)
+	}
+
+	sink, err := NewSink(config.OutputConfig{
+		Type:           "sql",
+		Driver:         "sqlite",
+		DSN:            dsn,
+		Table:          "users",
+		IDFields:       []string{"id"},
+		DeleteStrategy: strategy,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sink.Close() })
+	return sink, db
+}
+
+func writeSQLRecords(t *testing.T, sink Sink, records ...map[string]any) {
+	t.Helper()
+	ctx := context.Background()
+	for _, data := range records {
+		if err := sink.Write(ctx, source.Record{Data: data}); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+	if err := sink.Flush(ctx); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+}
+
+func TestSQLSinkUpsertAndPhysicalDelete(t *testing.T) {
+	sink, db := openSQLSinkTest(t, &types.DeleteStrategy{Mode: types.DeleteModePhysical})
+
+	writeSQLRecords(t, sink,
+		map[string]any{"id": 1, "name": "alice", "tags": []any{"a"}},
+		map[string]any{"id": 2, "name": "bob"},
+		map[string]any{"id": 1, "name": "alice2"}, // upsert
+		map[string]any{"id": 2},                   // null body → delete
+	)
+
+	var count int
+	_ = db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&count)
+	var name, tags string
+	_ = db.QueryRow(`SELECT name, tags FROM users WHERE id = 1`).Scan(&name, &tags)
+	if count != 1 || name != "alice2" || tags != `["a"]` {
+		t.Errorf("count = %d, name = %s, tags = %s", count, name, tags)
+	}
+	if stats := sink.Stats(); stats.SuccessRecords != 4 || stats.ErrorRecords != 0 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestSQLSinkSoftDelete(t *testing.T) {
+	sink, db := openSQLSinkTest(t, &types.DeleteStrategy{
+		Mode:       types.DeleteModeSoft,
+		SoftDelete: &types.SoftDeleteConfig{FieldType: types.SoftDeleteFieldStatus, FieldName: "status"},
+		Detection:  &types.DeleteDetectionConfig{Method: DetectEventType, EventTypeField: "op", DeleteEventType: "delete"},
+	})
+
+	writeSQLRecords(t, sink, map[string]any{"id": 1, "name": "alice"})
+	var status string
+	_ = db.QueryRow(`SELECT status FROM users WHERE id = 1`).Scan(&status)
+	if status != "A" {
+		t.Errorf("status after write = %q", status)
+	}
+
+	// 삭제 이벤트는 플래그만 갱신하고 다른 컬럼은 유지
+	writeSQLRecords(t, sink, map[string]any{"id": 1, "op": "DELETE"})
+	var name string
+	_ = db.QueryRow(`SELECT name, status FROM users WHERE id = 1`).Scan(&name, &status)
+	if name != "alice" || status != "D" {
+		t.Errorf("after delete: name = %q, status = %q", name, status)
+	}
+}
+
+func TestSQLSinkStatements(t *testing.T) {
+	tests := []struct {
+		driver string
+		op     Operation
+		want   string
+	}{
+		{"postgres", Operation{Kind: OpWrite, Key: map[string]any{"id": 1}, Fields: map[string]any{"id": 1, "name": "a"}},
+			`INSERT INTO "app"."users" ("id", "name") VALUES ($1, $2) ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name"`},
+		{"mysql", Operation{Kind: OpWrite, Key: map[string]any{"id": 1}, Fields: map[string]any{"id": 1, "name": "a"}},
+			"INSERT INTO `app`.`users` (`id`, `name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)"},
+		{"mysql", Operation{Kind: OpWrite, Key: map[string]any{"id": 1}, Fields: map[string]any{"id": 1}},
+			"INSERT INTO `app`.`users` (`id`) VALUES (?) ON DUPLICATE KEY UPDATE `id` = `id`"},
+		{"postgres", Operation{Kind: OpDelete, Key: map[string]any{"id": 1}},
+			`DELETE FROM "app"."users" WHERE "id" = $1`},
+		{"postgres", Operation{Kind: OpSoftDelete, Key: map[string]any{"id": 1}, Fields: map[string]any{"is_deleted": true}},
+			`UPDATE "app"."users" SET "is_deleted" = $1 WHERE "id" = $2`},
+	}
+
+	for _, tt := range tests {
+		s := &SQLSink{driver: tt.driver, table: "app.users", idFields: []string{"id"}}
+		if got, _ := s.statement(tt.op); got != tt.want {
+			t.Errorf("%s %s:\n got  %s\n want %s", tt.driver, tt.op.Kind, got, tt.want)
+		}
+	}
+}
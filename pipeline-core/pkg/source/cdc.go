@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
 	"github.com/go-mysql-org/go-mysql/schema"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgconn"
 
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
 )
@@ -22,25 +26,67 @@ const (
 	CDCEventInsert CDCEventType = "insert"
 	CDCEventUpdate CDCEventType = "update"
 	CDCEventDelete CDCEventType = "delete"
+
+	// CDCEventSnapshot 초기 스냅샷으로 읽은 기존 행
+	CDCEventSnapshot CDCEventType = "snapshot"
+	// CDCEventSchemaChange DDL로 인한 테이블 스키마 변경
+	CDCEventSchemaChange CDCEventType = "schema_change"
 )
 
+// Op 이벤트 연산 코드 (c: insert, u: update, d: delete, r: snapshot read)
+// 스키마 변경은 행 연산이 아니므로 빈 문자열
+func (t CDCEventType) Op() string {
+	switch t {
+	case CDCEventInsert:
+		return "c"
+	case CDCEventUpdate:
+		return "u"
+	case CDCEventDelete:
+		return "d"
+	case CDCEventSnapshot:
+		return "r"
+	}
+	return ""
+}
+
 // CDCSource CDC(Change Data Capture) 소스
 // MySQL binlog 또는 PostgreSQL WAL을 통해 변경 사항 캡처
 type CDCSource struct {
-	driver   string // mysql, postgres
-	host     string
-	port     uint16
-	username string
-	password string
-	database string
-	tables   []string // 감시할 테이블 목록 (빈 경우 전체)
-	serverID uint32   // MySQL server ID (binlog용)
-	slotName string   // PostgreSQL replication slot name
+	driver      string // mysql, postgres
+	host        string
+	port        uint16
+	username    string
+	password    string
+	database    string
+	tables      []string // 감시할 테이블 목록 (빈 경우 전체)
+	serverID    uint32   // MySQL server ID (binlog용)
+	slotName    string   // PostgreSQL replication slot name
+	publication string   // PostgreSQL publication name
+
+	snapshotMode      string // never, initial
+	snapshotChunkSize int
+	snapshot          snapshotState
+	filter            *cdcFilter
 
 	canal    *canal.Canal
 	mu       sync.RWMutex
 	running  bool
 	position mysql.Position // MySQL binlog position
+	gtidSet  string         // MySQL 실행된 GTID set (GTID 모드에서 failover 후 재개용)
+
+	// PostgreSQL 논리 복제
+	pgConn *pgconn.PgConn
+	lsn    pglogrepl.LSN // 이벤트 전달을 마친 마지막 커밋 트랜잭션의 종료 LSN
+	ackLSN pglogrepl.LSN // 체크포인트 저장이 확정되어 서버에 보고할 LSN
+	ackCh  chan struct{}
+
+	// 체크포인트 확정: 전달한 이벤트에 순번을 매기고, 위치가 바뀐 시점까지 전달된 이벤트가
+	// 모두 Ack되어야 그 위치를 GetCheckpoint로 내보낸다
+	sent      uint64              // eventCh로 전달한 이벤트 수
+	acked     uint64              // 빠짐없이 Ack된 마지막 순번
+	ackedSeqs map[uint64]struct{} // acked 이후에 먼저 Ack된 순번
+	pending   []cdcCheckpoint     // Ack를 기다리는 위치 (순번 오름차순)
+	confirmed map[string]any      // Ack가 끝난 마지막 위치
 
 	// 이벤트 핸들러
 	eventCh chan *CDCEvent
@@ -56,6 +102,14 @@ type CDCEvent struct {
 	Data       map[string]any `json:"data"`        // 현재 데이터 (INSERT, UPDATE)
 	OldData    map[string]any `json:"old_data"`    // 이전 데이터 (UPDATE, DELETE)
 	PrimaryKey []any          `json:"primary_key"` // PK 값들
+
+	seq uint64 // 전달 순번 (레코드 Offset으로 전달되어 Ack 때 돌아옴)
+}
+
+// cdcCheckpoint seq번째 이벤트까지 Ack되면 확정되는 체크포인트
+type cdcCheckpoint struct {
+	seq        uint64
+	checkpoint map[string]any
 }
 
 // NewCDCSource CDC 소스 생성
@@ -70,19 +124,52 @@ func NewCDCSource(cfg config.SourceV2) (*CDCSource, error) {
 		serverID = cfg.ServerID
 	}
 
-	return &CDCSource{
-		driver:   cfg.Driver,
-		host:     cfg.Host,
-		port:     port,
-		username: cfg.Username,
-		password: cfg.Password,
-		database: cfg.Database,
-		tables:   cfg.Tables,
-		serverID: serverID,
-		slotName: cfg.SlotName,
-		eventCh:  make(chan *CDCEvent, 1000),
-		errorCh:  make(chan error, 10),
-	}, nil
+	slotName := cfg.SlotName
+	if slotName == "" {
+		slotName = "conduix_cdc"
+	}
+
+	publication := cfg.Publication
+	if publication == "" {
+		publication = slotName
+	}
+
+	snapshotMode := cfg.SnapshotMode
+	if snapshotMode == "" {
+		snapshotMode = "never"
+	}
+
+	chunkSize := cfg.SnapshotChunkSize
+	if chunkSize <= 0 {
+		chunkSize = 1000
+	}
+
+	filter, err := newCDCFilter(cfg.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cdc filter: %w", err)
+	}
+
+	s := &CDCSource{
+		driver:            cfg.Driver,
+		host:              cfg.Host,
+		port:              port,
+		username:          cfg.Username,
+		password:          cfg.Password,
+		database:          cfg.Database,
+		tables:            cfg.Tables,
+		serverID:          serverID,
+		slotName:          slotName,
+		publication:       publication,
+		snapshotMode:      snapshotMode,
+		snapshotChunkSize: chunkSize,
+		filter:            filter,
+		ackCh:             make(chan struct{}, 1),
+		ackedSeqs:         make(map[uint64]struct{}),
+		eventCh:           make(chan *CDCEvent, 1000),
+		errorCh:           make(chan error, 10),
+	}
+	s.confirmed = s.checkpoint()
+	return s, nil
 }
 
 func (s *CDCSource) Name() string {
@@ -94,7 +181,7 @@ func (s *CDCSource) Open(ctx context.Context) error {
 	case "mysql":
 		return s.openMySQL()
 	case "postgres":
-		return s.openPostgreSQL()
+		return s.openPostgreSQL(ctx)
 	default:
 		return fmt.Errorf("unsupported CDC driver: %s", s.driver)
 	}
@@ -112,6 +199,8 @@ func (s *CDCSource) openMySQL() error {
 	if len(s.tables) > 0 {
 		cfg.IncludeTableRegex = s.tables
 	}
+	// 제외 테이블은 canal 단계에서 걸러 메타데이터 조회를 생략
+	cfg.ExcludeTableRegex = s.filter.excludedTablePatterns()
 
 	c, err := canal.NewCanal(cfg)
 	if err != nil {
@@ -119,7 +208,7 @@ func (s *CDCSource) openMySQL() error {
 	}
 
 	// 이벤트 핸들러 등록
-	c.SetEventHandler(&mysqlEventHandler{source: s})
+	c.SetEventHandler(&mysqlEventHandler{source: s, tables: c, done: c.Ctx().Done()})
 
 	s.mu.Lock()
 	s.canal = c
@@ -128,12 +217,6 @@ func (s *CDCSource) openMySQL() error {
 	return nil
 }
 
-func (s *CDCSource) openPostgreSQL() error {
-	// PostgreSQL CDC는 pglogrepl 라이브러리 사용
-	// 여기서는 인터페이스만 정의하고 실제 구현은 PostgreSQL specific
-	return fmt.Errorf("PostgreSQL CDC not implemented yet - use pglogrepl library")
-}
-
 func (s *CDCSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
 	records := make(chan Record, 100)
 	errs := make(chan error, 1)
@@ -142,42 +225,16 @@ func (s *CDCSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
 	s.running = true
 	s.mu.Unlock()
 
-	// MySQL binlog 시작
-	go func() {
-		s.mu.RLock()
-		c := s.canal
-		pos := s.position
-		s.mu.RUnlock()
-
-		if c == nil {
-			errs <- fmt.Errorf("canal not initialized")
-			close(records)
-			close(errs)
-			return
-		}
-
-		// Position이 설정되어 있으면 해당 위치부터, 아니면 현재 위치부터
-		if pos.Name == "" {
-			// 현재 binlog position 가져오기
-			currentPos, err := c.GetMasterPos()
-			if err != nil {
-				errs <- fmt.Errorf("failed to get master position: %w", err)
-				close(records)
-				close(errs)
-				return
-			}
-			pos = currentPos
-		}
-
+	if s.driver == "postgres" {
+		// PostgreSQL 논리 복제 시작
 		go func() {
-			if err := c.RunFrom(pos); err != nil {
-				select {
-				case errs <- fmt.Errorf("canal run error: %w", err):
-				default:
-				}
+			if err := s.runPostgreSQL(ctx); err != nil {
+				s.reportError(err)
 			}
 		}()
-	}()
+	} else {
+		go s.runMySQL(ctx)
+	}
 
 	// 이벤트 변환 및 전달
 	go func() {
@@ -213,6 +270,122 @@ func (s *CDCSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
 	return records, errs
 }
 
+// runMySQL binlog 읽기 시작 (snapshot_mode=initial이면 스냅샷 후 이어서 읽음)
+// 오류는 errorCh를 통해 Read의 에러 채널로 전달된다
+func (s *CDCSource) runMySQL(ctx context.Context) {
+	s.mu.RLock()
+	c := s.canal
+	pos := s.position
+	gtid := s.gtidSet
+	snapshotPending := s.snapshotMode == "initial" && !s.snapshot.Completed
+	s.mu.RUnlock()
+
+	if c == nil {
+		s.reportError(fmt.Errorf("canal not initialized"))
+		return
+	}
+
+	// Position이 설정되어 있으면 해당 위치부터, 아니면 현재 위치부터
+	if pos.Name == "" && gtid == "" {
+		// 현재 binlog position 가져오기
+		currentPos, err := c.GetMasterPos()
+		if err != nil {
+			s.reportError(fmt.Errorf("failed to get master position: %w", err))
+			return
+		}
+		pos = currentPos
+
+		gtid, err = currentGTIDSet(c)
+		if err != nil {
+			s.reportError(err)
+			return
+		}
+
+		// 스냅샷 이후 이 위치부터 변경을 읽도록 체크포인트에 기록
+		s.mu.Lock()
+		s.position = pos
+		s.gtidSet = gtid
+		s.trackCheckpoint()
+		s.mu.Unlock()
+	}
+
+	if snapshotPending {
+		if err := s.runSnapshot(ctx, &canalSnapshotReader{canal: c}); err != nil {
+			s.reportError(err)
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+
+	// GTID set이 있으면 primary failover 후에도 같은 트랜잭션부터 이어 읽을 수 있음
+	var err error
+	if gtid != "" {
+		set, perr := mysql.ParseGTIDSet(mysql.MySQLFlavor, gtid)
+		if perr != nil {
+			s.reportError(fmt.Errorf("invalid gtid set %q: %w", gtid, perr))
+			return
+		}
+		err = c.StartFromGTID(set)
+	} else {
+		err = c.RunFrom(pos)
+	}
+	if err != nil {
+		s.reportError(fmt.Errorf("canal run error: %w", err))
+	}
+}
+
+// currentGTIDSet GTID 모드가 켜져 있으면 실행된 GTID set 반환
+// gtid_mode 변수가 없는 서버(구버전 등)는 binlog 위치만 사용한다
+func currentGTIDSet(c *canal.Canal) (string, error) {
+	res, err := c.Execute("SELECT @@GLOBAL.gtid_mode, @@GLOBAL.gtid_executed")
+	if err != nil {
+		return "", nil
+	}
+	defer res.Close()
+
+	mode, _ := res.GetString(0, 0)
+	if !strings.EqualFold(mode, "ON") {
+		return "", nil
+	}
+	executed, err := res.GetString(0, 1)
+	if err != nil {
+		return "", fmt.Errorf("failed to read gtid_executed: %w", err)
+	}
+	return strings.ReplaceAll(executed, "\n", ""), nil
+}
+
+// sendEvent 필터/마스킹 적용 후 이벤트 전달 (채널이 가득 차면 대기)
+// 필터로 제외되었거나 done이 닫히면 false 반환
+func (s *CDCSource) sendEvent(event *CDCEvent, pkColumns []string, done <-chan struct{}) bool {
+	if !s.filter.matchTable(event.Database, event.Table) {
+		return false
+	}
+	s.filter.apply(event, pkColumns)
+
+	s.mu.RLock()
+	event.seq = s.sent + 1
+	s.mu.RUnlock()
+
+	select {
+	case s.eventCh <- event:
+		s.mu.Lock()
+		s.sent = event.seq
+		s.mu.Unlock()
+		return true
+	case <-done:
+		return false
+	}
+}
+
+func (s *CDCSource) reportError(err error) {
+	select {
+	case s.errorCh <- err:
+	default:
+	}
+}
+
 func (s *CDCSource) convertEventToRecord(event *CDCEvent) Record {
 	data := map[string]any{
 		"_cdc_type":  string(event.Type),
@@ -220,6 +393,9 @@ func (s *CDCSource) convertEventToRecord(event *CDCEvent) Record {
 		"_table":     event.Table,
 		"_timestamp": event.Timestamp,
 	}
+	if op := event.Type.Op(); op != "" {
+		data["_op"] = op
+	}
 
 	// 현재 데이터 병합
 	for k, v := range event.Data {
@@ -240,7 +416,7 @@ func (s *CDCSource) convertEventToRecord(event *CDCEvent) Record {
 		data["_primary_key"] = event.PrimaryKey
 	}
 
-	return Record{
+	record := Record{
 		Data: data,
 		Metadata: Metadata{
 			Source:    "cdc",
@@ -248,17 +424,37 @@ func (s *CDCSource) convertEventToRecord(event *CDCEvent) Record {
 			Timestamp: event.Timestamp.UnixMilli(),
 		},
 	}
+	if event.seq > 0 {
+		record.Metadata.Offset = strconv.FormatUint(event.seq, 10)
+	}
+	return record
 }
 
-// GetCheckpoint 현재 체크포인트(binlog position) 반환
+// GetCheckpoint 싱크 기록이 확정된(Ack된) 이벤트까지의 체크포인트(binlog position 또는 LSN) 반환
+// 전달만 되고 아직 Ack되지 않은 이벤트가 있는 위치는 내보내지 않으므로 실행 중에 저장해도 안전하다
 func (s *CDCSource) GetCheckpoint() map[string]any {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
+	return s.confirmed
+}
+
+// checkpoint 현재 읽은 위치를 체크포인트 값으로 변환 (s.mu 보유 상태에서 호출)
+func (s *CDCSource) checkpoint() map[string]any {
+	if s.driver == "postgres" {
+		return map[string]any{
+			"lsn": s.lsn.String(),
+		}
+	}
 
-	return map[string]any{
+	checkpoint := map[string]any{
 		"binlog_file": s.position.Name,
 		"binlog_pos":  s.position.Pos,
+		"gtid_set":    s.gtidSet,
+	}
+	if s.snapshotMode == "initial" {
+		checkpoint["snapshot"] = s.snapshot.checkpoint()
 	}
+	return checkpoint
 }
 
 // SetCheckpoint 체크포인트 설정 (복구용)
@@ -266,6 +462,19 @@ func (s *CDCSource) SetCheckpoint(checkpoint map[string]any) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	// 저장된 체크포인트는 확정된 위치이므로 서버에도 그대로 보고
+	if v, ok := checkpoint["lsn"]; ok {
+		lsn, err := parseLSN(v)
+		if err != nil {
+			return err
+		}
+		s.lsn = lsn
+		s.ackLSN = lsn
+	}
+
+	if gtid, ok := checkpoint["gtid_set"].(string); ok {
+		s.gtidSet = gtid
+	}
 	if name, ok := checkpoint["binlog_file"].(string); ok {
 		s.position.Name = name
 	}
@@ -274,7 +483,86 @@ func (s *CDCSource) SetCheckpoint(checkpoint map[string]any) error {
 	} else if pos, ok := checkpoint["binlog_pos"].(float64); ok {
 		s.position.Pos = uint32(pos)
 	}
+	if snapshot, ok := checkpoint["snapshot"].(map[string]any); ok {
+		s.snapshot.restore(snapshot)
+	}
+	s.confirmed = s.checkpoint()
+
+	return nil
+}
+
+// trackCheckpoint 읽은 위치가 바뀌었음을 기록 (s.mu 보유 상태에서 호출)
+// 지금까지 전달한 이벤트가 모두 Ack되었으면 바로 확정하고, 아니면 마지막 이벤트의 Ack를 기다린다
+func (s *CDCSource) trackCheckpoint() {
+	checkpoint := s.checkpoint()
+	if s.sent <= s.acked {
+		s.confirmed = checkpoint
+		return
+	}
+	// 그 사이 전달된 이벤트가 없으면 같은 순번의 대기 위치를 갱신
+	if n := len(s.pending); n > 0 && s.pending[n-1].seq == s.sent {
+		s.pending[n-1].checkpoint = checkpoint
+		return
+	}
+	s.pending = append(s.pending, cdcCheckpoint{seq: s.sent, checkpoint: checkpoint})
+}
+
+// Ack 싱크 기록이 확정된 레코드 통지
+// 빠짐없이 Ack된 순번까지 전달을 마친 위치를 체크포인트로 확정한다 (보류 단계 때문에 순서가 바뀌어도 됨)
+func (s *CDCSource) Ack(ctx context.Context, records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, record := range records {
+		seq, err := strconv.ParseUint(record.Metadata.Offset, 10, 64)
+		if err != nil || seq <= s.acked {
+			continue
+		}
+		s.ackedSeqs[seq] = struct{}{}
+	}
+	for {
+		if _, ok := s.ackedSeqs[s.acked+1]; !ok {
+			break
+		}
+		delete(s.ackedSeqs, s.acked+1)
+		s.acked++
+	}
+
+	n := 0
+	for n < len(s.pending) && s.pending[n].seq <= s.acked {
+		s.confirmed = s.pending[n].checkpoint
+		n++
+	}
+	s.pending = s.pending[n:]
+	return nil
+}
+
+// AckInOrder 빠짐없이 Ack된 순번까지만 확정하므로 OrderedAcker
+func (s *CDCSource) AckInOrder() {}
+
+// AckCheckpoint 체크포인트 저장 완료 통지
+// PostgreSQL은 이 시점에야 LSN을 서버에 보고하여 slot이 WAL을 해제하도록 한다
+func (s *CDCSource) AckCheckpoint(checkpoint map[string]any) error {
+	v, ok := checkpoint["lsn"]
+	if !ok {
+		return nil
+	}
+
+	lsn, err := parseLSN(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if lsn > s.ackLSN {
+		s.ackLSN = lsn
+	}
+	s.mu.Unlock()
 
+	select {
+	case s.ackCh <- struct{}{}:
+	default:
+	}
 	return nil
 }
 
@@ -289,6 +577,13 @@ func (s *CDCSource) Close() error {
 		s.canal = nil
 	}
 
+	if s.pgConn != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		s.pgConn.Close(ctx)
+		s.pgConn = nil
+	}
+
 	return nil
 }
 
@@ -296,6 +591,20 @@ func (s *CDCSource) Close() error {
 type mysqlEventHandler struct {
 	canal.DummyEventHandler
 	source *CDCSource
+	tables tableLoader     // 테이블 메타데이터 조회 (canal)
+	done   <-chan struct{} // canal 종료 시 닫힘
+
+	changed []tableRef // OnTableChanged ~ OnDDL 사이에 변경된 테이블
+}
+
+// tableLoader 테이블 메타데이터 조회 인터페이스 (*canal.Canal)
+type tableLoader interface {
+	GetTable(db string, table string) (*schema.Table, error)
+}
+
+type tableRef struct {
+	db    string
+	table string
 }
 
 func (h *mysqlEventHandler) OnRow(e *canal.RowsEvent) error {
@@ -320,6 +629,7 @@ func (h *mysqlEventHandler) OnRow(e *canal.RowsEvent) error {
 	}
 
 	columns := e.Table.Columns
+	pkColumns := primaryKeyNames(e.Table)
 
 	// UPDATE는 old/new 쌍으로 온다
 	if e.Action == canal.UpdateAction {
@@ -340,12 +650,7 @@ func (h *mysqlEventHandler) OnRow(e *canal.RowsEvent) error {
 				OldData:    rowToMap(columns, oldRow),
 				PrimaryKey: getPrimaryKeyValues(e.Table, newRow),
 			}
-
-			select {
-			case h.source.eventCh <- event:
-			default:
-				// 채널이 가득 차면 스킵
-			}
+			h.source.sendEvent(event, pkColumns, h.done)
 		}
 	} else {
 		for _, row := range e.Rows {
@@ -362,20 +667,66 @@ func (h *mysqlEventHandler) OnRow(e *canal.RowsEvent) error {
 			} else {
 				event.Data = rowToMap(columns, row)
 			}
+			h.source.sendEvent(event, pkColumns, h.done)
+		}
+	}
 
-			select {
-			case h.source.eventCh <- event:
-			default:
+	return nil
+}
+
+// OnTableChanged canal이 테이블 캐시를 비운 직후 호출됨 (OnDDL 전에 호출)
+func (h *mysqlEventHandler) OnTableChanged(header *replication.EventHeader, db string, table string) error {
+	h.changed = append(h.changed, tableRef{db: db, table: table})
+	return nil
+}
+
+// OnDDL 변경된 테이블 스키마를 다시 읽어 캐시를 갱신하고 스키마 변경 이벤트 전달
+// 이후 행 이벤트는 갱신된 컬럼 목록으로 매핑된다
+func (h *mysqlEventHandler) OnDDL(header *replication.EventHeader, nextPos mysql.Position, queryEvent *replication.QueryEvent) error {
+	changed := h.changed
+	h.changed = nil
+
+	for _, ref := range changed {
+		data := map[string]any{
+			"ddl": string(queryEvent.Query),
+		}
+
+		table, err := h.tables.GetTable(ref.db, ref.table)
+		switch {
+		case err == canal.ErrExcludedTable:
+			continue
+		case err == nil:
+			columns := make([]any, len(table.Columns))
+			for i, col := range table.Columns {
+				columns[i] = map[string]any{"name": col.Name, "type": col.RawType}
 			}
+			data["columns"] = columns
+			data["primary_key"] = primaryKeyNames(table)
+		default:
+			// DROP 등으로 테이블이 없어진 경우 컬럼 정보 없이 전달
+			data["columns"] = nil
 		}
-	}
 
+		event := &CDCEvent{
+			Type:      CDCEventSchemaChange,
+			Database:  ref.db,
+			Table:     ref.table,
+			Timestamp: time.Unix(int64(header.Timestamp), 0),
+			Data:      data,
+		}
+		h.source.sendEvent(event, nil, h.done)
+	}
 	return nil
 }
 
+// OnPosSynced 트랜잭션/DDL 단위로 확정된 위치와 GTID set 기록
 func (h *mysqlEventHandler) OnPosSynced(header *replication.EventHeader, pos mysql.Position, set mysql.GTIDSet, force bool) error {
 	h.source.mu.Lock()
 	h.source.position = pos
+	if set != nil {
+		h.source.gtidSet = set.String()
+	}
+	h.source.trackCheckpoint()
 	h.source.mu.Unlock()
 	return nil
 }
@@ -400,6 +751,17 @@ func rowToMap(columns []schema.TableColumn, row []any) map[string]any {
 	return data
 }
 
+// primaryKeyNames PK 컬럼 이름 목록
+func primaryKeyNames(table *schema.Table) []string {
+	names := make([]string, 0, len(table.PKColumns))
+	for _, idx := range table.PKColumns {
+		if idx < len(table.Columns) {
+			names = append(names, table.Columns[idx].Name)
+		}
+	}
+	return names
+}
+
 // getPrimaryKeyValues PK 값들 추출
 func getPrimaryKeyValues(table *schema.Table, row []any) []any {
 	var pkValues []any
@@ -413,15 +775,16 @@ func getPrimaryKeyValues(table *schema.Table, row []any) []any {
 
 // CDCConfig CDC 설정 구조체 (JSON 직렬화용)
 type CDCConfig struct {
-	Driver   string   `json:"driver"`
-	Host     string   `json:"host"`
-	Port     int      `json:"port"`
-	Username string   `json:"username"`
-	Password string   `json:"password"`
-	Database string   `json:"database"`
-	Tables   []string `json:"tables"`
-	ServerID uint32   `json:"server_id"`
-	SlotName string   `json:"slot_name"`
+	Driver      string   `json:"driver"`
+	Host        string   `json:"host"`
+	Port        int      `json:"port"`
+	Username    string   `json:"username"`
+	Password    string   `json:"password"`
+	Database    string   `json:"database"`
+	Tables      []string `json:"tables"`
+	ServerID    uint32   `json:"server_id"`
+	SlotName    string   `json:"slot_name"`
+	Publication string   `json:"publication"`
 }
 
 // ToJSON 설정을 JSON으로 직렬화
@@ -0,0 +1,211 @@
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// 마스킹 방식
+const (
+	MaskRedact  = "redact"  // 고정 문자열로 대체
+	MaskHash    = "hash"    // SHA-256 해시 (조인/중복 제거용으로 값 동일성 유지)
+	MaskPartial = "partial" // 끝 N글자만 남기고 '*'로 대체
+)
+
+// maskRedacted redact 방식 대체 문자열
+const maskRedacted = "***"
+
+// cdcFilter 데이터베이스/테이블/컬럼 필터와 컬럼 마스킹
+// 정규식은 전체 일치로 평가하며, 테이블은 "db.table", 컬럼은 "db.table.column"과 비교한다
+type cdcFilter struct {
+	includeDatabases []*regexp.Regexp
+	excludeDatabases []*regexp.Regexp
+	includeTables    []*regexp.Regexp
+	excludeTables    []*regexp.Regexp
+	includeColumns   []*regexp.Regexp
+	excludeColumns   []*regexp.Regexp
+	masks            []columnMask
+}
+
+type columnMask struct {
+	column *regexp.Regexp
+	method string
+	keep   int
+}
+
+// newCDCFilter 필터 설정 컴파일 (설정이 없으면 nil 반환)
+func newCDCFilter(cfg *config.CDCFilterConfig) (*cdcFilter, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	f := &cdcFilter{}
+	var err error
+	compile := func(field string, patterns []string) []*regexp.Regexp {
+		if err != nil {
+			return nil
+		}
+		var out []*regexp.Regexp
+		out, err = compileAnchored(patterns)
+		if err != nil {
+			err = fmt.Errorf("%s: %w", field, err)
+		}
+		return out
+	}
+
+	f.includeDatabases = compile("include_databases", cfg.IncludeDatabases)
+	f.excludeDatabases = compile("exclude_databases", cfg.ExcludeDatabases)
+	f.includeTables = compile("include_tables", cfg.IncludeTables)
+	f.excludeTables = compile("exclude_tables", cfg.ExcludeTables)
+	f.includeColumns = compile("include_columns", cfg.IncludeColumns)
+	f.excludeColumns = compile("exclude_columns", cfg.ExcludeColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range cfg.Masks {
+		re, err := regexp.Compile("^(?:" + m.Column + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("masks: invalid column pattern %s: %w", m.Column, err)
+		}
+		method := m.Method
+		if method == "" {
+			method = MaskRedact
+		}
+		switch method {
+		case MaskRedact, MaskHash, MaskPartial:
+		default:
+			return nil, fmt.Errorf("masks: unsupported method %s", m.Method)
+		}
+		f.masks = append(f.masks, columnMask{column: re, method: method, keep: m.Keep})
+	}
+
+	return f, nil
+}
+
+func compileAnchored(patterns []string) ([]*regexp.Regexp, error) {
+	out := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile("^(?:" + p + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %w", p, err)
+		}
+		out = append(out, re)
+	}
+	return out, nil
+}
+
+// matchTable 데이터베이스/테이블 포함 여부
+func (f *cdcFilter) matchTable(db, table string) bool {
+	if f == nil {
+		return true
+	}
+	if !matchIncludeExclude(db, f.includeDatabases, f.excludeDatabases) {
+		return false
+	}
+	if table == "" {
+		return true
+	}
+	return matchIncludeExclude(db+"."+table, f.includeTables, f.excludeTables)
+}
+
+// excludedTablePatterns canal ExcludeTableRegex용 패턴 ("db.table" 기준)
+func (f *cdcFilter) excludedTablePatterns() []string {
+	if f == nil {
+		return nil
+	}
+	patterns := make([]string, len(f.excludeTables))
+	for i, re := range f.excludeTables {
+		patterns[i] = re.String()
+	}
+	return patterns
+}
+
+// apply 이벤트의 컬럼 필터와 마스킹 적용
+// PK 값은 엔티티 식별에 필요하므로 제외하지 않지만 마스킹 대상이면 함께 마스킹된다
+func (f *cdcFilter) apply(event *CDCEvent, pkColumns []string) {
+	if f == nil || event.Type == CDCEventSchemaChange {
+		return
+	}
+
+	prefix := event.Database + "." + event.Table + "."
+	event.Data = f.applyRow(prefix, event.Data)
+	event.OldData = f.applyRow(prefix, event.OldData)
+
+	for i := range event.PrimaryKey {
+		if i < len(pkColumns) {
+			event.PrimaryKey[i] = f.mask(prefix+pkColumns[i], event.PrimaryKey[i])
+		}
+	}
+}
+
+func (f *cdcFilter) applyRow(prefix string, row map[string]any) map[string]any {
+	if row == nil {
+		return nil
+	}
+
+	out := make(map[string]any, len(row))
+	for col, val := range row {
+		key := prefix + col
+		if !matchIncludeExclude(key, f.includeColumns, f.excludeColumns) {
+			continue
+		}
+		out[col] = f.mask(key, val)
+	}
+	return out
+}
+
+// mask 처음 일치하는 마스킹 규칙 적용 (null은 그대로 유지)
+func (f *cdcFilter) mask(column string, val any) any {
+	if val == nil {
+		return nil
+	}
+
+	for _, m := range f.masks {
+		if !m.column.MatchString(column) {
+			continue
+		}
+
+		s := fmt.Sprint(val)
+		switch m.method {
+		case MaskHash:
+			sum := sha256.Sum256([]byte(s))
+			return hex.EncodeToString(sum[:])
+		case MaskPartial:
+			runes := []rune(s)
+			keep := m.keep
+			if keep > len(runes) {
+				keep = len(runes)
+			}
+			if keep < 0 {
+				keep = 0
+			}
+			return strings.Repeat("*", len(runes)-keep) + string(runes[len(runes)-keep:])
+		default:
+			return maskRedacted
+		}
+	}
+	return val
+}
+
+// matchIncludeExclude include가 비어 있으면 전체 포함, exclude가 우선
+func matchIncludeExclude(value string, include, exclude []*regexp.Regexp) bool {
+	if len(include) > 0 && !matchAny(value, include) {
+		return false
+	}
+	return !matchAny(value, exclude)
+}
+
+func matchAny(value string, patterns []*regexp.Regexp) bool {
+	for _, re := range patterns {
+		if re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/canal"
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/replication"
+	"github.com/go-mysql-org/go-mysql/schema"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func TestCDCFilter(t *testing.T) {
+	f, err := newCDCFilter(&config.CDCFilterConfig{
+		IncludeDatabases: []string{"shop|crm"},
+		ExcludeTables:    []string{`shop\.audit_.*`},
+		ExcludeColumns:   []string{`.*\.password`},
+		Masks: []config.ColumnMaskConfig{
+			{Column: `shop\.users\.email`, Method: MaskHash},
+			{Column: `.*\.phone`, Method: MaskPartial, Keep: 4},
+			{Column: `shop\.users\.id`},
+		},
+	})
+	if err != nil {
+		t.Fatalf("newCDCFilter failed: %v", err)
+	}
+
+	tables := []struct {
+		db, table string
+		want      bool
+	}{
+		{"shop", "users", true},
+		{"crm", "leads", true},
+		{"shop", "audit_log", false},
+		{"shopping", "users", false}, // 전체 일치
+		{"mysql", "user", false},
+	}
+	for _, tt := range tables {
+		if got := f.matchTable(tt.db, tt.table); got != tt.want {
+			t.Errorf("matchTable(%s.%s) = %v, want %v", tt.db, tt.table, got, tt.want)
+		}
+	}
+
+	event := &CDCEvent{
+		Type:       CDCEventUpdate,
+		Database:   "shop",
+		Table:      "users",
+		Data:       map[string]any{"id": int64(7), "email": "a@b.c", "phone": "010-1234-5678", "password": "x", "note": nil},
+		OldData:    map[string]any{"id": int64(7), "phone": nil},
+		PrimaryKey: []any{int64(7)},
+	}
+	f.apply(event, []string{"id"})
+
+	sum := sha256.Sum256([]byte("a@b.c"))
+	want := map[string]any{
+		"id":    maskRedacted,
+		"email": hex.EncodeToString(sum[:]),
+		"phone": "*********5678",
+		"note":  nil,
+	}
+	if !reflect.DeepEqual(event.Data, want) {
+		t.Errorf("data mismatch: %v", event.Data)
+	}
+	if !reflect.DeepEqual(event.OldData, map[string]any{"id": maskRedacted, "phone": nil}) {
+		t.Errorf("old data mismatch: %v", event.OldData)
+	}
+	if event.PrimaryKey[0] != maskRedacted {
+		t.Errorf("primary key not masked: %v", event.PrimaryKey)
+	}
+
+	if _, err := newCDCFilter(&config.CDCFilterConfig{Masks: []config.ColumnMaskConfig{{Column: "a", Method: "rot13"}}}); err == nil {
+		t.Error("expected error for unsupported mask method")
+	}
+}
+
+type fakeTableLoader map[string]*schema.Table
+
+func (l fakeTableLoader) GetTable(db, table string) (*schema.Table, error) {
+	if t, ok := l[db+"."+table]; ok {
+		return t, nil
+	}
+	if db == "tmp" {
+		return nil, canal.ErrExcludedTable
+	}
+	return nil, schema.ErrTableNotExist
+}
+
+func testTable(columns ...string) *schema.Table {
+	t := &schema.Table{Schema: "shop", Name: "users", PKColumns: []int{0}}
+	for _, c := range columns {
+		t.Columns = append(t.Columns, schema.TableColumn{Name: c, RawType: "varchar(64)"})
+	}
+	return t
+}
+
+func TestMySQLEventHandlerDDL(t *testing.T) {
+	src, _ := NewCDCSource(config.SourceV2{
+		Driver: "mysql",
+		Filter: &config.CDCFilterConfig{ExcludeColumns: []string{`shop\.users\.secret`}},
+	})
+	src.running = true
+
+	altered := testTable("id", "email", "name", "secret")
+	h := &mysqlEventHandler{
+		source: src,
+		tables: fakeTableLoader{"shop.users": altered},
+		done:   make(chan struct{}),
+	}
+	header := &replication.EventHeader{Timestamp: uint32(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix())}
+
+	// ALTER TABLE 후 스키마 변경 이벤트 (제외된 DB의 변경은 무시)
+	_ = h.OnTableChanged(header, "shop", "users")
+	_ = h.OnTableChanged(header, "tmp", "scratch")
+	_ = h.OnDDL(header, mysql.Position{}, &replication.QueryEvent{Query: []byte("ALTER TABLE users ADD COLUMN name varchar(64) AFTER email")})
+
+	events := drainEvents(src)
+	if len(events) != 1 || events[0].Type != CDCEventSchemaChange {
+		t.Fatalf("expected 1 schema change event, got %+v", events)
+	}
+	columns := events[0].Data["columns"].([]any)
+	if len(columns) != 4 || columns[2].(map[string]any)["name"] != "name" {
+		t.Errorf("columns mismatch: %v", columns)
+	}
+	record := src.convertEventToRecord(events[0])
+	if record.Data["_cdc_type"] != "schema_change" || record.Data["ddl"] == nil {
+		t.Errorf("record mismatch: %+v", record.Data)
+	}
+	if _, ok := record.Data["_op"]; ok {
+		t.Errorf("schema change should not have _op: %+v", record.Data)
+	}
+
+	// DROP TABLE: 컬럼 정보 없이 전달
+	_ = h.OnTableChanged(header, "shop", "gone")
+	_ = h.OnDDL(header, mysql.Position{}, &replication.QueryEvent{Query: []byte("DROP TABLE gone")})
+	if events := drainEvents(src); len(events) != 1 || events[0].Data["columns"] != nil {
+		t.Errorf("drop event mismatch: %+v", events)
+	}
+
+	// 갱신된 스키마로 행 매핑, 제외 컬럼은 제거
+	_ = h.OnRow(&canal.RowsEvent{
+		Table:  altered,
+		Action: canal.InsertAction,
+		Rows:   [][]any{{int64(1), "a@b.c", []byte("kim"), "s3cret"}},
+	})
+	events = drainEvents(src)
+	if len(events) != 1 || !reflect.DeepEqual(events[0].Data, map[string]any{"id": int64(1), "email": "a@b.c", "name": "kim"}) {
+		t.Errorf("row mismatch: %+v", events[0].Data)
+	}
+}
+
+func TestMySQLGTIDCheckpoint(t *testing.T) {
+	src, _ := NewCDCSource(config.SourceV2{Driver: "mysql"})
+	h := &mysqlEventHandler{source: src}
+
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-23"
+	set, err := mysql.ParseGTIDSet(mysql.MySQLFlavor, gtid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = h.OnPosSynced(nil, mysql.Position{Name: "binlog.000007", Pos: 1200}, set, false)
+
+	checkpoint := src.GetCheckpoint()
+	if checkpoint["gtid_set"] != gtid || checkpoint["binlog_file"] != "binlog.000007" {
+		t.Fatalf("checkpoint mismatch: %+v", checkpoint)
+	}
+
+	resumed, _ := NewCDCSource(config.SourceV2{Driver: "mysql"})
+	_ = resumed.SetCheckpoint(map[string]any{"gtid_set": gtid, "binlog_file": "binlog.000007", "binlog_pos": float64(1200)})
+	if resumed.gtidSet != gtid || resumed.position.Pos != 1200 {
+		t.Errorf("restored state mismatch: %s %+v", resumed.gtidSet, resumed.position)
+	}
+}
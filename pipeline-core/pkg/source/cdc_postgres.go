@@ -0,0 +1,476 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/codec"
+)
+
+// pgStandbyTimeout standby status 전송 주기 (wal_sender_timeout보다 짧아야 함)
+const pgStandbyTimeout = 10 * time.Second
+
+// openPostgreSQL 복제 연결 생성 후 publication/replication slot 준비
+func (s *CDCSource) openPostgreSQL(ctx context.Context) error {
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(s.username, s.password),
+		Host:     fmt.Sprintf("%s:%d", s.host, s.port),
+		Path:     "/" + s.database,
+		RawQuery: "replication=database",
+	}
+
+	conn, err := pgconn.Connect(ctx, dsn.String())
+	if err != nil {
+		return fmt.Errorf("failed to connect postgres: %w", err)
+	}
+
+	if err := s.ensurePublication(ctx, conn); err != nil {
+		conn.Close(ctx)
+		return err
+	}
+	if err := s.ensureReplicationSlot(ctx, conn); err != nil {
+		conn.Close(ctx)
+		return err
+	}
+
+	s.mu.Lock()
+	s.pgConn = conn
+	s.mu.Unlock()
+
+	return nil
+}
+
+// ensurePublication publication이 없으면 감시 테이블(없으면 전체 테이블)로 생성
+func (s *CDCSource) ensurePublication(ctx context.Context, conn *pgconn.PgConn) error {
+	exists, err := pgExists(ctx, conn, "SELECT 1 FROM pg_publication WHERE pubname = "+pgQuoteLiteral(s.publication))
+	if err != nil {
+		return fmt.Errorf("failed to check publication: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	target := "ALL TABLES"
+	if len(s.tables) > 0 {
+		tables := make([]string, len(s.tables))
+		for i, table := range s.tables {
+			tables[i] = pgQuoteQualified(table)
+		}
+		target = "TABLE " + strings.Join(tables, ", ")
+	}
+
+	sql := fmt.Sprintf("CREATE PUBLICATION %s FOR %s", pgQuoteIdent(s.publication), target)
+	if _, err := conn.Exec(ctx, sql).ReadAll(); err != nil {
+		return fmt.Errorf("failed to create publication %s: %w", s.publication, err)
+	}
+	return nil
+}
+
+// ensureReplicationSlot pgoutput 논리 복제 슬롯이 없으면 생성
+func (s *CDCSource) ensureReplicationSlot(ctx context.Context, conn *pgconn.PgConn) error {
+	exists, err := pgExists(ctx, conn, "SELECT 1 FROM pg_replication_slots WHERE slot_name = "+pgQuoteLiteral(s.slotName))
+	if err != nil {
+		return fmt.Errorf("failed to check replication slot: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	if _, err := pglogrepl.CreateReplicationSlot(ctx, conn, s.slotName, "pgoutput", pglogrepl.CreateReplicationSlotOptions{}); err != nil {
+		return fmt.Errorf("failed to create replication slot %s: %w", s.slotName, err)
+	}
+	return nil
+}
+
+// runPostgreSQL 복제 스트림을 읽어 CDC 이벤트로 변환
+// 서버에는 AckCheckpoint로 확정된 LSN만 보고하므로 체크포인트 이전 WAL은 유지된다
+func (s *CDCSource) runPostgreSQL(ctx context.Context) error {
+	s.mu.RLock()
+	conn := s.pgConn
+	startLSN := s.ackLSN
+	s.mu.RUnlock()
+
+	if conn == nil {
+		return fmt.Errorf("postgres replication connection not initialized")
+	}
+
+	err := pglogrepl.StartReplication(ctx, conn, s.slotName, startLSN, pglogrepl.StartReplicationOptions{
+		PluginArgs: []string{
+			"proto_version '1'",
+			"publication_names " + pgQuoteLiteral(s.publication),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start replication: %w", err)
+	}
+
+	decoder := newPgOutputDecoder()
+	nextStandby := time.Now().Add(pgStandbyTimeout)
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if time.Now().After(nextStandby) {
+			if err := s.sendStandbyStatus(ctx, conn); err != nil {
+				return err
+			}
+			nextStandby = time.Now().Add(pgStandbyTimeout)
+		}
+
+		recvCtx, cancel := context.WithDeadline(ctx, nextStandby)
+		rawMsg, err := conn.ReceiveMessage(recvCtx)
+		cancel()
+		if err != nil {
+			if pgconn.Timeout(err) {
+				continue
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to receive replication message: %w", err)
+		}
+
+		switch msg := rawMsg.(type) {
+		case *pgproto3.ErrorResponse:
+			return fmt.Errorf("replication error: %s", msg.Message)
+		case *pgproto3.CopyData:
+			if len(msg.Data) == 0 {
+				continue
+			}
+			switch msg.Data[0] {
+			case pglogrepl.PrimaryKeepaliveMessageByteID:
+				keepalive, err := pglogrepl.ParsePrimaryKeepaliveMessage(msg.Data[1:])
+				if err != nil {
+					return fmt.Errorf("failed to parse keepalive: %w", err)
+				}
+				if keepalive.ReplyRequested {
+					nextStandby = time.Time{}
+				}
+
+			case pglogrepl.XLogDataByteID:
+				xld, err := pglogrepl.ParseXLogData(msg.Data[1:])
+				if err != nil {
+					return fmt.Errorf("failed to parse xlog data: %w", err)
+				}
+				if err := s.handleWAL(ctx, decoder, xld); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-s.ackCh:
+			nextStandby = time.Time{} // 확정된 LSN 즉시 보고
+		default:
+		}
+	}
+}
+
+// handleWAL pgoutput 메시지 디코딩 후 이벤트 전달
+// 체크포인트 LSN은 트랜잭션 커밋 후 그 이벤트가 모두 Ack되어야 전진하므로 중간에 재시작하면 트랜잭션 전체를 다시 받는다
+func (s *CDCSource) handleWAL(ctx context.Context, decoder *pgOutputDecoder, xld pglogrepl.XLogData) error {
+	events, commitLSN, err := decoder.Decode(xld.WALData)
+	if err != nil {
+		return fmt.Errorf("failed to decode pgoutput message: %w", err)
+	}
+
+	// 재시작 시 이미 체크포인트에 포함된 트랜잭션은 건너뜀
+	s.mu.RLock()
+	skip := decoder.finalLSN < s.ackLSN
+	s.mu.RUnlock()
+
+	for _, event := range events {
+		if skip || !s.watches(event.Database, event.Table) {
+			continue
+		}
+		if !s.sendEvent(event, decoder.keyColumns(event.Database, event.Table), ctx.Done()) && ctx.Err() != nil {
+			return nil
+		}
+	}
+
+	if commitLSN > 0 {
+		s.mu.Lock()
+		if commitLSN > s.lsn {
+			s.lsn = commitLSN
+			s.trackCheckpoint()
+		}
+		s.mu.Unlock()
+	}
+	return nil
+}
+
+// sendStandbyStatus 확정된(체크포인트 저장이 끝난) LSN을 서버에 보고
+func (s *CDCSource) sendStandbyStatus(ctx context.Context, conn *pgconn.PgConn) error {
+	if err := pglogrepl.SendStandbyStatusUpdate(ctx, conn, s.standbyStatus()); err != nil {
+		return fmt.Errorf("failed to send standby status: %w", err)
+	}
+	return nil
+}
+
+// standbyStatus 서버에 보고할 상태 (slot은 이 위치 이전의 WAL을 해제한다)
+func (s *CDCSource) standbyStatus() pglogrepl.StandbyStatusUpdate {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return pglogrepl.StandbyStatusUpdate{WALWritePosition: s.ackLSN}
+}
+
+// watches 감시 대상 테이블 여부 ("schema.table" 또는 "table")
+func (s *CDCSource) watches(schemaName, table string) bool {
+	if len(s.tables) == 0 {
+		return true
+	}
+	for _, t := range s.tables {
+		if t == table || t == schemaName+"."+table {
+			return true
+		}
+	}
+	return false
+}
+
+// pgOutputDecoder pgoutput 논리 복제 메시지 디코더
+// 서버 없이 캡처한 메시지 바이트만으로 테스트할 수 있도록 연결과 분리되어 있다
+type pgOutputDecoder struct {
+	relations  map[uint32]*pglogrepl.RelationMessage
+	typeMap    *pgtype.Map
+	commitTime time.Time
+	finalLSN   pglogrepl.LSN // 현재 트랜잭션의 커밋 LSN
+}
+
+func newPgOutputDecoder() *pgOutputDecoder {
+	return &pgOutputDecoder{
+		relations: make(map[uint32]*pglogrepl.RelationMessage),
+		typeMap:   pgtype.NewMap(),
+	}
+}
+
+// Decode 메시지 하나를 디코딩
+// 행 변경은 CDC 이벤트로, 커밋은 트랜잭션 종료 LSN으로 반환한다
+func (d *pgOutputDecoder) Decode(data []byte) ([]*CDCEvent, pglogrepl.LSN, error) {
+	msg, err := pglogrepl.Parse(data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch m := msg.(type) {
+	case *pglogrepl.RelationMessage:
+		d.relations[m.RelationID] = m
+
+	case *pglogrepl.BeginMessage:
+		d.commitTime = m.CommitTime
+		d.finalLSN = m.FinalLSN
+
+	case *pglogrepl.CommitMessage:
+		return nil, m.TransactionEndLSN, nil
+
+	case *pglogrepl.InsertMessage:
+		rel, err := d.relation(m.RelationID)
+		if err != nil {
+			return nil, 0, err
+		}
+		values, err := d.tupleToMap(rel, m.Tuple)
+		if err != nil {
+			return nil, 0, err
+		}
+		return []*CDCEvent{d.event(CDCEventInsert, rel, values, nil)}, 0, nil
+
+	case *pglogrepl.UpdateMessage:
+		rel, err := d.relation(m.RelationID)
+		if err != nil {
+			return nil, 0, err
+		}
+		values, err := d.tupleToMap(rel, m.NewTuple)
+		if err != nil {
+			return nil, 0, err
+		}
+		var old map[string]any
+		if m.OldTuple != nil {
+			if old, err = d.tupleToMap(rel, m.OldTuple); err != nil {
+				return nil, 0, err
+			}
+		}
+		return []*CDCEvent{d.event(CDCEventUpdate, rel, values, old)}, 0, nil
+
+	case *pglogrepl.DeleteMessage:
+		rel, err := d.relation(m.RelationID)
+		if err != nil {
+			return nil, 0, err
+		}
+		old, err := d.tupleToMap(rel, m.OldTuple)
+		if err != nil {
+			return nil, 0, err
+		}
+		return []*CDCEvent{d.event(CDCEventDelete, rel, nil, old)}, 0, nil
+	}
+
+	// Origin, Type, Truncate 등은 무시
+	return nil, 0, nil
+}
+
+// keyColumns 테이블의 키 컬럼 이름 목록
+func (d *pgOutputDecoder) keyColumns(namespace, table string) []string {
+	for _, rel := range d.relations {
+		if rel.Namespace != namespace || rel.RelationName != table {
+			continue
+		}
+		var names []string
+		for _, col := range rel.Columns {
+			if col.Flags&1 == 1 {
+				names = append(names, col.Name)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+func (d *pgOutputDecoder) relation(id uint32) (*pglogrepl.RelationMessage, error) {
+	rel, ok := d.relations[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown relation id %d", id)
+	}
+	return rel, nil
+}
+
+func (d *pgOutputDecoder) event(eventType CDCEventType, rel *pglogrepl.RelationMessage, data, old map[string]any) *CDCEvent {
+	// PK 값은 새 행에서, 삭제는 이전 행(키)에서 추출
+	keySource := data
+	if keySource == nil {
+		keySource = old
+	}
+
+	var pk []any
+	for _, col := range rel.Columns {
+		if col.Flags&1 == 1 {
+			pk = append(pk, keySource[col.Name])
+		}
+	}
+
+	return &CDCEvent{
+		Type:       eventType,
+		Database:   rel.Namespace,
+		Table:      rel.RelationName,
+		Timestamp:  d.commitTime,
+		Data:       data,
+		OldData:    old,
+		PrimaryKey: pk,
+	}
+}
+
+// tupleToMap 튜플 데이터를 컬럼명 기준 map으로 변환
+// 변경되지 않은 TOAST 값은 전송되지 않으므로 결과에서 제외된다
+func (d *pgOutputDecoder) tupleToMap(rel *pglogrepl.RelationMessage, tuple *pglogrepl.TupleData) (map[string]any, error) {
+	if tuple == nil {
+		return nil, nil
+	}
+
+	values := make(map[string]any, len(tuple.Columns))
+	for i, col := range tuple.Columns {
+		if i >= len(rel.Columns) {
+			break
+		}
+		relCol := rel.Columns[i]
+
+		switch col.DataType {
+		case pglogrepl.TupleDataTypeNull:
+			values[relCol.Name] = nil
+		case pglogrepl.TupleDataTypeToast:
+			continue
+		case pglogrepl.TupleDataTypeText:
+			val, err := d.decodeText(relCol.DataType, col.Data)
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", relCol.Name, err)
+			}
+			values[relCol.Name] = val
+		case pglogrepl.TupleDataTypeBinary:
+			values[relCol.Name] = col.Data
+		}
+	}
+	return values, nil
+}
+
+// decodeText 텍스트 형식 컬럼 값을 Go 값으로 변환 (알 수 없는 타입은 문자열)
+func (d *pgOutputDecoder) decodeText(oid uint32, data []byte) (any, error) {
+	dt, ok := d.typeMap.TypeForOID(oid)
+	if !ok {
+		return string(data), nil
+	}
+
+	val, err := dt.Codec.DecodeValue(d.typeMap, oid, pgtype.TextFormatCode, data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := val.(type) {
+	case pgtype.Numeric:
+		if i, err := v.Int64Value(); err == nil && i.Valid && v.Exp >= 0 {
+			return i.Int64, nil
+		}
+		f, err := v.Float64Value()
+		if err != nil {
+			return string(data), nil
+		}
+		return f.Float64, nil
+	case [16]byte:
+		// uuid는 원문 문자열 유지
+		return string(data), nil
+	}
+	return codec.Normalize(val), nil
+}
+
+func pgExists(ctx context.Context, conn *pgconn.PgConn, sql string) (bool, error) {
+	results, err := conn.Exec(ctx, sql).ReadAll()
+	if err != nil {
+		return false, err
+	}
+	return len(results) > 0 && len(results[0].Rows) > 0, nil
+}
+
+func pgQuoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func pgQuoteIdent(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// pgQuoteQualified "schema.table" 형식 이름을 각각 인용
+func pgQuoteQualified(name string) string {
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		parts[i] = pgQuoteIdent(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// parseLSN 체크포인트 값에서 LSN 파싱 ("0/16B3748" 또는 숫자)
+func parseLSN(v any) (pglogrepl.LSN, error) {
+	switch val := v.(type) {
+	case string:
+		if !strings.Contains(val, "/") {
+			n, err := strconv.ParseUint(val, 10, 64)
+			return pglogrepl.LSN(n), err
+		}
+		return pglogrepl.ParseLSN(val)
+	case float64:
+		return pglogrepl.LSN(val), nil
+	case uint64:
+		return pglogrepl.LSN(val), nil
+	case int64:
+		return pglogrepl.LSN(val), nil
+	case pglogrepl.LSN:
+		return val, nil
+	}
+	return 0, fmt.Errorf("invalid lsn: %v", v)
+}
@@ -0,0 +1,235 @@
+package source
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jackc/pglogrepl"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// pgoutput 메시지 바이트 생성 헬퍼 (프로토콜 v1)
+
+type pgMsg []byte
+
+func (m pgMsg) u8(v byte) pgMsg     { return append(m, v) }
+func (m pgMsg) str(v string) pgMsg  { return append(append(m, v...), 0) }
+func (m pgMsg) u16(v uint16) pgMsg  { return binary.BigEndian.AppendUint16(m, v) }
+func (m pgMsg) u32(v uint32) pgMsg  { return binary.BigEndian.AppendUint32(m, v) }
+func (m pgMsg) u64(v uint64) pgMsg  { return binary.BigEndian.AppendUint64(m, v) }
+func (m pgMsg) text(v string) pgMsg { return append(m.u8('t').u32(uint32(len(v))), v...) }
+
+func pgTime(t time.Time) uint64 {
+	return uint64(t.Sub(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).Microseconds())
+}
+
+var pgCommitTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func pgRelation() []byte {
+	// public.users (id int8 key, name text, score numeric, active bool)
+	return pgMsg{'R'}.u32(16384).str("public").str("users").u8('d').u16(4).
+		u8(1).str("id").u32(20).u32(0xffffffff).
+		u8(0).str("name").u32(25).u32(0xffffffff).
+		u8(0).str("score").u32(1700).u32(0xffffffff).
+		u8(0).str("active").u32(16).u32(0xffffffff)
+}
+
+func pgBegin(finalLSN uint64) []byte {
+	return pgMsg{'B'}.u64(finalLSN).u64(pgTime(pgCommitTime)).u32(700)
+}
+
+func pgCommit(commitLSN, endLSN uint64) []byte {
+	return pgMsg{'C'}.u8(0).u64(commitLSN).u64(endLSN).u64(pgTime(pgCommitTime))
+}
+
+func pgInsert() []byte {
+	return pgMsg{'I'}.u32(16384).u8('N').u16(4).text("1").text("alice").text("9.5").text("t")
+}
+
+func pgUpdate() []byte {
+	return pgMsg{'U'}.u32(16384).
+		u8('O').u16(4).text("1").text("alice").text("9.5").text("t").
+		u8('N').u16(4).text("1").u8('n').u8('u').text("f")
+}
+
+func pgDelete() []byte {
+	return pgMsg{'D'}.u32(16384).u8('K').u16(4).text("1").u8('n').u8('n').u8('n')
+}
+
+func TestPgOutputDecoder(t *testing.T) {
+	d := newPgOutputDecoder()
+
+	for _, msg := range [][]byte{pgRelation(), pgBegin(0x100)} {
+		if events, _, err := d.Decode(msg); err != nil || len(events) != 0 {
+			t.Fatalf("unexpected result: %v %v", events, err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     []byte
+		typ     CDCEventType
+		data    map[string]any
+		oldData map[string]any
+	}{
+		{
+			name: "insert",
+			msg:  pgInsert(),
+			typ:  CDCEventInsert,
+			data: map[string]any{"id": int64(1), "name": "alice", "score": 9.5, "active": true},
+		},
+		{
+			name:    "update with unchanged toast",
+			msg:     pgUpdate(),
+			typ:     CDCEventUpdate,
+			data:    map[string]any{"id": int64(1), "name": nil, "active": false},
+			oldData: map[string]any{"id": int64(1), "name": "alice", "score": 9.5, "active": true},
+		},
+		{
+			name:    "delete",
+			msg:     pgDelete(),
+			typ:     CDCEventDelete,
+			oldData: map[string]any{"id": int64(1), "name": nil, "score": nil, "active": nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, _, err := d.Decode(tt.msg)
+			if err != nil {
+				t.Fatalf("decode failed: %v", err)
+			}
+			if len(events) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(events))
+			}
+			e := events[0]
+			if e.Type != tt.typ || e.Database != "public" || e.Table != "users" || !e.Timestamp.Equal(pgCommitTime) {
+				t.Errorf("envelope mismatch: %+v", e)
+			}
+			if !reflect.DeepEqual(e.Data, tt.data) || !reflect.DeepEqual(e.OldData, tt.oldData) {
+				t.Errorf("data mismatch: data=%v old=%v", e.Data, e.OldData)
+			}
+			if !reflect.DeepEqual(e.PrimaryKey, []any{int64(1)}) {
+				t.Errorf("primary key mismatch: %v", e.PrimaryKey)
+			}
+		})
+	}
+
+	if _, lsn, err := d.Decode(pgCommit(0x100, 0x128)); err != nil || lsn != 0x128 {
+		t.Errorf("commit lsn mismatch: %v %v", lsn, err)
+	}
+
+	if _, _, err := newPgOutputDecoder().Decode(pgInsert()); err == nil {
+		t.Error("expected error for unknown relation")
+	}
+}
+
+func TestCDCSourceAckGap(t *testing.T) {
+	src, _ := NewCDCSource(config.SourceV2{Driver: "postgres", Tables: []string{"public.users"}})
+	ctx := context.Background()
+	decoder := newPgOutputDecoder()
+
+	msgs := [][]byte{pgRelation()}
+	for _, lsn := range []uint64{0x100, 0x200, 0x300} {
+		msgs = append(msgs, pgBegin(lsn), pgInsert(), pgCommit(lsn, lsn+0x28))
+	}
+	for _, msg := range msgs {
+		if err := src.handleWAL(ctx, decoder, pglogrepl.XLogData{WALData: msg}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(src.eventCh) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(src.eventCh))
+	}
+	first, _, third := <-src.eventCh, <-src.eventCh, <-src.eventCh
+
+	// 가운데 레코드가 Ack되지 않으면 뒤 레코드가 Ack되어도 그 앞 트랜잭션까지만 확정
+	// (다시 전달되지 않으므로 파이프라인은 OrderedAcker 소스의 실패를 실행 중단으로 처리)
+	if err := src.Ack(ctx, []Record{src.convertEventToRecord(first), src.convertEventToRecord(third)}); err != nil {
+		t.Fatal(err)
+	}
+	if got := src.GetCheckpoint()["lsn"]; got != "0/128" {
+		t.Errorf("checkpoint = %v, want 0/128 (stopped before the unacked record)", got)
+	}
+	if _, ok := any(src).(OrderedAcker); !ok {
+		t.Error("CDCSource should be an OrderedAcker")
+	}
+}
+
+func TestCDCSourcePostgresCheckpoint(t *testing.T) {
+	src, _ := NewCDCSource(config.SourceV2{Driver: "postgres", Tables: []string{"public.users"}})
+	ctx := context.Background()
+	decoder := newPgOutputDecoder()
+
+	wal := func(data []byte) {
+		t.Helper()
+		if err := src.handleWAL(ctx, decoder, pglogrepl.XLogData{WALData: data}); err != nil {
+			t.Fatalf("handleWAL failed: %v", err)
+		}
+	}
+
+	wal(pgRelation())
+	wal(pgBegin(0x100))
+	wal(pgInsert())
+
+	// 커밋 전에는 체크포인트가 전진하지 않음
+	if got := src.GetCheckpoint()["lsn"]; got != "0/0" {
+		t.Errorf("expected no checkpoint before commit, got %v", got)
+	}
+	wal(pgCommit(0x100, 0x128))
+	if len(src.eventCh) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(src.eventCh))
+	}
+
+	// 커밋되었어도 트랜잭션의 이벤트가 싱크에 기록(Ack)되기 전에는 전진하지 않음
+	if got := src.GetCheckpoint()["lsn"]; got != "0/0" {
+		t.Errorf("expected no checkpoint before ack, got %v", got)
+	}
+	if err := src.Ack(ctx, []Record{src.convertEventToRecord(<-src.eventCh)}); err != nil {
+		t.Fatal(err)
+	}
+	checkpoint := src.GetCheckpoint()
+	if got := checkpoint["lsn"]; got != "0/128" {
+		t.Errorf("checkpoint mismatch: %v", got)
+	}
+
+	// 체크포인트 저장 후에만 서버 보고 LSN이 전진
+	if got := src.standbyStatus().WALWritePosition; got != 0 {
+		t.Errorf("standby lsn should not advance before AckCheckpoint: %v", got)
+	}
+	if err := src.AckCheckpoint(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if got := src.standbyStatus().WALWritePosition; got != 0x128 {
+		t.Errorf("standby lsn mismatch: %v", got)
+	}
+
+	// 재개: 체크포인트 이전 트랜잭션은 다시 받아도 건너뜀
+	resumed, _ := NewCDCSource(config.SourceV2{Driver: "postgres"})
+	if err := resumed.SetCheckpoint(map[string]any{"lsn": "0/128"}); err != nil {
+		t.Fatal(err)
+	}
+	decoder = newPgOutputDecoder()
+	for _, msg := range [][]byte{pgRelation(), pgBegin(0x100), pgInsert(), pgCommit(0x100, 0x128), pgBegin(0x200), pgInsert()} {
+		if err := resumed.handleWAL(ctx, decoder, pglogrepl.XLogData{WALData: msg}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(resumed.eventCh) != 1 {
+		t.Errorf("expected only the new transaction, got %d events", len(resumed.eventCh))
+	}
+
+	// 감시 대상이 아닌 테이블은 제외
+	other, _ := NewCDCSource(config.SourceV2{Driver: "postgres", Tables: []string{"orders"}})
+	decoder = newPgOutputDecoder()
+	for _, msg := range [][]byte{pgRelation(), pgBegin(0x100), pgInsert()} {
+		_ = other.handleWAL(ctx, decoder, pglogrepl.XLogData{WALData: msg})
+	}
+	if len(other.eventCh) != 0 {
+		t.Errorf("expected filtered events, got %d", len(other.eventCh))
+	}
+}
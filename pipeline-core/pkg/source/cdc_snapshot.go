@@ -0,0 +1,279 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-mysql-org/go-mysql/canal"
+)
+
+// snapshotState 초기 스냅샷 진행 상태 (체크포인트에 저장)
+type snapshotState struct {
+	Completed  bool     // 모든 테이블 스냅샷 완료 여부
+	TablesDone []string // 완료된 테이블 ("db.table")
+	Table      string   // 진행 중인 테이블
+	LastPK     []string // 진행 중인 테이블에서 마지막으로 읽은 PK 값
+}
+
+// snapshotReader 스냅샷용 테이블 조회 인터페이스 (테스트에서 대체 가능)
+type snapshotReader interface {
+	// tableInfo 컬럼 목록과 PK 컬럼 목록 반환
+	tableInfo(db, table string) (columns []string, pk []string, err error)
+	// query 쿼리 실행 후 행 값 반환
+	query(sql string, args ...any) ([][]any, error)
+}
+
+// canalSnapshotReader canal 연결을 사용하는 snapshotReader
+type canalSnapshotReader struct {
+	canal *canal.Canal
+}
+
+func (r *canalSnapshotReader) tableInfo(db, table string) ([]string, []string, error) {
+	t, err := r.canal.GetTable(db, table)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := make([]string, len(t.Columns))
+	for i, col := range t.Columns {
+		columns[i] = col.Name
+	}
+
+	pk := make([]string, len(t.PKColumns))
+	for i, idx := range t.PKColumns {
+		pk[i] = t.Columns[idx].Name
+	}
+	return columns, pk, nil
+}
+
+func (r *canalSnapshotReader) query(sql string, args ...any) ([][]any, error) {
+	result, err := r.canal.Execute(sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	if result.Resultset == nil {
+		return nil, nil
+	}
+
+	rows := make([][]any, len(result.Values))
+	for i, values := range result.Values {
+		row := make([]any, len(values))
+		for j := range values {
+			val := values[j].Value()
+			if b, ok := val.([]byte); ok {
+				val = string(b)
+			}
+			row[j] = val
+		}
+		rows[i] = row
+	}
+	return rows, nil
+}
+
+// runSnapshot 감시 테이블을 PK 순서로 청크 단위로 읽어 스냅샷 이벤트(op=r) 전달
+// binlog 위치는 스냅샷 시작 전에 기록되어 있어야 하며, 스냅샷 중 발생한 변경은
+// 이후 해당 위치부터 binlog를 읽으며 다시 반영된다
+func (s *CDCSource) runSnapshot(ctx context.Context, reader snapshotReader) error {
+	for _, name := range s.tables {
+		db, table := s.splitTableName(name)
+		key := db + "." + table
+
+		s.mu.RLock()
+		done := contains(s.snapshot.TablesDone, key)
+		var lastPK []string
+		if s.snapshot.Table == key {
+			lastPK = s.snapshot.LastPK
+		}
+		s.mu.RUnlock()
+
+		if done {
+			continue
+		}
+		if !s.filter.matchTable(db, table) {
+			continue
+		}
+
+		if err := s.snapshotTable(ctx, reader, db, table, lastPK); err != nil {
+			return fmt.Errorf("snapshot %s: %w", key, err)
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		s.mu.Lock()
+		s.snapshot.TablesDone = append(s.snapshot.TablesDone, key)
+		s.snapshot.Table = ""
+		s.snapshot.LastPK = nil
+		s.trackCheckpoint()
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	s.snapshot.Completed = true
+	s.trackCheckpoint()
+	s.mu.Unlock()
+	return nil
+}
+
+// snapshotTable 테이블 하나를 청크 단위로 읽음 (청크마다 진행 위치 갱신)
+func (s *CDCSource) snapshotTable(ctx context.Context, reader snapshotReader, db, table string, lastPK []string) error {
+	columns, pk, err := reader.tableInfo(db, table)
+	if err != nil {
+		return err
+	}
+	if len(pk) == 0 {
+		return fmt.Errorf("table has no primary key")
+	}
+
+	pkIndex := make([]int, len(pk))
+	for i, name := range pk {
+		pkIndex[i] = indexOf(columns, name)
+		if pkIndex[i] < 0 {
+			return fmt.Errorf("primary key column %s not found", name)
+		}
+	}
+
+	for {
+		sql := snapshotChunkQuery(db, table, columns, pk, len(lastPK) > 0, s.snapshotChunkSize)
+		args := make([]any, len(lastPK))
+		for i, v := range lastPK {
+			args[i] = v
+		}
+
+		rows, err := reader.query(sql, args...)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			data := make(map[string]any, len(columns))
+			for i, col := range columns {
+				if i < len(row) {
+					data[col] = row[i]
+				}
+			}
+
+			pkValues := make([]any, len(pkIndex))
+			for i, idx := range pkIndex {
+				pkValues[i] = row[idx]
+			}
+
+			event := &CDCEvent{
+				Type:       CDCEventSnapshot,
+				Database:   db,
+				Table:      table,
+				Timestamp:  time.Now(),
+				Data:       data,
+				PrimaryKey: pkValues,
+			}
+			if !s.sendEvent(event, pk, ctx.Done()) && ctx.Err() != nil {
+				return nil
+			}
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+
+		// 청크 단위 재개 위치 기록 (값은 JSON 왕복에도 정밀도를 잃지 않도록 문자열로 저장)
+		last := rows[len(rows)-1]
+		lastPK = make([]string, len(pkIndex))
+		for i, idx := range pkIndex {
+			lastPK[i] = fmt.Sprint(last[idx])
+		}
+
+		s.mu.Lock()
+		s.snapshot.Table = db + "." + table
+		s.snapshot.LastPK = lastPK
+		s.trackCheckpoint()
+		s.mu.Unlock()
+
+		if len(rows) < s.snapshotChunkSize {
+			return nil
+		}
+	}
+}
+
+// snapshotChunkQuery PK 기준 keyset 페이지네이션 쿼리 생성
+func snapshotChunkQuery(db, table string, columns, pk []string, after bool, limit int) string {
+	quoted := make([]string, len(columns))
+	for i, col := range columns {
+		quoted[i] = quoteMySQLIdent(col)
+	}
+
+	pkCols := make([]string, len(pk))
+	placeholders := make([]string, len(pk))
+	for i, col := range pk {
+		pkCols[i] = quoteMySQLIdent(col)
+		placeholders[i] = "?"
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "SELECT %s FROM %s.%s", strings.Join(quoted, ", "), quoteMySQLIdent(db), quoteMySQLIdent(table))
+	if after {
+		fmt.Fprintf(&sb, " WHERE (%s) > (%s)", strings.Join(pkCols, ", "), strings.Join(placeholders, ", "))
+	}
+	fmt.Fprintf(&sb, " ORDER BY %s LIMIT %d", strings.Join(pkCols, ", "), limit)
+	return sb.String()
+}
+
+// splitTableName "db.table" 또는 "table" (기본 database 사용) 분리
+func (s *CDCSource) splitTableName(name string) (string, string) {
+	if db, table, ok := strings.Cut(name, "."); ok {
+		return db, table
+	}
+	return s.database, name
+}
+
+// checkpoint 스냅샷 상태를 체크포인트 값으로 변환
+func (st *snapshotState) checkpoint() map[string]any {
+	return map[string]any{
+		"completed":   st.Completed,
+		"tables_done": append([]string(nil), st.TablesDone...),
+		"table":       st.Table,
+		"last_pk":     append([]string(nil), st.LastPK...),
+	}
+}
+
+// restore 체크포인트 값에서 스냅샷 상태 복원 (JSON 왕복 형태 허용)
+func (st *snapshotState) restore(v map[string]any) {
+	st.Completed, _ = v["completed"].(bool)
+	st.Table, _ = v["table"].(string)
+	st.TablesDone = toStringSlice(v["tables_done"])
+	st.LastPK = toStringSlice(v["last_pk"])
+}
+
+func toStringSlice(v any) []string {
+	switch val := v.(type) {
+	case []string:
+		return append([]string(nil), val...)
+	case []any:
+		out := make([]string, 0, len(val))
+		for _, item := range val {
+			out = append(out, fmt.Sprint(item))
+		}
+		return out
+	}
+	return nil
+}
+
+func quoteMySQLIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func contains(list []string, value string) bool {
+	return indexOf(list, value) >= 0
+}
+
+func indexOf(list []string, value string) int {
+	for i, item := range list {
+		if item == value {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,145 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// fakeSnapshotReader id 순으로 정렬된 메모리 테이블
+type fakeSnapshotReader struct {
+	rows    map[string][][]any // "db.table" -> rows (id, name)
+	queries []string
+	failAt  int // n번째 쿼리에서 실패 (0이면 실패 없음)
+}
+
+func (r *fakeSnapshotReader) tableInfo(db, table string) ([]string, []string, error) {
+	return []string{"id", "name"}, []string{"id"}, nil
+}
+
+func (r *fakeSnapshotReader) query(sql string, args ...any) ([][]any, error) {
+	r.queries = append(r.queries, sql)
+	if r.failAt > 0 && len(r.queries) == r.failAt {
+		return nil, fmt.Errorf("connection lost")
+	}
+
+	var table string
+	for key := range r.rows {
+		if db, name, _ := strings.Cut(key, "."); sql == snapshotChunkQuery(db, name, []string{"id", "name"}, []string{"id"}, len(args) > 0, 2) {
+			table = key
+		}
+	}
+
+	after := int64(-1)
+	if len(args) > 0 {
+		after, _ = strconv.ParseInt(args[0].(string), 10, 64)
+	}
+
+	var out [][]any
+	for _, row := range r.rows[table] {
+		if row[0].(int64) > after && len(out) < 2 {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
+
+func drainEvents(src *CDCSource) []*CDCEvent {
+	var events []*CDCEvent
+	for len(src.eventCh) > 0 {
+		events = append(events, <-src.eventCh)
+	}
+	return events
+}
+
+// ackEvents 싱크 기록이 끝난 것처럼 이벤트를 Ack
+func ackEvents(t *testing.T, src *CDCSource, events []*CDCEvent) {
+	t.Helper()
+	records := make([]Record, len(events))
+	for i, e := range events {
+		records[i] = src.convertEventToRecord(e)
+	}
+	if err := src.Ack(context.Background(), records); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSnapshotChunkQuery(t *testing.T) {
+	got := snapshotChunkQuery("shop", "order_items", []string{"order_id", "line", "sku"}, []string{"order_id", "line"}, true, 500)
+	want := "SELECT `order_id`, `line`, `sku` FROM `shop`.`order_items` WHERE (`order_id`, `line`) > (?, ?) ORDER BY `order_id`, `line` LIMIT 500"
+	if got != want {
+		t.Errorf("query mismatch:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestCDCSourceSnapshot(t *testing.T) {
+	rows := map[string][][]any{
+		"shop.users":  {{int64(1), "a"}, {int64(2), "b"}, {int64(3), "c"}},
+		"shop.orders": {{int64(10), "x"}},
+	}
+	cfg := config.SourceV2{
+		Driver:            "mysql",
+		Database:          "shop",
+		Tables:            []string{"users", "shop.orders"},
+		SnapshotMode:      "initial",
+		SnapshotChunkSize: 2,
+	}
+
+	// 두 번째 청크에서 중단
+	src, _ := NewCDCSource(cfg)
+	if err := src.runSnapshot(context.Background(), &fakeSnapshotReader{rows: rows, failAt: 2}); err == nil {
+		t.Fatal("expected snapshot error")
+	}
+	events := drainEvents(src)
+	if len(events) != 2 {
+		t.Fatalf("expected first chunk only, got %d events", len(events))
+	}
+
+	// 청크 진행 위치는 그 청크의 이벤트가 Ack된 뒤에 체크포인트로 나감
+	if snapshot := src.GetCheckpoint()["snapshot"].(map[string]any); snapshot["table"] != "" {
+		t.Errorf("expected no progress before ack, got %+v", snapshot)
+	}
+	ackEvents(t, src, events)
+
+	// JSON 왕복한 체크포인트로 재개하면 남은 청크부터 읽음
+	data, _ := json.Marshal(src.GetCheckpoint())
+	var checkpoint map[string]any
+	_ = json.Unmarshal(data, &checkpoint)
+
+	resumed, _ := NewCDCSource(cfg)
+	if err := resumed.SetCheckpoint(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if err := resumed.runSnapshot(context.Background(), &fakeSnapshotReader{rows: rows}); err != nil {
+		t.Fatalf("snapshot failed: %v", err)
+	}
+
+	events = drainEvents(resumed)
+	ackEvents(t, resumed, events)
+	var got []any
+	for _, e := range events {
+		if e.Type != CDCEventSnapshot {
+			t.Errorf("unexpected event type: %s", e.Type)
+		}
+		got = append(got, e.PrimaryKey[0])
+	}
+	if !reflect.DeepEqual(got, []any{int64(3), int64(10)}) {
+		t.Errorf("resumed keys mismatch: %v", got)
+	}
+
+	record := resumed.convertEventToRecord(events[0])
+	if record.Data["_op"] != "r" || record.Data["name"] != "c" || record.Metadata.Origin != "shop.users" {
+		t.Errorf("record mismatch: %+v", record)
+	}
+
+	snapshot := resumed.GetCheckpoint()["snapshot"].(map[string]any)
+	if snapshot["completed"] != true || !reflect.DeepEqual(snapshot["tables_done"], []string{"shop.users", "shop.orders"}) {
+		t.Errorf("snapshot checkpoint mismatch: %+v", snapshot)
+	}
+}
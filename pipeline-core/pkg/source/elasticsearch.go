@@ -0,0 +1,349 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// ElasticsearchSource Elasticsearch 인덱스 전체(또는 쿼리 결과) 읽기
+// point-in-time으로 일관된 스냅샷을 고정하고 search_after로 페이지를 넘긴다
+// sort를 고유 필드로 지정하면 중단 후 PIT가 만료되어도 마지막 정렬 값부터 이어 읽는다
+type ElasticsearchSource struct {
+	cfg       elasticsearch.Config
+	client    *elasticsearch.Client
+	index     string
+	query     map[string]any
+	sort      []any
+	stable    bool // 사용자 지정 정렬 (PIT와 무관하게 search_after 값이 유효)
+	keepAlive string
+	batchSize int
+
+	// 진행 상태 (전체를 읽으면 초기화되어 다음 실행은 처음부터 읽음)
+	mu          sync.RWMutex
+	pitID       string
+	searchAfter []any
+}
+
+// NewElasticsearchSource Elasticsearch 소스 생성
+func NewElasticsearchSource(cfg config.SourceV2) (*ElasticsearchSource, error) {
+	if len(cfg.Addresses) == 0 {
+		return nil, fmt.Errorf("elasticsearch addresses are required")
+	}
+	if cfg.Index == "" {
+		return nil, fmt.Errorf("elasticsearch index is required")
+	}
+
+	esCfg := elasticsearch.Config{Addresses: cfg.Addresses}
+	if cfg.APIKey != "" {
+		esCfg.APIKey = cfg.APIKey
+	} else if cfg.Username != "" {
+		esCfg.Username = cfg.Username
+		esCfg.Password = cfg.Password
+	}
+
+	keepAlive := "5m"
+	if cfg.KeepAlive != "" {
+		d, err := time.ParseDuration(cfg.KeepAlive)
+		if err != nil {
+			return nil, fmt.Errorf("invalid keep_alive: %w", err)
+		}
+		keepAlive = fmt.Sprintf("%dms", d.Milliseconds())
+	}
+
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	query := cfg.QueryFilter
+	if len(query) == 0 {
+		query = map[string]any{"match_all": map[string]any{}}
+	}
+
+	// 정렬 미지정 시 PIT 전용 타이브레이커(_shard_doc) 사용
+	sort := []any{"_shard_doc"}
+	if len(cfg.Sort) > 0 {
+		sort = make([]any, len(cfg.Sort))
+		for i, field := range cfg.Sort {
+			order := "asc"
+			if strings.HasPrefix(field, "-") {
+				field, order = field[1:], "desc"
+			}
+			sort[i] = map[string]any{field: map[string]any{"order": order}}
+		}
+	}
+
+	return &ElasticsearchSource{
+		cfg:       esCfg,
+		index:     cfg.Index,
+		query:     query,
+		sort:      sort,
+		stable:    len(cfg.Sort) > 0,
+		keepAlive: keepAlive,
+		batchSize: batchSize,
+	}, nil
+}
+
+func (s *ElasticsearchSource) Name() string {
+	return "elasticsearch"
+}
+
+func (s *ElasticsearchSource) Open(ctx context.Context) error {
+	client, err := elasticsearch.NewClient(s.cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create elasticsearch client: %w", err)
+	}
+	s.client = client
+	return nil
+}
+
+func (s *ElasticsearchSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
+	records := make(chan Record, s.batchSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(records)
+		defer close(errs)
+
+		if err := s.readAll(ctx, records); err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return records, errs
+}
+
+func (s *ElasticsearchSource) readAll(ctx context.Context, records chan<- Record) error {
+	if s.client == nil {
+		return fmt.Errorf("elasticsearch client not opened")
+	}
+
+	s.mu.RLock()
+	pitID, after := s.pitID, s.searchAfter
+	s.mu.RUnlock()
+
+	if pitID == "" {
+		if after != nil && !s.stable {
+			return fmt.Errorf("cannot resume without point in time: configure sort on a unique field")
+		}
+		id, err := s.openPIT(ctx)
+		if err != nil {
+			return err
+		}
+		pitID = id
+	}
+
+	for {
+		result, status, err := s.search(ctx, pitID, after)
+		if status == http.StatusNotFound && s.stable {
+			// 저장된 PIT가 만료된 경우 새 PIT에서 같은 정렬 값부터 이어 읽음
+			if pitID, err = s.openPIT(ctx); err != nil {
+				return err
+			}
+			result, _, err = s.search(ctx, pitID, after)
+		}
+		if err != nil {
+			return err
+		}
+		if result.PitID != "" {
+			pitID = result.PitID
+		}
+
+		hits := result.Hits.Hits
+		for _, hit := range hits {
+			data := make(map[string]any)
+			if len(hit.Source) > 0 {
+				if err := json.Unmarshal(hit.Source, &data); err != nil {
+					return fmt.Errorf("failed to decode document %s: %w", hit.ID, err)
+				}
+			}
+			if _, exists := data["_id"]; !exists {
+				data["_id"] = hit.ID
+			}
+
+			select {
+			case records <- Record{
+				Data: data,
+				Metadata: Metadata{
+					Source:    "elasticsearch",
+					Origin:    hit.Index,
+					Offset:    hit.ID,
+					Timestamp: time.Now().UnixMilli(),
+				},
+			}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if len(hits) > 0 {
+			if after, err = decodeSortValues(hits[len(hits)-1].Sort); err != nil {
+				return err
+			}
+		}
+		s.mu.Lock()
+		s.pitID, s.searchAfter = pitID, after
+		s.mu.Unlock()
+
+		if len(hits) < s.batchSize {
+			break
+		}
+	}
+
+	s.mu.Lock()
+	s.pitID, s.searchAfter = "", nil
+	s.mu.Unlock()
+	s.closePIT(pitID)
+	return nil
+}
+
+// esSearchResult search 응답 중 필요한 부분
+type esSearchResult struct {
+	PitID string `json:"pit_id"`
+	Hits  struct {
+		Hits []struct {
+			Index  string          `json:"_index"`
+			ID     string          `json:"_id"`
+			Source json.RawMessage `json:"_source"`
+			Sort   json.RawMessage `json:"sort"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+// search PIT 기반 검색 (status는 응답 코드, 요청 실패 시 0)
+func (s *ElasticsearchSource) search(ctx context.Context, pitID string, after []any) (*esSearchResult, int, error) {
+	body := map[string]any{
+		"size":             s.batchSize,
+		"query":            s.query,
+		"sort":             s.sort,
+		"pit":              map[string]any{"id": pitID, "keep_alive": s.keepAlive},
+		"track_total_hits": false,
+	}
+	if after != nil {
+		body["search_after"] = after
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to encode search request: %w", err)
+	}
+
+	res, err := s.client.Search(
+		s.client.Search.WithContext(ctx),
+		s.client.Search.WithBody(bytes.NewReader(payload)),
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("search failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, res.StatusCode, esError("search", res)
+	}
+
+	var result esSearchResult
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, res.StatusCode, fmt.Errorf("failed to decode search response: %w", err)
+	}
+	return &result, res.StatusCode, nil
+}
+
+func (s *ElasticsearchSource) openPIT(ctx context.Context) (string, error) {
+	res, err := s.client.OpenPointInTime([]string{s.index}, s.keepAlive,
+		s.client.OpenPointInTime.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("failed to open point in time: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return "", esError("open point in time", res)
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode point in time response: %w", err)
+	}
+	return result.ID, nil
+}
+
+// closePIT PIT 해제 (실패해도 keep_alive 후 만료되므로 무시)
+func (s *ElasticsearchSource) closePIT(pitID string) {
+	if pitID == "" || s.client == nil {
+		return
+	}
+	payload, _ := json.Marshal(map[string]any{"id": pitID})
+	res, err := s.client.ClosePointInTime(s.client.ClosePointInTime.WithBody(bytes.NewReader(payload)))
+	if err == nil {
+		res.Body.Close()
+	}
+}
+
+// decodeSortValues 정렬 값 디코딩 (long 값 정밀도 유지를 위해 json.Number 사용)
+func decodeSortValues(raw []byte) ([]any, error) {
+	var values []any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&values); err != nil {
+		return nil, fmt.Errorf("invalid sort values %s: %w", string(raw), err)
+	}
+	return values, nil
+}
+
+func esError(action string, res *esapi.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+	return fmt.Errorf("elasticsearch %s failed %d: %s", action, res.StatusCode, string(body))
+}
+
+// GetCheckpoint 현재 체크포인트 반환 (마지막으로 전달한 문서의 정렬 값)
+// search_after는 long 정렬 값이 JSON 저장 과정에서 float로 바뀌지 않도록 JSON 문자열로 저장한다
+func (s *ElasticsearchSource) GetCheckpoint() map[string]any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	checkpoint := make(map[string]any)
+	if s.searchAfter != nil {
+		after, _ := json.Marshal(s.searchAfter)
+		checkpoint["search_after"] = string(after)
+	}
+	if s.pitID != "" {
+		checkpoint["pit_id"] = s.pitID
+	}
+	return checkpoint
+}
+
+// SetCheckpoint 체크포인트 설정 (복구용)
+func (s *ElasticsearchSource) SetCheckpoint(checkpoint map[string]any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := checkpoint["search_after"].(string); ok && v != "" {
+		after, err := decodeSortValues([]byte(v))
+		if err != nil {
+			return fmt.Errorf("invalid search_after checkpoint: %w", err)
+		}
+		s.searchAfter = after
+	}
+	if v, ok := checkpoint["pit_id"].(string); ok {
+		s.pitID = v
+	}
+	return nil
+}
+
+// Close 완료되지 않은 PIT는 재개를 위해 닫지 않음 (keep_alive 후 자동 만료)
+func (s *ElasticsearchSource) Close() error {
+	return nil
+}
@@ -0,0 +1,192 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// fakeElasticsearch PIT + search_after만 지원하는 테스트 서버 (문서는 id 오름차순)
+type fakeElasticsearch struct {
+	mu      sync.Mutex
+	docs    int
+	pits    map[string]bool
+	nextPIT int
+	closed  []string
+}
+
+func (f *fakeElasticsearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("X-Elastic-Product", "Elasticsearch")
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/_pit") && r.Method == http.MethodPost:
+		f.nextPIT++
+		id := fmt.Sprintf("pit-%d", f.nextPIT)
+		f.pits[id] = true
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": id})
+
+	case r.URL.Path == "/_pit" && r.Method == http.MethodDelete:
+		var req struct {
+			ID string `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		f.closed = append(f.closed, req.ID)
+		delete(f.pits, req.ID)
+		_ = json.NewEncoder(w).Encode(map[string]any{"succeeded": true})
+
+	case r.URL.Path == "/_search":
+		var req struct {
+			Size        int   `json:"size"`
+			SearchAfter []int `json:"search_after"`
+			Pit         struct {
+				ID string `json:"id"`
+			} `json:"pit"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if !f.pits[req.Pit.ID] {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"type":"search_context_missing_exception"}}`))
+			return
+		}
+
+		start := 1
+		if len(req.SearchAfter) > 0 {
+			start = req.SearchAfter[0] + 1
+		}
+		var hits []any
+		for id := start; id <= f.docs && len(hits) < req.Size; id++ {
+			hits = append(hits, map[string]any{
+				"_index":  "items",
+				"_id":     fmt.Sprintf("doc-%d", id),
+				"_source": map[string]any{"id": id, "name": fmt.Sprintf("item %d", id)},
+				"sort":    []any{id},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"pit_id": req.Pit.ID,
+			"hits":   map[string]any{"hits": hits},
+		})
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func readESRecords(t *testing.T, src *ElasticsearchSource) []Record {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := src.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	records, errs := src.Read(ctx)
+	var out []Record
+	for r := range records {
+		out = append(out, r)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	return out
+}
+
+func esDocIDs(records []Record) []string {
+	ids := make([]string, len(records))
+	for i, r := range records {
+		ids[i] = r.Metadata.Offset
+	}
+	return ids
+}
+
+func TestElasticsearchSourceReadAll(t *testing.T) {
+	fake := &fakeElasticsearch{docs: 5, pits: make(map[string]bool)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	src, err := NewElasticsearchSource(config.SourceV2{
+		Addresses: []string{server.URL},
+		Index:     "items",
+		BatchSize: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := readESRecords(t, src)
+	want := []string{"doc-1", "doc-2", "doc-3", "doc-4", "doc-5"}
+	if got := esDocIDs(records); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ids = %v", got)
+	}
+	if records[0].Data["_id"] != "doc-1" || records[0].Data["name"] != "item 1" {
+		t.Errorf("data = %v", records[0].Data)
+	}
+	// 전체를 읽으면 PIT를 닫고 진행 상태를 초기화
+	if !reflect.DeepEqual(fake.closed, []string{"pit-1"}) {
+		t.Errorf("closed pits = %v", fake.closed)
+	}
+	if cp := src.GetCheckpoint(); len(cp) != 0 {
+		t.Errorf("checkpoint after full read = %v", cp)
+	}
+}
+
+func TestElasticsearchSourceResume(t *testing.T) {
+	fake := &fakeElasticsearch{docs: 5, pits: make(map[string]bool)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	newSource := func(sort []string) *ElasticsearchSource {
+		src, err := NewElasticsearchSource(config.SourceV2{
+			Addresses: []string{server.URL},
+			Index:     "items",
+			Sort:      sort,
+			BatchSize: 2,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return src
+	}
+
+	// 고유 필드 정렬: 만료된 PIT 대신 새 PIT에서 마지막 정렬 값 이후부터 읽음
+	src := newSource([]string{"id"})
+	if err := src.SetCheckpoint(map[string]any{"search_after": "[2]", "pit_id": "expired"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := esDocIDs(readESRecords(t, src)); !reflect.DeepEqual(got, []string{"doc-3", "doc-4", "doc-5"}) {
+		t.Fatalf("resumed ids = %v", got)
+	}
+
+	// 체크포인트 형식: search_after는 정밀도 유지를 위해 JSON 문자열
+	src = newSource([]string{"id"})
+	_ = src.SetCheckpoint(map[string]any{"search_after": "[9007199254740993]"})
+	if got := src.GetCheckpoint()["search_after"]; got != "[9007199254740993]" {
+		t.Errorf("search_after = %v", got)
+	}
+
+	// 기본 정렬(_shard_doc)은 PIT 없이 재개할 수 없음
+	src = newSource(nil)
+	_ = src.SetCheckpoint(map[string]any{"search_after": "[2]"})
+	if err := src.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	records, errs := src.Read(context.Background())
+	for range records {
+	}
+	if err := <-errs; err == nil {
+		t.Error("expected error resuming without point in time")
+	}
+}
@@ -2,47 +2,96 @@ package source
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/conduix/conduix/pipeline-core/pkg/codec"
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/shared/types"
 )
 
+// fingerprintSize 파일 식별에 사용하는 선두 바이트 수
+const fingerprintSize = 1024
+
 // FileSource 파일 소스
+// tail 모드에서는 파일 끝에 추가되는 내용을 계속 읽고, 새로 생성된 파일도 감지한다.
+// 파일은 선두 바이트의 fingerprint로 식별하여 로테이션/truncate를 감지한다.
+// 체크포인트는 읽은 위치가 아니라 Ack된 레코드까지의 위치로, 싱크에 기록되기 전의 레코드는 재시작 시 다시 읽는다.
 type FileSource struct {
-	paths     []string
-	format    string // json, csv, lines
-	csvHeader bool   //nolint:unused
+	patterns     []string
+	format       string // json, ndjson, csv, lines, parquet, avro, xml
+	recordPath   string // xml 레코드 요소 경로
+	tail         bool
+	readFrom     string // beginning, end
+	compression  string // auto, gzip, zstd, none
+	pollInterval time.Duration
+
+	// decodeOnly 다른 소스의 디코더로만 사용 (S3 객체): Ack 대기 위치를 추적하지 않음
+	decodeOnly bool
+
+	mu      sync.RWMutex
+	files   map[string]*fileState
+	scanned bool // 최초 스캔 완료 여부 (read_from=end 적용 범위)
+}
+
+// fileState 파일별 읽기 상태
+type fileState struct {
+	path        string
+	offset      int64 // 소비한 바이트 수 (압축 파일은 압축 해제 기준)
+	line        int64 // 소비한 줄 수 (parquet/avro/xml은 레코드 수)
+	fingerprint string
+	headers     []string // csv 헤더
+	done        bool     // 압축 파일/JSON 배열 등 재읽기 불가 파일 완료 여부
+
+	// fingerprintAt 소비 위치까지의 fingerprint 계산 (읽는 동안만 설정)
+	// 읽기 도중의 체크포인트도 복구 시 같은 파일로 인식되도록 advance에서 갱신한다
+	fingerprintAt func(offset int64) string
+
+	// acked 체크포인트로 내보내는 위치 (앞선 레코드가 모두 Ack된 위치)
+	// unacked는 읽었지만 아직 확정되지 않은 위치를 읽은 순서대로 보관한다
+	acked   filePosition
+	unacked []filePosition
+}
+
+// filePosition 레코드 하나(또는 건너뛴 줄)를 소비한 뒤의 읽기 위치
+type filePosition struct {
+	offset      int64
+	line        int64
+	fingerprint string
+
+	origin   string // 레코드 Metadata.Origin (로테이션 전 경로일 수 있음)
+	position string // 레코드 Metadata.Offset (빈 값이면 레코드 없이 건너뛴 위치)
+	pending  bool   // Ack 대기 중
 }
 
 // NewFileSource 파일 소스 생성
 func NewFileSource(cfg config.SourceV2) (*FileSource, error) {
-	var paths []string
+	var patterns []string
 
 	if cfg.Path != "" {
-		paths = append(paths, cfg.Path)
+		patterns = append(patterns, cfg.Path)
 	}
-	paths = append(paths, cfg.Paths...)
+	patterns = append(patterns, cfg.Paths...)
 
-	// Glob 패턴 확장
-	var expandedPaths []string
-	for _, p := range paths {
-		matches, err := filepath.Glob(p)
-		if err != nil {
+	// Glob 패턴 검증 (확장은 읽기 시점마다 수행)
+	for _, p := range patterns {
+		if _, err := filepath.Glob(p); err != nil {
 			return nil, fmt.Errorf("invalid glob pattern %s: %w", p, err)
 		}
-		if len(matches) == 0 {
-			// 패턴이 아닌 경우 그대로 사용
-			expandedPaths = append(expandedPaths, p)
-		} else {
-			expandedPaths = append(expandedPaths, matches...)
-		}
 	}
 
 	format := cfg.Format
@@ -50,9 +99,30 @@ func NewFileSource(cfg config.SourceV2) (*FileSource, error) {
 		format = "json"
 	}
 
+	readFrom := cfg.ReadFrom
+	if readFrom == "" {
+		readFrom = "beginning"
+	}
+
+	compression := cfg.Compression
+	if compression == "" {
+		compression = "auto"
+	}
+
+	pollInterval := time.Duration(cfg.PollInterval) * time.Millisecond
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+
 	return &FileSource{
-		paths:  expandedPaths,
-		format: format,
+		patterns:     patterns,
+		format:       format,
+		recordPath:   cfg.RecordPath,
+		tail:         cfg.Tail,
+		readFrom:     readFrom,
+		compression:  compression,
+		pollInterval: pollInterval,
+		files:        make(map[string]*fileState),
 	}, nil
 }
 
@@ -61,8 +131,16 @@ func (s *FileSource) Name() string {
 }
 
 func (s *FileSource) Open(ctx context.Context) error {
-	// 파일 존재 여부 확인
-	for _, path := range s.paths {
+	// tail 모드는 파일이 나중에 생성될 수 있으므로 존재 여부를 확인하지 않음
+	if s.tail {
+		return nil
+	}
+
+	// Glob이 아닌 경로의 파일 존재 여부 확인
+	for _, path := range s.patterns {
+		if isGlobPattern(path) {
+			continue
+		}
 		if _, err := os.Stat(path); os.IsNotExist(err) {
 			return fmt.Errorf("file not found: %s", path)
 		}
@@ -78,176 +156,718 @@ func (s *FileSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
 		defer close(records)
 		defer close(errs)
 
-		for _, path := range s.paths {
-			select {
-			case <-ctx.Done():
+		ticker := time.NewTicker(s.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.discover(); err != nil {
+				errs <- err
 				return
-			default:
 			}
 
-			if err := s.readFile(ctx, path, records); err != nil {
-				errs <- fmt.Errorf("error reading %s: %w", path, err)
+			for _, st := range s.activeFiles() {
+				if err := s.readFile(ctx, st, records); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					errs <- fmt.Errorf("error reading %s: %w", st.path, err)
+					return
+				}
+			}
+
+			if !s.tail {
 				return
 			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
 		}
 	}()
 
 	return records, errs
 }
 
-func (s *FileSource) readFile(ctx context.Context, path string, records chan<- Record) error {
-	file, err := os.Open(path)
+// discover Glob 패턴을 확장하여 새 파일을 등록하고 사라진 파일을 정리
+func (s *FileSource) discover() error {
+	present := make(map[string]bool)
+	var paths []string
+	for _, p := range s.patterns {
+		matches, err := filepath.Glob(p)
+		if err != nil {
+			return fmt.Errorf("invalid glob pattern %s: %w", p, err)
+		}
+		if len(matches) == 0 && !isGlobPattern(p) {
+			matches = []string{p}
+		}
+		for _, m := range matches {
+			if present[m] {
+				continue
+			}
+			if info, err := os.Stat(m); err != nil || info.IsDir() {
+				continue
+			}
+			present[m] = true
+			paths = append(paths, m)
+		}
+	}
+	sort.Strings(paths)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, path := range paths {
+		if _, ok := s.files[path]; ok {
+			continue
+		}
+
+		st := &fileState{path: path}
+		// 로테이션으로 이름이 바뀐 파일이면 기존 상태를 이어받음
+		if !s.adoptRotated(st, present) && s.tail && !s.scanned && s.readFrom == "end" {
+			s.skipToEnd(st)
+		}
+		s.files[path] = st
+	}
+
+	for path := range s.files {
+		if !present[path] {
+			delete(s.files, path)
+		}
+	}
+
+	s.scanned = true
+	return nil
+}
+
+// adoptRotated 새 경로의 파일이 기존에 추적하던 파일(로테이션/이동됨)과 같으면 상태를 이어받음
+func (s *FileSource) adoptRotated(st *fileState, present map[string]bool) bool {
+	for _, old := range s.files {
+		if old.offset == 0 || old.fingerprint == "" {
+			continue
+		}
+		// 기존 경로에 같은 파일이 남아 있으면 로테이션이 아님
+		if present[old.path] && fingerprintFile(old.path, old.offset) == old.fingerprint {
+			continue
+		}
+		if fingerprintFile(st.path, old.offset) != old.fingerprint {
+			continue
+		}
+
+		st.offset, st.line, st.fingerprint, st.headers, st.done = old.offset, old.line, old.fingerprint, old.headers, old.done
+		st.acked, st.unacked = old.acked, old.unacked
+		old.reset()
+		return true
+	}
+	return false
+}
+
+func (s *FileSource) activeFiles() []*fileState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	files := make([]*fileState, 0, len(s.files))
+	for _, st := range s.files {
+		files = append(files, st)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+	return files
+}
+
+func (s *FileSource) readFile(ctx context.Context, st *fileState, records chan<- Record) error {
+	file, err := os.Open(st.path)
 	if err != nil {
+		if os.IsNotExist(err) {
+			return nil // 읽기 전에 삭제/로테이션됨
+		}
 		return err
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	compression := s.detectCompression(file)
+	compressed := compression != codec.CompressionNone
+
+	if codec.IsRecordFormat(s.format) {
+		return s.readRecordFile(ctx, file, info.Size(), st, compression, records)
+	}
+
+	s.mu.Lock()
+	// truncate 또는 다른 파일로 교체된 경우 처음부터 다시 읽기
+	if st.offset > 0 && ((!compressed && info.Size() < st.offset) || fingerprintReaderAt(file, info.Size(), st.offset) != st.fingerprint) {
+		st.reset()
+	}
+	done := st.done || (!compressed && info.Size() == st.offset)
+	size := info.Size()
+	st.fingerprintAt = func(offset int64) string {
+		if !compressed {
+			return fingerprintReaderAt(file, offset, offset) // 소비한 바이트는 항상 파일 안에 있음
+		}
+		return fingerprintReaderAt(file, size, offset)
+	}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		st.fingerprintAt = nil
+		s.mu.Unlock()
+	}()
+
+	if done {
+		return nil
+	}
+
+	reader, closeReader, err := s.openAt(file, compression, st.offset)
+	if err != nil {
+		return err
+	}
+	defer closeReader()
+
 	switch s.format {
 	case "json":
-		return s.readJSON(ctx, file, path, records)
+		array, err := s.isJSONArray(file, compression)
+		if err != nil {
+			return err
+		}
+		if array {
+			// JSON 배열은 부분 재개를 지원하지 않으므로 한 번에 읽음
+			if st.offset > 0 {
+				return nil
+			}
+			err = s.readJSONArray(ctx, reader, st, records)
+		} else {
+			err = s.readLines(ctx, reader, st, compressed, records, s.parseJSONLine)
+		}
+		if err != nil {
+			return err
+		}
+	case codec.FormatNDJSON:
+		if err := s.readLines(ctx, reader, st, compressed, records, s.parseJSONLine); err != nil {
+			return err
+		}
 	case "csv":
-		return s.readCSV(ctx, file, path, records)
+		if err := s.readCSV(ctx, file, reader, st, compression, records); err != nil {
+			return err
+		}
 	case "lines":
-		return s.readLines(ctx, file, path, records)
+		if err := s.readLines(ctx, reader, st, compressed, records, s.parseTextLine); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unsupported format: %s", s.format)
 	}
+
+	s.mu.Lock()
+	if compressed {
+		// 압축 파일은 추가 쓰기를 따라가지 않음
+		st.done = true
+	}
+	// 읽는 동안 파일이 커졌을 수 있으므로 크기를 다시 확인
+	if latest, err := file.Stat(); err == nil {
+		info = latest
+	}
+	st.fingerprint = fingerprintReaderAt(file, info.Size(), st.offset)
+	s.mu.Unlock()
+
+	return nil
 }
 
-func (s *FileSource) readJSON(ctx context.Context, file *os.File, path string, records chan<- Record) error {
-	decoder := json.NewDecoder(file)
+// readRecordFile parquet/avro/xml 파일 읽기
+// 바이트 단위 재개가 불가능하므로 처리한 레코드 수(LineNumber)로 재개하고,
+// 파일을 모두 읽으면 ByteOffset을 파일 크기로 설정한다
+func (s *FileSource) readRecordFile(ctx context.Context, file *os.File, size int64, st *fileState, compression string, records chan<- Record) error {
+	fingerprint := fingerprintReaderAt(file, size, size)
 
-	// 배열인지 확인
-	token, err := decoder.Token()
+	s.mu.Lock()
+	if st.fingerprint != "" && st.fingerprint != fingerprint {
+		st.reset()
+	}
+	st.fingerprint = fingerprint
+	done := st.offset > 0 && st.offset == size
+	skip := st.line
+	s.mu.Unlock()
+
+	if done {
+		return nil
+	}
+
+	var r io.Reader = io.NewSectionReader(file, 0, size)
+	if compression != codec.CompressionNone {
+		rc, err := codec.NewDecompressor(r, compression)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+		r = rc
+	}
+
+	decoder, err := codec.NewDecoder(s.format, r, codec.Options{RecordPath: s.recordPath})
 	if err != nil {
 		return err
 	}
 
-	if delim, ok := token.(json.Delim); ok && delim == '[' {
-		// JSON 배열
-		for decoder.More() {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
-			}
-
-			var data map[string]any
-			if err := decoder.Decode(&data); err != nil {
-				return err
-			}
+	var index int64
+	for {
+		data, err := decoder.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
 
-			records <- Record{
-				Data: data,
-				Metadata: Metadata{
-					Source:    "file",
-					Origin:    path,
-					Timestamp: time.Now().UnixMilli(),
-				},
-			}
+		index++
+		if index <= skip {
+			continue
+		}
+		if err := s.emit(ctx, records, st, data, 0, index); err != nil {
+			return err
 		}
+	}
+
+	s.advance(st, size, index, "")
+	return nil
+}
+
+// detectCompression 압축 설정 또는 매직 바이트로 압축 방식 판단
+func (s *FileSource) detectCompression(file *os.File) string {
+	if s.compression != "auto" {
+		return s.compression
+	}
+	header := make([]byte, 4)
+	n, _ := file.ReadAt(header, 0)
+	return codec.DetectCompression(header[:n])
+}
+
+// skipToEnd 기존 내용을 건너뛰고 이후 추가분부터 읽도록 설정 (read_from=end)
+func (s *FileSource) skipToEnd(st *fileState) {
+	file, err := os.Open(st.path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return
+	}
+	if codec.IsRecordFormat(s.format) {
+		st.fingerprint = fingerprintReaderAt(file, info.Size(), info.Size())
+	} else if s.detectCompression(file) != codec.CompressionNone {
+		return
 	} else {
-		// NDJSON (줄별 JSON)
-		_, _ = file.Seek(0, 0)
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			default:
-			}
+		st.fingerprint = fingerprintReaderAt(file, info.Size(), info.Size())
+	}
+	st.offset = info.Size()
+	st.acked = filePosition{offset: st.offset, line: st.line, fingerprint: st.fingerprint}
+}
 
-			var data map[string]any
-			if err := json.Unmarshal(scanner.Bytes(), &data); err != nil {
-				continue // 잘못된 줄 스킵
-			}
+// openAt offset 위치부터 읽는 reader 생성
+// 파일 위치를 공유하지 않도록 SectionReader를 사용한다
+func (s *FileSource) openAt(file *os.File, compression string, offset int64) (*bufio.Reader, func(), error) {
+	if compression == codec.CompressionNone {
+		return bufio.NewReader(io.NewSectionReader(file, offset, 1<<62)), func() {}, nil
+	}
 
-			records <- Record{
-				Data: data,
-				Metadata: Metadata{
-					Source:    "file",
-					Origin:    path,
-					Timestamp: time.Now().UnixMilli(),
-				},
-			}
+	rc, err := codec.NewDecompressor(io.NewSectionReader(file, 0, 1<<62), compression)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.CopyN(io.Discard, rc, offset); err != nil && err != io.EOF {
+		rc.Close()
+		return nil, nil, err
+	}
+	return bufio.NewReader(rc), func() { rc.Close() }, nil
+}
+
+// isJSONArray 파일 내용이 JSON 배열로 시작하는지 확인
+func (s *FileSource) isJSONArray(file *os.File, compression string) (bool, error) {
+	reader, closeReader, err := s.openAt(file, compression, 0)
+	if err != nil {
+		return false, err
+	}
+	defer closeReader()
+
+	for {
+		b, err := reader.ReadByte()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b == '[', nil
+	}
+}
+
+func (s *FileSource) readJSONArray(ctx context.Context, reader io.Reader, st *fileState, records chan<- Record) error {
+	decoder := json.NewDecoder(reader)
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		var data map[string]any
+		if err := decoder.Decode(&data); err != nil {
+			return err
+		}
+
+		if err := s.emit(ctx, records, st, data, decoder.InputOffset(), 0); err != nil {
+			return err
 		}
 	}
 
+	// 닫는 괄호까지 소비
+	_, _ = decoder.Token()
+	s.advance(st, decoder.InputOffset(), 0, "")
+	s.mu.Lock()
+	st.done = true
+	s.mu.Unlock()
 	return nil
 }
 
-func (s *FileSource) readCSV(ctx context.Context, file *os.File, path string, records chan<- Record) error {
-	reader := csv.NewReader(file)
+// lineParser 한 줄을 레코드 데이터로 변환 (nil이면 스킵)
+type lineParser func(line []byte, lineNo int64) map[string]any
 
-	// 헤더 읽기
-	headers, err := reader.Read()
-	if err != nil {
-		return err
+func (s *FileSource) parseJSONLine(line []byte, _ int64) map[string]any {
+	var data map[string]any
+	if err := json.Unmarshal(line, &data); err != nil {
+		return nil // 잘못된 줄 스킵
 	}
+	return data
+}
+
+func (s *FileSource) parseTextLine(line []byte, lineNo int64) map[string]any {
+	return map[string]any{
+		"line":    string(line),
+		"line_no": int(lineNo),
+	}
+}
+
+// readLines 줄 단위로 읽기
+// tail 모드에서는 개행으로 끝나지 않은 마지막 줄은 완성될 때까지 소비하지 않음
+func (s *FileSource) readLines(ctx context.Context, reader *bufio.Reader, st *fileState, compressed bool, records chan<- Record, parse lineParser) error {
+	offset, lineNo := st.offset, st.line
 
 	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if len(line) == 0 {
+			return nil
+		}
+		if err == io.EOF && s.tail && !compressed {
+			return nil
+		}
+
+		offset += int64(len(line))
+		lineNo++
+
+		text := bytes.TrimRight(line, "\r\n")
+		if data := parse(text, lineNo); data != nil {
+			if err := s.emit(ctx, records, st, data, offset, lineNo); err != nil {
+				return err
+			}
+		} else {
+			s.advance(st, offset, lineNo, "")
 		}
 
-		row, err := reader.Read()
 		if err == io.EOF {
-			break
+			return nil
 		}
+	}
+}
+
+// readCSV CSV 읽기 (첫 행은 헤더)
+// 따옴표 안의 개행을 지원하기 위해 레코드가 완성될 때까지 줄을 누적한다
+func (s *FileSource) readCSV(ctx context.Context, file *os.File, reader *bufio.Reader, st *fileState, compression string, records chan<- Record) error {
+	compressed := compression != codec.CompressionNone
+	if st.headers == nil && st.offset > 0 {
+		// 중간부터 재개하는 경우 파일 처음에서 헤더를 읽음
+		headerReader, closeReader, err := s.openAt(file, compression, 0)
 		if err != nil {
 			return err
 		}
+		headers, err := csv.NewReader(headerReader).Read()
+		closeReader()
+		if err != nil {
+			return fmt.Errorf("failed to read csv header: %w", err)
+		}
+		st.headers = headers
+	}
 
-		data := make(map[string]any)
-		for i, header := range headers {
-			if i < len(row) {
-				data[header] = row[i]
+	offset, lineNo := st.offset, st.line
+	var pending []byte
+	var pendingLines int64
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if len(line) == 0 {
+			return nil
+		}
+		if err == io.EOF && s.tail && !compressed {
+			return nil
+		}
+
+		pending = append(pending, line...)
+		pendingLines++
+
+		row, parseErr := csv.NewReader(bytes.NewReader(pending)).Read()
+		if parseErr != nil && errors.Is(parseErr, csv.ErrQuote) && err != io.EOF {
+			continue // 따옴표 안의 개행: 다음 줄과 합침
+		}
+
+		offset += int64(len(pending))
+		lineNo += pendingLines
+		pending, pendingLines = nil, 0
+
+		switch {
+		case parseErr == io.EOF:
+			s.advance(st, offset, lineNo, "") // 빈 줄
+		case parseErr != nil:
+			return parseErr
+		case st.headers == nil:
+			st.headers = row
+			s.advance(st, offset, lineNo, "")
+		default:
+			data := make(map[string]any)
+			for i, header := range st.headers {
+				if i < len(row) {
+					data[header] = row[i]
+				}
+			}
+			if err := s.emit(ctx, records, st, data, offset, lineNo); err != nil {
+				return err
 			}
 		}
 
-		records <- Record{
-			Data: data,
-			Metadata: Metadata{
-				Source:    "file",
-				Origin:    path,
-				Timestamp: time.Now().UnixMilli(),
-			},
+		if err == io.EOF {
+			return nil
 		}
 	}
+}
 
+// emit 오프셋을 갱신하고 레코드 전송
+// Ack가 전송 직후에 올 수 있으므로 Ack 대기 위치를 먼저 등록한다
+func (s *FileSource) emit(ctx context.Context, records chan<- Record, st *fileState, data map[string]any, offset, lineNo int64) error {
+	position := offset
+	if codec.IsRecordFormat(s.format) {
+		position = lineNo
+	}
+
+	record := Record{
+		Data: data,
+		Metadata: Metadata{
+			Source:    "file",
+			Origin:    st.path,
+			Offset:    strconv.FormatInt(position, 10),
+			Timestamp: time.Now().UnixMilli(),
+		},
+	}
+
+	s.advance(st, offset, lineNo, record.Metadata.Offset)
+
+	select {
+	case records <- record:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 	return nil
 }
 
-func (s *FileSource) readLines(ctx context.Context, file *os.File, path string, records chan<- Record) error {
-	scanner := bufio.NewScanner(file)
-	lineNum := 0
+// advance 읽은 위치 갱신
+// position이 있으면 레코드를 내보낸 위치로 Ack될 때까지 체크포인트에 반영하지 않고,
+// 없으면(건너뛴 줄, 헤더 등) 앞선 레코드가 모두 Ack되는 대로 반영한다
+func (s *FileSource) advance(st *fileState, offset, lineNo int64, position string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	for scanner.Scan() {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
+	// fingerprint는 선두 fingerprintSize 바이트까지만 읽은 범위에 따라 달라짐
+	if st.fingerprintAt != nil && (st.fingerprint == "" || st.offset < fingerprintSize) {
+		st.fingerprint = st.fingerprintAt(offset)
+	}
+	st.offset = offset
+	if lineNo > 0 {
+		st.line = lineNo
+	}
+
+	if s.decodeOnly {
+		return
+	}
+	st.unacked = append(st.unacked, filePosition{
+		offset:      st.offset,
+		line:        st.line,
+		fingerprint: st.fingerprint,
+		origin:      st.path,
+		position:    position,
+		pending:     position != "",
+	})
+	st.settle()
+}
+
+// settle 앞에서부터 Ack가 끝난 위치를 체크포인트 위치로 반영
+func (st *fileState) settle() {
+	n := 0
+	for n < len(st.unacked) && !st.unacked[n].pending {
+		st.acked = st.unacked[n]
+		n++
+	}
+	if n > 0 {
+		st.unacked = append(st.unacked[:0], st.unacked[n:]...)
+	}
+}
+
+// ack Origin과 Offset이 같은 Ack 대기 위치를 확정
+func (st *fileState) ack(origin, position string) bool {
+	for i := range st.unacked {
+		p := &st.unacked[i]
+		if p.pending && p.origin == origin && p.position == position {
+			p.pending = false
+			return true
 		}
+	}
+	return false
+}
+
+func (st *fileState) reset() {
+	st.offset = 0
+	st.line = 0
+	st.fingerprint = ""
+	st.headers = nil
+	st.done = false
+	st.acked = filePosition{}
+	st.unacked = nil
+}
 
-		lineNum++
-		records <- Record{
-			Data: map[string]any{
-				"line":    scanner.Text(),
-				"line_no": lineNum,
-			},
-			Metadata: Metadata{
-				Source:    "file",
-				Origin:    path,
-				Timestamp: time.Now().UnixMilli(),
-			},
+// Ack 싱크 기록이 확정된 레코드를 반영 (앞선 레코드가 모두 Ack된 위치까지 체크포인트가 전진)
+func (s *FileSource) Ack(ctx context.Context, records []Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range records {
+		if r.Metadata.Source != "file" {
+			continue
+		}
+		for _, st := range s.files {
+			if st.ack(r.Metadata.Origin, r.Metadata.Offset) {
+				break
+			}
 		}
 	}
+	for _, st := range s.files {
+		st.settle()
+	}
+	return nil
+}
 
-	return scanner.Err()
+// AckInOrder 앞선 레코드가 모두 Ack된 위치까지만 체크포인트에 반영하므로 OrderedAcker
+func (s *FileSource) AckInOrder() {}
+
+// GetCheckpoint 현재 체크포인트(파일별로 Ack된 레코드까지의 오프셋) 반환
+func (s *FileSource) GetCheckpoint() map[string]any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	offsets := make([]types.FileOffset, 0, len(s.files))
+	for _, st := range s.files {
+		offsets = append(offsets, types.FileOffset{
+			Path:        st.path,
+			ByteOffset:  st.acked.offset,
+			LineNumber:  st.acked.line,
+			Fingerprint: st.acked.fingerprint,
+		})
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i].Path < offsets[j].Path })
+
+	return map[string]any{
+		"files": offsets,
+	}
+}
+
+// SetCheckpoint 체크포인트 설정 (복구용)
+// fingerprint가 현재 파일과 다르면 읽기 시점에 처음부터 다시 읽는다
+func (s *FileSource) SetCheckpoint(checkpoint map[string]any) error {
+	var offsets []types.FileOffset
+	switch v := checkpoint["files"].(type) {
+	case nil:
+		return nil
+	case []types.FileOffset:
+		offsets = v
+	default:
+		// JSON 역직렬화된 값
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("invalid file checkpoint: %w", err)
+		}
+		if err := json.Unmarshal(data, &offsets); err != nil {
+			return fmt.Errorf("invalid file checkpoint: %w", err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.files = make(map[string]*fileState, len(offsets))
+	for _, fo := range offsets {
+		s.files[fo.Path] = &fileState{
+			path:        fo.Path,
+			offset:      fo.ByteOffset,
+			line:        fo.LineNumber,
+			fingerprint: fo.Fingerprint,
+			acked:       filePosition{offset: fo.ByteOffset, line: fo.LineNumber, fingerprint: fo.Fingerprint},
+		}
+	}
+	s.scanned = true // 복구 시에는 read_from=end를 적용하지 않음
+
+	return nil
 }
 
 func (s *FileSource) Close() error {
 	return nil
 }
+
+// fingerprintFile 파일 선두 바이트의 fingerprint 계산
+func fingerprintFile(path string, offset int64) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return ""
+	}
+	return fingerprintReaderAt(file, info.Size(), offset)
+}
+
+// fingerprintReaderAt 선두 min(offset, fingerprintSize, size) 바이트의 SHA-256
+// 읽은 범위만으로 계산하므로 같은 파일에 내용이 추가되어도 값이 유지된다
+func fingerprintReaderAt(r io.ReaderAt, size, offset int64) string {
+	n := min(offset, fingerprintSize, size)
+	if n <= 0 {
+		return ""
+	}
+
+	buf := make([]byte, n)
+	if _, err := r.ReadAt(buf, 0); err != nil && err != io.EOF {
+		return ""
+	}
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:])
+}
+
+func isGlobPattern(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
@@ -0,0 +1,338 @@
+package source
+
+import (
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/codec"
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/shared/types"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+}
+
+func appendTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", path, err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(content); err != nil {
+		t.Fatalf("failed to append %s: %v", path, err)
+	}
+}
+
+// readAll 배치 모드로 모든 레코드를 읽고 Ack (체크포인트가 끝까지 전진)
+func readAll(t *testing.T, src *FileSource) []Record {
+	t.Helper()
+	ctx := context.Background()
+	if err := src.Open(ctx); err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+
+	records, errs := src.Read(ctx)
+	var result []Record
+	for r := range records {
+		result = append(result, r)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("read failed: %v", err)
+	}
+	if err := src.Ack(ctx, result); err != nil {
+		t.Fatalf("ack failed: %v", err)
+	}
+	return result
+}
+
+// collect tail 모드에서 n개의 레코드를 기다림
+func collect(t *testing.T, records <-chan Record, n int) []Record {
+	t.Helper()
+	var result []Record
+	timeout := time.After(3 * time.Second)
+	for len(result) < n {
+		select {
+		case r, ok := <-records:
+			if !ok {
+				t.Fatalf("records channel closed after %d records", len(result))
+			}
+			result = append(result, r)
+		case <-timeout:
+			t.Fatalf("timed out waiting for records: got %d, want %d", len(result), n)
+		}
+	}
+	return result
+}
+
+func checkpointOffsets(t *testing.T, src *FileSource) []types.FileOffset {
+	t.Helper()
+	offsets, ok := src.GetCheckpoint()["files"].([]types.FileOffset)
+	if !ok {
+		t.Fatalf("unexpected checkpoint: %+v", src.GetCheckpoint())
+	}
+	return offsets
+}
+
+func TestFileSourceFormats(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		format  string
+		content string
+		field   string
+		want    []any
+	}{
+		{"ndjson", "json", "{\"id\":1}\n\n{\"id\":2}\n", "id", []any{float64(1), float64(2)}},
+		{"json array", "json", "[{\"id\":1},{\"id\":2}]", "id", []any{float64(1), float64(2)}},
+		{"csv", "csv", "id,note\n1,\"multi\nline\"\n2,plain\n", "note", []any{"multi\nline", "plain"}},
+		{"lines", "lines", "first\nsecond", "line", []any{"first", "second"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			writeTestFile(t, path, tt.content)
+
+			src, _ := NewFileSource(config.SourceV2{Path: path, Format: tt.format})
+			records := readAll(t, src)
+			if len(records) != len(tt.want) {
+				t.Fatalf("record count mismatch: got %d, want %d", len(records), len(tt.want))
+			}
+			for i, r := range records {
+				if r.Data[tt.field] != tt.want[i] {
+					t.Errorf("record %d mismatch: got %v, want %v", i, r.Data[tt.field], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFileSourceGzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json.gz")
+	f, _ := os.Create(path)
+	gz := gzip.NewWriter(f)
+	_, _ = gz.Write([]byte("{\"id\":1}\n{\"id\":2}\n"))
+	_ = gz.Close()
+	_ = f.Close()
+
+	src, _ := NewFileSource(config.SourceV2{Path: path})
+	if records := readAll(t, src); len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+
+	// 압축 해제 기준 오프셋으로 재개
+	resumed, _ := NewFileSource(config.SourceV2{Path: path})
+	_ = resumed.SetCheckpoint(map[string]any{"files": checkpointOffsets(t, src)})
+	if records := readAll(t, resumed); len(records) != 0 {
+		t.Errorf("expected no records after resume, got %d", len(records))
+	}
+}
+
+func TestFileSourceRecordFormats(t *testing.T) {
+	dir := t.TempDir()
+	input := []map[string]any{{"id": int64(1)}, {"id": int64(2)}, {"id": int64(3)}}
+
+	for _, format := range []string{codec.FormatParquet, codec.FormatAvro} {
+		t.Run(format, func(t *testing.T) {
+			path := filepath.Join(dir, "data."+format)
+			f, _ := os.Create(path)
+			enc, _ := codec.NewEncoder(format, f, codec.Options{})
+			for _, r := range input {
+				_ = enc.Encode(r)
+			}
+			_ = enc.Close()
+			_ = f.Close()
+
+			src, _ := NewFileSource(config.SourceV2{Path: path, Format: format})
+			records := readAll(t, src)
+			if len(records) != 3 || records[2].Data["id"] != int64(3) || records[2].Metadata.Offset != "3" {
+				t.Fatalf("records mismatch: %+v", records)
+			}
+
+			// 레코드 수 기준으로 재개
+			offsets := checkpointOffsets(t, src)
+			offsets[0].ByteOffset = 0
+			offsets[0].LineNumber = 2
+			resumed, _ := NewFileSource(config.SourceV2{Path: path, Format: format})
+			_ = resumed.SetCheckpoint(map[string]any{"files": offsets})
+			if records := readAll(t, resumed); len(records) != 1 || records[0].Data["id"] != int64(3) {
+				t.Errorf("expected only last record after resume, got %+v", records)
+			}
+
+			// 완료된 파일은 다시 읽지 않음
+			done, _ := NewFileSource(config.SourceV2{Path: path, Format: format})
+			_ = done.SetCheckpoint(map[string]any{"files": checkpointOffsets(t, src)})
+			if records := readAll(t, done); len(records) != 0 {
+				t.Errorf("expected no records for completed file, got %d", len(records))
+			}
+		})
+	}
+}
+
+func TestFileSourceXMLZstd(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "books.xml.zst")
+	f, _ := os.Create(path)
+	zw, _ := codec.NewCompressor(f, codec.CompressionZstd)
+	_, _ = zw.Write([]byte("<catalog><book id=\"1\"><title>Go</title></book><book id=\"2\"/></catalog>"))
+	_ = zw.Close()
+	_ = f.Close()
+
+	src, _ := NewFileSource(config.SourceV2{Path: path, Format: "xml", RecordPath: "catalog/book"})
+	records := readAll(t, src)
+	if len(records) != 2 || records[0].Data["title"] != "Go" || records[1].Data["@id"] != "2" {
+		t.Fatalf("records mismatch: %+v", records)
+	}
+}
+
+func TestFileSourceResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.csv")
+	writeTestFile(t, path, "id,name\n1,a\n2,b\n")
+
+	src, _ := NewFileSource(config.SourceV2{Path: path, Format: "csv"})
+	readAll(t, src)
+
+	offsets := checkpointOffsets(t, src)
+	if len(offsets) != 1 || offsets[0].LineNumber != 3 || offsets[0].Fingerprint == "" {
+		t.Fatalf("checkpoint mismatch: %+v", offsets)
+	}
+
+	appendTestFile(t, path, "3,c\n")
+
+	// JSON 왕복 후에도 복구 가능해야 함
+	resumed, _ := NewFileSource(config.SourceV2{Path: path, Format: "csv"})
+	if err := resumed.SetCheckpoint(map[string]any{"files": []any{map[string]any{
+		"path":        offsets[0].Path,
+		"byte_offset": float64(offsets[0].ByteOffset),
+		"line_number": float64(offsets[0].LineNumber),
+		"fingerprint": offsets[0].Fingerprint,
+	}}}); err != nil {
+		t.Fatalf("SetCheckpoint failed: %v", err)
+	}
+
+	records := readAll(t, resumed)
+	if len(records) != 1 || records[0].Data["name"] != "c" {
+		t.Fatalf("expected only appended record, got %+v", records)
+	}
+
+	// 다른 내용으로 교체되면 처음부터 다시 읽음
+	writeTestFile(t, path, "id,name\n9,z\n8,y\n7,x\n6,w\n")
+	replaced, _ := NewFileSource(config.SourceV2{Path: path, Format: "csv"})
+	_ = replaced.SetCheckpoint(map[string]any{"files": offsets})
+	if records := readAll(t, replaced); len(records) != 4 {
+		t.Errorf("expected 4 records after replacement, got %d", len(records))
+	}
+}
+
+func TestFileSourceResumeMidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	writeTestFile(t, path, "{\"id\":1}\n{\"id\":2}\n{\"id\":3}\n")
+
+	src, _ := NewFileSource(config.SourceV2{Path: path, Format: "ndjson"})
+	if err := src.discover(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 버퍼 없는 채널로 두 번째 레코드까지만 받고 중단 (파일 읽기가 끝나기 전의 체크포인트)
+	ctx, cancel := context.WithCancel(context.Background())
+	records := make(chan Record)
+	done := make(chan error, 1)
+	go func() { done <- src.readFile(ctx, src.activeFiles()[0], records) }()
+	first, second := <-records, <-records
+	cancel()
+	if err := <-done; err == nil {
+		t.Fatal("expected read to stop before the end of the file")
+	}
+
+	// 두 번째 레코드만 Ack되면 첫 레코드가 확정되지 않았으므로 체크포인트는 그대로
+	_ = src.Ack(ctx, []Record{second})
+	if offsets := checkpointOffsets(t, src); len(offsets) != 1 || offsets[0].LineNumber != 0 || offsets[0].ByteOffset != 0 {
+		t.Fatalf("checkpoint advanced past an unacked record: %+v", offsets)
+	}
+	_ = src.Ack(ctx, []Record{first})
+
+	offsets := checkpointOffsets(t, src)
+	if len(offsets) != 1 || offsets[0].LineNumber != 2 || offsets[0].Fingerprint == "" {
+		t.Fatalf("mid-file checkpoint mismatch: %+v", offsets)
+	}
+
+	resumed, _ := NewFileSource(config.SourceV2{Path: path, Format: "ndjson"})
+	if err := resumed.SetCheckpoint(map[string]any{"files": offsets}); err != nil {
+		t.Fatal(err)
+	}
+	got := readAll(t, resumed)
+	if len(got) != 1 || got[0].Data["id"] != float64(3) {
+		t.Fatalf("expected only the unread record after resume, got %+v", got)
+	}
+}
+
+func TestFileSourceTail(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	writeTestFile(t, path, "old\n")
+
+	src, _ := NewFileSource(config.SourceV2{
+		Path:         filepath.Join(dir, "*.log*"),
+		Format:       "lines",
+		Tail:         true,
+		ReadFrom:     "end",
+		PollInterval: 10,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = src.Open(ctx)
+	records, _ := src.Read(ctx)
+
+	// 기존 내용은 건너뛰고 완성된 줄만 읽음
+	time.Sleep(50 * time.Millisecond)
+	appendTestFile(t, path, "one\npart")
+	got := collect(t, records, 1)
+	if got[0].Data["line"] != "one" {
+		t.Errorf("expected 'one', got %v", got[0].Data["line"])
+	}
+	appendTestFile(t, path, "ial\n")
+	if got := collect(t, records, 1); got[0].Data["line"] != "partial" {
+		t.Errorf("expected 'partial', got %v", got[0].Data["line"])
+	}
+
+	// 로테이션: 이름 변경된 파일은 이어서 읽고 새 파일은 처음부터 읽음
+	appendTestFile(t, path, "before-rotate\n")
+	collect(t, records, 1)
+	if err := os.Rename(path, path+".1"); err != nil {
+		t.Fatal(err)
+	}
+	appendTestFile(t, path+".1", "late\n")
+	writeTestFile(t, path, "fresh\n")
+
+	lines := map[any]bool{}
+	for _, r := range collect(t, records, 2) {
+		lines[r.Data["line"]] = true
+	}
+	if !lines["late"] || !lines["fresh"] {
+		t.Errorf("rotation lines mismatch: %v", lines)
+	}
+
+	// truncate: 처음부터 다시 읽음
+	writeTestFile(t, path, "")
+	time.Sleep(50 * time.Millisecond)
+	appendTestFile(t, path, "after-truncate\n")
+	if got := collect(t, records, 1); got[0].Data["line"] != "after-truncate" {
+		t.Errorf("expected 'after-truncate', got %v", got[0].Data["line"])
+	}
+
+	// 새로 생성된 파일 감지
+	writeTestFile(t, filepath.Join(dir, "other.log"), "new-file\n")
+	if got := collect(t, records, 1); got[0].Data["line"] != "new-file" {
+		t.Errorf("expected 'new-file', got %v", got[0].Data["line"])
+	}
+}
@@ -0,0 +1,346 @@
+package source
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp/syntax"
+	"strings"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+)
+
+// faker 시드 기반 테스트 데이터 값 생성기
+// 같은 시드와 같은 호출 순서이면 같은 값을 만든다 (시각 값은 base 기준)
+type faker struct {
+	rng  *rand.Rand
+	base time.Time // 시각 값 기준 (최근 30일 안에서 생성)
+}
+
+var (
+	fakeFirstNames = []string{"James", "Mary", "Minjun", "Seoyeon", "Liam", "Emma", "Noah", "Olivia", "Jiho", "Hana", "Lucas", "Mia"}
+	fakeLastNames  = []string{"Kim", "Lee", "Park", "Smith", "Johnson", "Brown", "Garcia", "Choi", "Jung", "Miller", "Davis", "Wilson"}
+	fakeCities     = []string{"Seoul", "Busan", "Tokyo", "New York", "London", "Berlin", "Paris", "Sydney", "Toronto", "Singapore"}
+	fakeCountries  = []string{"KR", "JP", "US", "GB", "DE", "FR", "AU", "CA", "SG", "IN"}
+	fakeCompanies  = []string{"Acme", "Globex", "Initech", "Umbrella", "Stark Industries", "Wayne Enterprises", "Hooli", "Vandelay"}
+	fakeDomains    = []string{"example.com", "example.org", "example.net", "test.io"}
+	fakeWords      = []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel", "india", "juliet", "kilo", "lima", "metro", "nova", "orbit", "pixel"}
+)
+
+// fakeKinds Fake가 지원하는 값 종류
+var fakeKinds = map[string]bool{
+	"name": true, "first_name": true, "last_name": true, "email": true, "username": true,
+	"phone": true, "city": true, "country": true, "company": true, "url": true, "ip": true,
+	"uuid": true, "word": true, "sentence": true, "timestamp": true, "date": true,
+}
+
+func newFaker(seed int64) *faker {
+	base := time.Now().UTC()
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	} else {
+		// 시드를 지정하면 시각 값도 재현되도록 고정된 기준 사용
+		base = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return &faker{rng: rand.New(rand.NewSource(seed)), base: base}
+}
+
+func (f *faker) pick(values []string) string {
+	return values[f.rng.Intn(len(values))]
+}
+
+// Int lo 이상 hi 이하 정수
+func (f *faker) Int(lo, hi int64) int64 {
+	if hi <= lo {
+		return lo
+	}
+	return lo + f.rng.Int63n(hi-lo+1)
+}
+
+// Float lo 이상 hi 미만 실수 (소수점 둘째 자리)
+func (f *faker) Float(lo, hi float64) float64 {
+	if hi <= lo {
+		return lo
+	}
+	v := lo + f.rng.Float64()*(hi-lo)
+	return float64(int64(v*100)) / 100
+}
+
+// Fake 종류별 값 생성 (name, email, uuid, timestamp 등)
+func (f *faker) Fake(kind string) (any, error) {
+	switch kind {
+	case "name":
+		return f.pick(fakeFirstNames) + " " + f.pick(fakeLastNames), nil
+	case "first_name":
+		return f.pick(fakeFirstNames), nil
+	case "last_name":
+		return f.pick(fakeLastNames), nil
+	case "email":
+		return fmt.Sprintf("%s.%s%d@%s", strings.ToLower(f.pick(fakeFirstNames)),
+			strings.ToLower(f.pick(fakeLastNames)), f.rng.Intn(100), f.pick(fakeDomains)), nil
+	case "username":
+		return fmt.Sprintf("%s_%s%d", f.pick(fakeWords), strings.ToLower(f.pick(fakeFirstNames)), f.rng.Intn(1000)), nil
+	case "phone":
+		return fmt.Sprintf("+82-10-%04d-%04d", f.rng.Intn(10000), f.rng.Intn(10000)), nil
+	case "city":
+		return f.pick(fakeCities), nil
+	case "country":
+		return f.pick(fakeCountries), nil
+	case "company":
+		return f.pick(fakeCompanies), nil
+	case "url":
+		return fmt.Sprintf("https://%s/%s/%d", f.pick(fakeDomains), f.pick(fakeWords), f.rng.Intn(10000)), nil
+	case "ip":
+		return fmt.Sprintf("10.%d.%d.%d", f.rng.Intn(256), f.rng.Intn(256), 1+f.rng.Intn(254)), nil
+	case "uuid":
+		b := make([]byte, 16)
+		f.rng.Read(b)
+		b[6] = (b[6] & 0x0f) | 0x40
+		b[8] = (b[8] & 0x3f) | 0x80
+		return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+	case "word":
+		return f.pick(fakeWords), nil
+	case "sentence":
+		words := make([]string, 4+f.rng.Intn(5))
+		for i := range words {
+			words[i] = f.pick(fakeWords)
+		}
+		s := strings.Join(words, " ")
+		return strings.ToUpper(s[:1]) + s[1:] + ".", nil
+	case "timestamp":
+		return f.time().Format(time.RFC3339), nil
+	case "date":
+		return f.time().Format("2006-01-02"), nil
+	}
+	return nil, fmt.Errorf("unknown fake kind: %s", kind)
+}
+
+func (f *faker) time() time.Time {
+	return f.base.Add(-time.Duration(f.rng.Int63n(int64(30 * 24 * time.Hour)))).Truncate(time.Second)
+}
+
+// Record 스키마 필드마다 값을 생성해 레코드 구성 ("a.b" 이름은 중첩 객체로 생성)
+func (f *faker) Record(s *schema.DataSchema) (map[string]any, error) {
+	record := make(map[string]any, len(s.Fields))
+	for i := range s.Fields {
+		field := &s.Fields[i]
+		v, err := f.Field(field)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		setNestedValue(record, field.Name, v)
+	}
+	return record, nil
+}
+
+// Field 필드 타입/enum/pattern/범위에 맞는 값 생성
+// 문자열은 필드 이름으로 종류를 추정한다 (email, name, created_at 등)
+func (f *faker) Field(field *schema.FieldSchema) (any, error) {
+	if len(field.Enum) > 0 {
+		return field.Enum[f.rng.Intn(len(field.Enum))], nil
+	}
+
+	switch field.Type {
+	case schema.FieldTypeInteger:
+		lo, hi := int64(0), int64(1000)
+		if field.Min != nil {
+			lo = int64(*field.Min)
+		}
+		if field.Max != nil {
+			hi = int64(*field.Max)
+		} else if lo > hi {
+			hi = lo + 1000
+		}
+		return f.Int(lo, hi), nil
+
+	case schema.FieldTypeNumber:
+		lo, hi := 0.0, 1000.0
+		if field.Min != nil {
+			lo = *field.Min
+		}
+		if field.Max != nil {
+			hi = *field.Max
+		} else if lo > hi {
+			hi = lo + 1000
+		}
+		return f.Float(lo, hi), nil
+
+	case schema.FieldTypeBoolean:
+		return f.rng.Intn(2) == 1, nil
+
+	case schema.FieldTypeArray:
+		items := make([]any, 1+f.rng.Intn(3))
+		for i := range items {
+			item := &schema.FieldSchema{Name: field.Name, Type: schema.FieldTypeString}
+			if field.Items != nil {
+				item = field.Items
+			}
+			v, err := f.Field(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = v
+		}
+		return items, nil
+
+	case schema.FieldTypeObject:
+		obj := make(map[string]any, len(field.Properties))
+		for i := range field.Properties {
+			prop := &field.Properties[i]
+			v, err := f.Field(prop)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", prop.Name, err)
+			}
+			obj[prop.Name] = v
+		}
+		return obj, nil
+	}
+
+	// string, any
+	if field.Pattern != "" {
+		return f.Pattern(field.Pattern)
+	}
+	if kind, ok := formatKinds[field.Format]; ok {
+		return f.Fake(kind)
+	}
+	s := f.stringFor(field.Name)
+	if field.MinLength != nil {
+		for len(s) < *field.MinLength {
+			s += f.pick(fakeWords)
+		}
+	}
+	if field.MaxLength != nil && len(s) > *field.MaxLength {
+		s = s[:*field.MaxLength]
+	}
+	return s, nil
+}
+
+// formatKinds 문자열 형식(FieldSchema.Format)별 값 종류
+var formatKinds = map[string]string{
+	"date-time": "timestamp",
+	"date":      "date",
+	"email":     "email",
+	"uuid":      "uuid",
+	"uri":       "url",
+	"ipv4":      "ip",
+}
+
+// stringFor 필드 이름으로 값 종류 추정
+func (f *faker) stringFor(name string) string {
+	name = strings.ToLower(name[strings.LastIndex(name, ".")+1:])
+	kind := "word"
+	switch {
+	case strings.Contains(name, "email"):
+		kind = "email"
+	case name == "id" || strings.HasSuffix(name, "_id") || strings.Contains(name, "uuid"):
+		kind = "uuid"
+	case strings.HasSuffix(name, "_at") || strings.Contains(name, "time"):
+		kind = "timestamp"
+	case strings.Contains(name, "date"):
+		kind = "date"
+	case strings.Contains(name, "first_name"):
+		kind = "first_name"
+	case strings.Contains(name, "last_name"):
+		kind = "last_name"
+	case strings.Contains(name, "username") || strings.Contains(name, "login"):
+		kind = "username"
+	case strings.Contains(name, "name"):
+		kind = "name"
+	case strings.Contains(name, "phone"):
+		kind = "phone"
+	case strings.Contains(name, "city"):
+		kind = "city"
+	case strings.Contains(name, "country"):
+		kind = "country"
+	case strings.Contains(name, "company"):
+		kind = "company"
+	case strings.Contains(name, "url"):
+		kind = "url"
+	case name == "ip" || strings.HasSuffix(name, "_ip"):
+		kind = "ip"
+	case strings.Contains(name, "message") || strings.Contains(name, "description") || strings.Contains(name, "text"):
+		kind = "sentence"
+	}
+	v, _ := f.Fake(kind)
+	return v.(string)
+}
+
+// maxPatternRepeat 패턴의 무제한 반복(*, +, {n,})에서 생성할 최대 추가 횟수
+const maxPatternRepeat = 8
+
+// Pattern 정규식과 일치하는 문자열 생성
+func (f *faker) Pattern(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("invalid pattern %s: %w", pattern, err)
+	}
+	var b strings.Builder
+	f.writePattern(&b, re.Simplify())
+	return b.String(), nil
+}
+
+func (f *faker) writePattern(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		// Rune은 [lo, hi] 범위 쌍 목록
+		var total int
+		for i := 0; i < len(re.Rune); i += 2 {
+			total += int(re.Rune[i+1]-re.Rune[i]) + 1
+		}
+		if total == 0 {
+			return
+		}
+		n := f.rng.Intn(total)
+		for i := 0; i < len(re.Rune); i += 2 {
+			size := int(re.Rune[i+1]-re.Rune[i]) + 1
+			if n < size {
+				b.WriteRune(re.Rune[i] + rune(n))
+				return
+			}
+			n -= size
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteByte(byte('a' + f.rng.Intn(26)))
+	case syntax.OpCapture:
+		f.writePattern(b, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			f.writePattern(b, sub)
+		}
+	case syntax.OpAlternate:
+		f.writePattern(b, re.Sub[f.rng.Intn(len(re.Sub))])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		lo, hi := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			lo, hi = 0, -1
+		case syntax.OpPlus:
+			lo, hi = 1, -1
+		case syntax.OpQuest:
+			lo, hi = 0, 1
+		}
+		if hi < 0 {
+			hi = lo + maxPatternRepeat
+		}
+		for i := lo + f.rng.Intn(hi-lo+1); i > 0; i-- {
+			f.writePattern(b, re.Sub[0])
+		}
+	}
+	// ^, $, \b 등 위치 지정자는 문자를 만들지 않음
+}
+
+// setNestedValue "a.b.c" 경로에 값 설정 (중간 객체 생성)
+func setNestedValue(data map[string]any, path string, value any) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := data[part].(map[string]any)
+		if !ok {
+			next = make(map[string]any)
+			data[part] = next
+		}
+		data = next
+	}
+	data[parts[len(parts)-1]] = value
+}
@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,9 @@ import (
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
 )
 
+// 재시도 백오프 상한
+const httpMaxRetryDelay = 30 * time.Second
+
 // HTTPSource HTTP 데이터 소스
 type HTTPSource struct {
 	url        string
@@ -26,17 +30,73 @@ type HTTPSource struct {
 	pagination *config.PaginationConfig
 	client     *http.Client
 
+	// 재시도 및 요청 속도 제한
+	maxRetries  int
+	retryDelay  time.Duration
+	interval    time.Duration // 요청 간 최소 간격 (rate_limit)
+	rateMu      sync.Mutex
+	nextRequest time.Time
+
+	// 증분 동기화 상태 (성공한 실행의 값만 values에 반영)
+	state          []config.HTTPStateConfig
+	templates      *httpTemplates
+	requestHeaders map[string]string // 이번 실행에서 렌더링된 헤더
+	stateMu        sync.Mutex
+	values         map[string]any
+	pending        map[string]any
+
 	// OAuth2 토큰 캐시
 	tokenMu     sync.RWMutex
 	accessToken string
 	tokenExpiry time.Time
 }
 
+// httpPage 페이지 요청 정보
+type httpPage struct {
+	url    string
+	body   string
+	offset int
+	page   int
+}
+
+// httpResponse 디코딩된 응답
+type httpResponse struct {
+	body   any
+	header http.Header
+}
+
 // NewHTTPSource HTTP 소스 생성
 func NewHTTPSource(cfg config.SourceV2) (*HTTPSource, error) {
+	method := cfg.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = 3
+	} else if maxRetries < 0 {
+		maxRetries = 0
+	}
+
+	retryDelay := 500 * time.Millisecond
+	if cfg.RetryDelay > 0 {
+		retryDelay = time.Duration(cfg.RetryDelay) * time.Millisecond
+	}
+
+	var interval time.Duration
+	if cfg.RateLimit > 0 {
+		interval = time.Duration(float64(time.Second) / cfg.RateLimit)
+	}
+
+	templates, err := parseHTTPTemplates(cfg.URL, cfg.Body, cfg.Headers)
+	if err != nil {
+		return nil, err
+	}
+
 	return &HTTPSource{
 		url:        cfg.URL,
-		method:     cfg.Method,
+		method:     method,
 		headers:    cfg.Headers,
 		body:       cfg.Body,
 		auth:       cfg.Auth,
@@ -44,6 +104,12 @@ func NewHTTPSource(cfg config.SourceV2) (*HTTPSource, error) {
 		client: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		maxRetries: maxRetries,
+		retryDelay: retryDelay,
+		interval:   interval,
+		state:      cfg.State,
+		templates:  templates,
+		values:     make(map[string]any),
 	}, nil
 }
 
@@ -69,131 +135,271 @@ func (s *HTTPSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
 		defer close(records)
 		defer close(errs)
 
-		if s.pagination != nil {
-			s.readWithPagination(ctx, records, errs)
-		} else {
-			s.readSingle(ctx, records, errs)
+		page, err := s.beginRun()
+		if err == nil {
+			if s.pagination != nil {
+				err = s.readWithPagination(ctx, page, records)
+			} else {
+				err = s.readSingle(ctx, page, records)
+			}
+		}
+		if err != nil {
+			// 취소된 경우 오류를 보고하지 않으며 상태도 반영하지 않음
+			if ctx.Err() == nil {
+				errs <- err
+			}
+			return
 		}
+		s.commitState()
 	}()
 
 	return records, errs
 }
 
-func (s *HTTPSource) readSingle(ctx context.Context, records chan<- Record, errs chan<- error) {
-	data, err := s.doRequest(ctx, s.url)
+func (s *HTTPSource) readSingle(ctx context.Context, page httpPage, records chan<- Record) error {
+	resp, err := s.doRequest(ctx, page.url, page.body)
 	if err != nil {
-		errs <- err
-		return
+		return err
 	}
 
-	s.emitRecords(data, s.url, records)
+	s.trackResponse(resp.body)
+	if !s.emitRecords(ctx, s.extractItems(resp.body), page.url, records) {
+		return ctx.Err()
+	}
+	return nil
 }
 
-func (s *HTTPSource) readWithPagination(ctx context.Context, records chan<- Record, errs chan<- error) {
-	currentURL := s.url
-	pageCount := 0
+func (s *HTTPSource) readWithPagination(ctx context.Context, page httpPage, records chan<- Record) error {
 	maxPages := s.pagination.MaxPages
 	if maxPages == 0 {
 		maxPages = 100 // 기본 최대 페이지
 	}
 
-	for currentURL != "" && pageCount < maxPages {
-		select {
-		case <-ctx.Done():
-			return
-		default:
+	page.page = s.startPage()
+	for pageCount := 1; pageCount <= maxPages; pageCount++ {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
 
-		pageCount++
+		requestURL, requestBody, err := s.pageRequest(page)
+		if err != nil {
+			return fmt.Errorf("page %d: %w", pageCount, err)
+		}
 
-		data, err := s.doRequest(ctx, currentURL)
+		resp, err := s.doRequest(ctx, requestURL, requestBody)
 		if err != nil {
-			errs <- fmt.Errorf("page %d: %w", pageCount, err)
-			return
+			return fmt.Errorf("page %d: %w", pageCount, err)
 		}
 
-		// 데이터 추출
-		var items []any
-		if s.pagination.DataField != "" {
-			if dataField, ok := data[s.pagination.DataField]; ok {
-				if arr, ok := dataField.([]any); ok {
-					items = arr
-				}
-			}
-		} else {
-			// 전체 응답이 배열인 경우
-			items = []any{data}
-		}
-
-		for _, item := range items {
-			if m, ok := item.(map[string]any); ok {
-				records <- Record{
-					Data: m,
-					Metadata: Metadata{
-						Source:    "http",
-						Origin:    currentURL,
-						Timestamp: time.Now().UnixMilli(),
-					},
-				}
-			}
+		s.trackResponse(resp.body)
+		items := s.extractItems(resp.body)
+		if !s.emitRecords(ctx, items, requestURL, records) {
+			return ctx.Err()
 		}
 
-		// 다음 페이지 URL 추출
-		currentURL = ""
-		if s.pagination.NextField != "" {
-			if nextURL, ok := data[s.pagination.NextField]; ok {
-				if urlStr, ok := nextURL.(string); ok && urlStr != "" {
-					currentURL = urlStr
-				}
+		next, ok := s.nextPage(page, requestURL, resp, len(items))
+		if !ok {
+			return nil
+		}
+		page = next
+	}
+	return nil
+}
+
+// pageRequest 페이지 상태로 요청 URL과 본문 생성
+func (s *HTTPSource) pageRequest(page httpPage) (string, string, error) {
+	p := s.pagination
+	switch p.Type {
+	case "offset":
+		params := map[string]string{paramName(p.OffsetParam, "offset"): strconv.Itoa(page.offset)}
+		if p.PageSize > 0 {
+			params[paramName(p.LimitParam, "limit")] = strconv.Itoa(p.PageSize)
+		}
+		u, err := setQueryParams(page.url, params)
+		return u, page.body, err
+
+	case "page":
+		params := map[string]string{paramName(p.PageParam, "page"): strconv.Itoa(page.page)}
+		if p.PageSize > 0 {
+			params[paramName(p.LimitParam, "limit")] = strconv.Itoa(p.PageSize)
+		}
+		u, err := setQueryParams(page.url, params)
+		return u, page.body, err
+	}
+	return page.url, page.body, nil
+}
+
+// nextPage 응답에서 다음 페이지 상태 결정 (없으면 false)
+func (s *HTTPSource) nextPage(page httpPage, requestURL string, resp *httpResponse, itemCount int) (httpPage, bool) {
+	p := s.pagination
+	switch p.Type {
+	case "offset", "page":
+		// 빈 페이지 또는 page_size보다 적게 오면 마지막 페이지
+		if itemCount == 0 || (p.PageSize > 0 && itemCount < p.PageSize) {
+			return page, false
+		}
+		page.offset += itemCount
+		page.page++
+		return page, true
+
+	case "cursor":
+		v, ok := lookupJSONPath(resp.body, p.NextField)
+		if !ok || v == nil {
+			return page, false
+		}
+		cursor := fmt.Sprint(v)
+		if cursor == "" {
+			return page, false
+		}
+
+		param := paramName(p.CursorParam, "cursor")
+		if p.CursorIn == "body" {
+			body, err := setBodyField(page.body, param, v)
+			if err != nil {
+				return page, false
 			}
+			page.body = body
+			return page, true
+		}
+		u, err := setQueryParams(page.url, map[string]string{param: cursor})
+		if err != nil {
+			return page, false
+		}
+		page.url = u
+		return page, true
+
+	case "link_header":
+		next := parseLinkHeader(resp.header.Values("Link"))["next"]
+		if next == "" {
+			return page, false
+		}
+		page.url = resolveURL(requestURL, next)
+		return page, true
+
+	default: // next_url
+		if p.NextField == "" {
+			return page, false
+		}
+		v, ok := lookupJSONPath(resp.body, p.NextField)
+		next, _ := v.(string)
+		if !ok || next == "" {
+			return page, false
+		}
+		page.url = resolveURL(requestURL, next)
+		return page, true
+	}
+}
+
+func (s *HTTPSource) startPage() int {
+	if s.pagination.StartPage > 0 {
+		return s.pagination.StartPage
+	}
+	return 1
+}
+
+// doRequest 요청 실행 (속도 제한 적용, 429/5xx와 네트워크 오류는 백오프 후 재시도)
+func (s *HTTPSource) doRequest(ctx context.Context, requestURL, body string) (*httpResponse, error) {
+	delay := s.retryDelay
+	for attempt := 0; ; attempt++ {
+		resp, retryAfter, err := s.doRequestOnce(ctx, requestURL, body)
+		if err == nil {
+			return resp, nil
+		}
+		if retryAfter < 0 || attempt >= s.maxRetries || ctx.Err() != nil {
+			return nil, err
+		}
+
+		wait := delay
+		if retryAfter > 0 {
+			wait = retryAfter
+		}
+		if wait > httpMaxRetryDelay {
+			wait = httpMaxRetryDelay
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+
+		delay *= 2
+		if delay > httpMaxRetryDelay {
+			delay = httpMaxRetryDelay
 		}
 	}
 }
 
-func (s *HTTPSource) doRequest(ctx context.Context, requestURL string) (map[string]any, error) {
+// doRequestOnce 요청 1회 실행
+// retryAfter: 재시도 불가 오류는 -1, 재시도 가능하면 서버가 지정한 대기 시간 (없으면 0)
+func (s *HTTPSource) doRequestOnce(ctx context.Context, requestURL, body string) (*httpResponse, time.Duration, error) {
+	if err := s.waitRateLimit(ctx); err != nil {
+		return nil, -1, err
+	}
+
 	var bodyReader io.Reader
-	if s.body != "" {
-		bodyReader = bytes.NewReader([]byte(s.body))
+	if body != "" {
+		bodyReader = bytes.NewReader([]byte(body))
 	}
 
 	req, err := http.NewRequestWithContext(ctx, s.method, requestURL, bodyReader)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, -1, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// 기본 헤더
 	req.Header.Set("Accept", "application/json")
-	if s.body != "" {
+	if body != "" {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
 	// 커스텀 헤더
-	for k, v := range s.headers {
+	for k, v := range s.requestHeaders {
 		req.Header.Set(k, v)
 	}
 
 	// 인증 설정
 	if err := s.setAuth(ctx, req); err != nil {
-		return nil, err
+		return nil, -1, err
 	}
 
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, 0, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 400 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("http error %d: %s", resp.StatusCode, string(body))
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		err := fmt.Errorf("http error %d: %s", resp.StatusCode, string(respBody))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			retryAfter, _ := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+			return nil, retryAfter, err
+		}
+		return nil, -1, err
 	}
 
-	var result map[string]any
+	var result any
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %w", err)
+		return nil, -1, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &httpResponse{body: result, header: resp.Header}, 0, nil
+}
+
+// waitRateLimit rate_limit 간격에 맞춰 대기
+func (s *HTTPSource) waitRateLimit(ctx context.Context) error {
+	if s.interval <= 0 {
+		return nil
+	}
+
+	s.rateMu.Lock()
+	now := time.Now()
+	if s.nextRequest.Before(now) {
+		s.nextRequest = now
 	}
+	wait := s.nextRequest.Sub(now)
+	s.nextRequest = s.nextRequest.Add(s.interval)
+	s.rateMu.Unlock()
 
-	return result, nil
+	return sleepContext(ctx, wait)
 }
 
 func (s *HTTPSource) setAuth(ctx context.Context, req *http.Request) error {
@@ -281,35 +487,172 @@ func (s *HTTPSource) getOAuth2Token(ctx context.Context) (string, error) {
 	return s.accessToken, nil
 }
 
-func (s *HTTPSource) emitRecords(data map[string]any, origin string, records chan<- Record) {
-	// 응답이 배열인 경우
-	if arr, ok := data["data"].([]any); ok {
-		for _, item := range arr {
-			if m, ok := item.(map[string]any); ok {
-				records <- Record{
-					Data: m,
-					Metadata: Metadata{
-						Source:    "http",
-						Origin:    origin,
-						Timestamp: time.Now().UnixMilli(),
-					},
-				}
-			}
+// extractItems 응답에서 레코드 목록 추출
+// data_field가 없으면 배열 응답은 각 요소, "data" 배열이 있으면 그 요소, 그 외에는 응답 전체를 사용
+func (s *HTTPSource) extractItems(body any) []any {
+	if s.pagination != nil && s.pagination.DataField != "" {
+		v, ok := lookupJSONPath(body, s.pagination.DataField)
+		if !ok {
+			return nil
 		}
-		return
+		if arr, ok := v.([]any); ok {
+			return arr
+		}
+		return []any{v}
 	}
 
-	// 단일 객체
-	records <- Record{
-		Data: data,
-		Metadata: Metadata{
-			Source:    "http",
-			Origin:    origin,
-			Timestamp: time.Now().UnixMilli(),
-		},
+	switch v := body.(type) {
+	case []any:
+		return v
+	case map[string]any:
+		if arr, ok := v["data"].([]any); ok {
+			return arr
+		}
+		return []any{v}
+	}
+	return nil
+}
+
+// emitRecords 객체 항목을 레코드로 전달 (컨텍스트 취소 시 false)
+func (s *HTTPSource) emitRecords(ctx context.Context, items []any, origin string, records chan<- Record) bool {
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		s.trackRecord(m)
+		record := Record{
+			Data: m,
+			Metadata: Metadata{
+				Source:    "http",
+				Origin:    origin,
+				Timestamp: time.Now().UnixMilli(),
+			},
+		}
+		select {
+		case records <- record:
+		case <-ctx.Done():
+			return false
+		}
 	}
+	return true
 }
 
 func (s *HTTPSource) Close() error {
 	return nil
 }
+
+// parseRetryAfter Retry-After 헤더 해석 (초 단위 또는 HTTP-date)
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// parseLinkHeader RFC 5988 Link 헤더를 rel별 URL로 변환
+// 예: <https://api.example.com/items?page=2>; rel="next", <...>; rel="last"
+func parseLinkHeader(values []string) map[string]string {
+	links := make(map[string]string)
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			segments := strings.Split(part, ";")
+			target := strings.TrimSpace(segments[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			target = target[1 : len(target)-1]
+
+			for _, param := range segments[1:] {
+				key, val, ok := strings.Cut(strings.TrimSpace(param), "=")
+				if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+					continue
+				}
+				for _, rel := range strings.Fields(strings.Trim(strings.TrimSpace(val), `"`)) {
+					if _, exists := links[rel]; !exists {
+						links[rel] = target
+					}
+				}
+			}
+		}
+	}
+	return links
+}
+
+// resolveURL 상대 URL을 기준 URL에 대해 해석
+func resolveURL(base, ref string) string {
+	b, err := url.Parse(base)
+	if err != nil {
+		return ref
+	}
+	r, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return b.ResolveReference(r).String()
+}
+
+// setQueryParams URL 쿼리 파라미터 설정 (기존 값 대체)
+func setQueryParams(rawURL string, params map[string]string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid url: %w", err)
+	}
+	q := u.Query()
+	for k, v := range params {
+		q.Set(k, v)
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// setBodyField JSON 요청 본문의 최상위 필드 설정
+func setBodyField(body, field string, value any) (string, error) {
+	m := make(map[string]any)
+	if strings.TrimSpace(body) != "" {
+		if err := json.Unmarshal([]byte(body), &m); err != nil {
+			return "", fmt.Errorf("request body is not a json object: %w", err)
+		}
+	}
+	m[field] = value
+	b, err := json.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func paramName(name, def string) string {
+	if name != "" {
+		return name
+	}
+	return def
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,474 @@
+package source
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// 수신 요청 처리 결과
+var (
+	errIngestBusy   = errors.New("ingest buffer is full")
+	errIngestPaused = errors.New("ingest is paused")
+	errIngestClosed = errors.New("ingest source is closed")
+)
+
+// HTTPServerSource HTTP 요청으로 전달되는 레코드를 받는 소스 (webhook 수신)
+// 요청의 레코드가 모두 Ack된 뒤(싱크 기록 확정)에 200을 응답하므로, 200을 받지 못한 요청은
+// 클라이언트가 재전송해야 한다 (at-least-once). commit_timeout 안에 Ack되지 않으면 504,
+// 일시 중지 상태이거나 버퍼 공간이 ack_timeout 안에 확보되지 않으면 429를 응답한다
+type HTTPServerSource struct {
+	address       string
+	path          string
+	auth          *config.IngestAuthConfig
+	bufferSize    int
+	maxBodySize   int64
+	ackTimeout    time.Duration
+	commitTimeout time.Duration
+
+	server   *http.Server
+	listener net.Listener
+	serveErr chan error
+
+	// records 전송은 sendMu를 잡은 상태에서만 하므로 남은 용량을 확인한 뒤 블로킹 없이 넣을 수 있다
+	records chan Record
+	sendMu  sync.Mutex
+	closed  bool
+	paused  atomic.Bool
+	done    chan struct{}
+
+	// 레코드 Offset(요청마다 증가하는 번호) → Ack를 기다리는 요청
+	seq     atomic.Uint64
+	waitMu  sync.Mutex
+	waiting map[uint64]*ingestWaiter
+}
+
+// ingestWaiter 한 요청에서 아직 Ack되지 않은 레코드 수 (모두 Ack되면 committed를 닫음)
+type ingestWaiter struct {
+	remaining int
+	committed chan struct{}
+}
+
+// NewHTTPServerSource HTTP 수신 소스 생성
+func NewHTTPServerSource(cfg config.SourceV2) (*HTTPServerSource, error) {
+	address := cfg.Address
+	if address == "" {
+		address = "0.0.0.0:8080"
+	}
+	path := cfg.Path
+	if path == "" {
+		path = "/"
+	}
+
+	bufferSize := cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = 10000
+	}
+	maxBodySize := int64(cfg.MaxBodySize)
+	if maxBodySize <= 0 {
+		maxBodySize = 10 << 20
+	}
+	ackTimeout := time.Second
+	if cfg.AckTimeout > 0 {
+		ackTimeout = time.Duration(cfg.AckTimeout) * time.Millisecond
+	}
+	commitTimeout := 30 * time.Second
+	if cfg.CommitTimeout > 0 {
+		commitTimeout = time.Duration(cfg.CommitTimeout) * time.Millisecond
+	}
+
+	if auth := cfg.IngestAuth; auth != nil {
+		if auth.Secret == "" {
+			return nil, fmt.Errorf("ingest_auth secret is required")
+		}
+		if auth.Type != "secret" && auth.Type != "hmac" {
+			return nil, fmt.Errorf("unsupported ingest_auth type: %s", auth.Type)
+		}
+		if auth.Type == "hmac" && hmacHash(auth.Algorithm) == nil {
+			return nil, fmt.Errorf("unsupported ingest_auth algorithm: %s", auth.Algorithm)
+		}
+	}
+
+	return &HTTPServerSource{
+		address:       address,
+		path:          path,
+		auth:          cfg.IngestAuth,
+		bufferSize:    bufferSize,
+		maxBodySize:   maxBodySize,
+		ackTimeout:    ackTimeout,
+		commitTimeout: commitTimeout,
+		records:       make(chan Record, bufferSize),
+		serveErr:      make(chan error, 1),
+		done:          make(chan struct{}),
+		waiting:       make(map[uint64]*ingestWaiter),
+	}, nil
+}
+
+func (s *HTTPServerSource) Name() string {
+	return "http_server"
+}
+
+// Open 수신 대기 시작
+func (s *HTTPServerSource) Open(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(s.path, s.handleIngest)
+
+	s.listener = listener
+	s.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.serveErr <- fmt.Errorf("http server failed: %w", err)
+		}
+	}()
+	return nil
+}
+
+// Addr 실제 수신 주소 (포트 0으로 연 경우 할당된 포트 확인용)
+func (s *HTTPServerSource) Addr() string {
+	if s.listener == nil {
+		return s.address
+	}
+	return s.listener.Addr().String()
+}
+
+// Read 수신한 레코드 채널 반환 (컨텍스트 취소 시 서버를 닫고 채널 종료)
+func (s *HTTPServerSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		select {
+		case <-ctx.Done():
+		case err := <-s.serveErr:
+			errs <- err
+		}
+		_ = s.Close()
+	}()
+
+	return s.records, errs
+}
+
+// Pause 수신 일시 중지 (요청에 429 응답)
+func (s *HTTPServerSource) Pause() {
+	s.paused.Store(true)
+}
+
+// Resume 수신 재개
+func (s *HTTPServerSource) Resume() {
+	s.paused.Store(false)
+}
+
+// Ack 싱크 기록이 확정된 레코드 통지 (요청의 레코드가 모두 Ack되면 해당 요청에 200 응답)
+func (s *HTTPServerSource) Ack(ctx context.Context, records []Record) error {
+	s.waitMu.Lock()
+	defer s.waitMu.Unlock()
+
+	for _, record := range records {
+		if record.Metadata.Source != "http_server" {
+			continue
+		}
+		id, err := strconv.ParseUint(record.Metadata.Offset, 10, 64)
+		if err != nil {
+			continue
+		}
+		w, ok := s.waiting[id]
+		if !ok {
+			continue
+		}
+		delete(s.waiting, id)
+		if w.remaining--; w.remaining == 0 {
+			close(w.committed)
+		}
+	}
+	return nil
+}
+
+// Close 서버를 종료하고 레코드 채널을 닫음
+// 종료 대기 중에도 파이프라인이 남은 레코드를 Ack하면 해당 요청은 200을 받고,
+// 그 뒤에도 Ack를 기다리는 요청은 503을 받는다
+func (s *HTTPServerSource) Close() error {
+	s.sendMu.Lock()
+	closing := !s.closed
+	if closing {
+		s.closed = true
+		close(s.records)
+	}
+	s.sendMu.Unlock()
+
+	var err error
+	if s.server != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		err = s.server.Shutdown(ctx)
+		cancel()
+	}
+	if closing {
+		close(s.done)
+	}
+	return err
+}
+
+func (s *HTTPServerSource) handleIngest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost && r.Method != http.MethodPut {
+		w.Header().Set("Allow", "POST, PUT")
+		writeIngestError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.paused.Load() {
+		w.Header().Set("Retry-After", "1")
+		writeIngestError(w, http.StatusTooManyRequests, errIngestPaused.Error())
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeIngestError(w, http.StatusRequestEntityTooLarge, "request body too large")
+			return
+		}
+		writeIngestError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+
+	if err := s.verify(r.Header, body); err != nil {
+		writeIngestError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	items, err := decodeIngestBody(body)
+	if err != nil {
+		writeIngestError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(items) > s.bufferSize {
+		writeIngestError(w, http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("%d records exceed buffer size %d", len(items), s.bufferSize))
+		return
+	}
+
+	now := time.Now().UnixMilli()
+	batch := make([]Record, len(items))
+	for i, item := range items {
+		batch[i] = Record{
+			Data: item,
+			Metadata: Metadata{
+				Source:    "http_server",
+				Origin:    r.URL.Path,
+				Offset:    strconv.FormatUint(s.seq.Add(1), 10),
+				Timestamp: now,
+			},
+		}
+	}
+
+	// 버퍼에 넣자마자 Ack될 수 있으므로 먼저 등록
+	waiter := s.register(batch)
+	switch err := s.accept(r.Context(), batch); {
+	case err == nil:
+	case errors.Is(err, errIngestBusy), errors.Is(err, errIngestPaused):
+		s.unregister(batch)
+		w.Header().Set("Retry-After", "1")
+		writeIngestError(w, http.StatusTooManyRequests, err.Error())
+		return
+	default:
+		s.unregister(batch)
+		writeIngestError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+
+	timer := time.NewTimer(s.commitTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-waiter.committed:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"accepted": len(batch)})
+	case <-timer.C:
+		// 이미 기록된 레코드가 있을 수 있으므로 재전송 시 중복될 수 있음
+		s.unregister(batch)
+		writeIngestError(w, http.StatusGatewayTimeout, "records were not committed in time")
+	case <-s.done:
+		s.unregister(batch)
+		writeIngestError(w, http.StatusServiceUnavailable, errIngestClosed.Error())
+	case <-r.Context().Done():
+		s.unregister(batch)
+	}
+}
+
+// register 요청의 레코드를 Ack 대기 목록에 등록
+func (s *HTTPServerSource) register(batch []Record) *ingestWaiter {
+	w := &ingestWaiter{remaining: len(batch), committed: make(chan struct{})}
+	s.waitMu.Lock()
+	for _, record := range batch {
+		id, _ := strconv.ParseUint(record.Metadata.Offset, 10, 64)
+		s.waiting[id] = w
+	}
+	s.waitMu.Unlock()
+	return w
+}
+
+// unregister 응답을 마친 요청의 레코드를 Ack 대기 목록에서 제거
+func (s *HTTPServerSource) unregister(batch []Record) {
+	s.waitMu.Lock()
+	for _, record := range batch {
+		id, _ := strconv.ParseUint(record.Metadata.Offset, 10, 64)
+		delete(s.waiting, id)
+	}
+	s.waitMu.Unlock()
+}
+
+// accept 요청의 레코드를 모두 버퍼에 넣음 (전부 들어가거나 하나도 들어가지 않음)
+// 공간이 부족하면 ack_timeout까지 기다린 뒤 errIngestBusy 반환
+func (s *HTTPServerSource) accept(ctx context.Context, batch []Record) error {
+	deadline := time.Now().Add(s.ackTimeout)
+	for {
+		s.sendMu.Lock()
+		if s.closed {
+			s.sendMu.Unlock()
+			return errIngestClosed
+		}
+		if cap(s.records)-len(s.records) >= len(batch) {
+			for _, record := range batch {
+				s.records <- record
+			}
+			s.sendMu.Unlock()
+			return nil
+		}
+		s.sendMu.Unlock()
+
+		if s.paused.Load() {
+			return errIngestPaused
+		}
+		if !time.Now().Before(deadline) {
+			return errIngestBusy
+		}
+		if err := sleepContext(ctx, min(10*time.Millisecond, time.Until(deadline))); err != nil {
+			return err
+		}
+	}
+}
+
+// verify 요청 인증 (shared secret 헤더 또는 본문 HMAC 서명)
+func (s *HTTPServerSource) verify(header http.Header, body []byte) error {
+	if s.auth == nil {
+		return nil
+	}
+
+	switch s.auth.Type {
+	case "secret":
+		got := header.Get(paramName(s.auth.Header, "X-Webhook-Secret"))
+		if subtle.ConstantTimeCompare([]byte(got), []byte(s.auth.Secret)) != 1 {
+			return fmt.Errorf("invalid secret")
+		}
+		return nil
+
+	case "hmac":
+		got := header.Get(paramName(s.auth.Header, "X-Signature"))
+		if got == "" {
+			return fmt.Errorf("missing signature")
+		}
+		got = strings.TrimPrefix(got, s.auth.Prefix)
+
+		mac := hmac.New(hmacHash(s.auth.Algorithm), []byte(s.auth.Secret))
+		mac.Write(body)
+		expected := mac.Sum(nil)
+
+		var signature []byte
+		var err error
+		if s.auth.Encoding == "base64" {
+			signature, err = base64.StdEncoding.DecodeString(got)
+		} else {
+			signature, err = hex.DecodeString(strings.ToLower(got))
+		}
+		if err != nil || !hmac.Equal(signature, expected) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported auth type: %s", s.auth.Type)
+}
+
+func hmacHash(algorithm string) func() hash.Hash {
+	switch algorithm {
+	case "", "sha256":
+		return sha256.New
+	case "sha1":
+		return sha1.New
+	case "sha512":
+		return sha512.New
+	}
+	return nil
+}
+
+// decodeIngestBody 요청 본문을 레코드로 변환
+// 단일 JSON 객체, JSON 배열, NDJSON(줄 단위 객체)을 지원한다
+func decodeIngestBody(body []byte) ([]map[string]any, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("empty request body")
+	}
+
+	var values []any
+	if trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &values); err != nil {
+			return nil, fmt.Errorf("invalid json array: %w", err)
+		}
+	} else {
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		for {
+			var v any
+			err := dec.Decode(&v)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid json at record %d: %w", len(values)+1, err)
+			}
+			values = append(values, v)
+		}
+	}
+
+	items := make([]map[string]any, 0, len(values))
+	for i, v := range values {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("record %d is not a json object", i+1)
+		}
+		items = append(items, m)
+	}
+	return items, nil
+}
+
+func writeIngestError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"error": message})
+}
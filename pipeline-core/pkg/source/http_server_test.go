@@ -0,0 +1,256 @@
+package source
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func openIngestSource(t *testing.T, cfg config.SourceV2) (*HTTPServerSource, <-chan Record) {
+	t.Helper()
+
+	cfg.Address = "127.0.0.1:0"
+	cfg.Path = "/ingest"
+	src, err := NewHTTPServerSource(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	if err := src.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	records, _ := src.Read(ctx)
+	return src, records
+}
+
+func postIngest(t *testing.T, src *HTTPServerSource, body string, header map[string]string) int {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodPost, "http://"+src.Addr()+"/ingest", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// postIngestAsync 응답이 Ack를 기다리는 동안 테스트를 진행할 수 있도록 요청을 따로 보냄 (실패 시 0)
+func postIngestAsync(src *HTTPServerSource, body string) <-chan int {
+	status := make(chan int, 1)
+	go func() {
+		resp, err := http.Post("http://"+src.Addr()+"/ingest", "application/json", strings.NewReader(body))
+		if err != nil {
+			status <- 0
+			return
+		}
+		resp.Body.Close()
+		status <- resp.StatusCode
+	}()
+	return status
+}
+
+// autoAck 싱크 역할: 받은 레코드를 전달한 뒤 바로 Ack
+func autoAck(src *HTTPServerSource, records <-chan Record) <-chan Record {
+	out := make(chan Record, 100)
+	go func() {
+		for r := range records {
+			out <- r
+			_ = src.Ack(context.Background(), []Record{r})
+		}
+	}()
+	return out
+}
+
+func receiveRecords(t *testing.T, records <-chan Record, n int) []Record {
+	t.Helper()
+
+	var out []Record
+	for len(out) < n {
+		select {
+		case r := <-records:
+			out = append(out, r)
+		case <-time.After(time.Second):
+			t.Fatalf("received %d records, want %d", len(out), n)
+		}
+	}
+	return out
+}
+
+func drainRecords(records <-chan Record) []Record {
+	var out []Record
+	for {
+		select {
+		case r := <-records:
+			out = append(out, r)
+		default:
+			return out
+		}
+	}
+}
+
+func TestHTTPServerSourceFormats(t *testing.T) {
+	src, buffered := openIngestSource(t, config.SourceV2{})
+	records := autoAck(src, buffered)
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+		count  int
+	}{
+		{"single object", `{"id": 1}`, http.StatusOK, 1},
+		{"array", `[{"id": 1}, {"id": 2}]`, http.StatusOK, 2},
+		{"ndjson", "{\"id\": 1}\n{\"id\": 2}\n{\"id\": 3}\n", http.StatusOK, 3},
+		{"invalid json", `{"id": `, http.StatusBadRequest, 0},
+		{"non-object", `[1, 2]`, http.StatusBadRequest, 0},
+		{"empty", ``, http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := postIngest(t, src, tt.body, nil); got != tt.status {
+				t.Fatalf("status = %d, want %d", got, tt.status)
+			}
+			// 200 응답 시점에 레코드가 이미 싱크에 전달되어 있어야 함
+			got := drainRecords(records)
+			if len(got) != tt.count {
+				t.Fatalf("records = %d, want %d", len(got), tt.count)
+			}
+			for _, r := range got {
+				if r.Metadata.Source != "http_server" || r.Metadata.Origin != "/ingest" {
+					t.Errorf("metadata = %+v", r.Metadata)
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPServerSourceAuth(t *testing.T) {
+	body := `{"event": "push"}`
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(body))
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		name   string
+		auth   config.IngestAuthConfig
+		header map[string]string
+		status int
+	}{
+		{"secret ok", config.IngestAuthConfig{Type: "secret", Secret: "s3cret"},
+			map[string]string{"X-Webhook-Secret": "s3cret"}, http.StatusOK},
+		{"secret wrong", config.IngestAuthConfig{Type: "secret", Secret: "s3cret"},
+			map[string]string{"X-Webhook-Secret": "nope"}, http.StatusUnauthorized},
+		{"hmac ok", config.IngestAuthConfig{Type: "hmac", Secret: "s3cret", Header: "X-Hub-Signature-256", Prefix: "sha256="},
+			map[string]string{"X-Hub-Signature-256": signature}, http.StatusOK},
+		{"hmac wrong key", config.IngestAuthConfig{Type: "hmac", Secret: "other", Header: "X-Hub-Signature-256", Prefix: "sha256="},
+			map[string]string{"X-Hub-Signature-256": signature}, http.StatusUnauthorized},
+		{"hmac missing", config.IngestAuthConfig{Type: "hmac", Secret: "s3cret"}, nil, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auth := tt.auth
+			src, records := openIngestSource(t, config.SourceV2{IngestAuth: &auth})
+			autoAck(src, records)
+			if got := postIngest(t, src, body, tt.header); got != tt.status {
+				t.Errorf("status = %d, want %d", got, tt.status)
+			}
+		})
+	}
+}
+
+func TestHTTPServerSourceBackpressure(t *testing.T) {
+	src, records := openIngestSource(t, config.SourceV2{BufferSize: 3, AckTimeout: 20})
+	ctx := context.Background()
+
+	// 읽히지 않은 레코드가 버퍼를 차지하는 동안 첫 요청은 Ack를 기다림
+	first := postIngestAsync(src, `[{"id": 1}, {"id": 2}]`)
+	deadline := time.Now().Add(time.Second)
+	for len(src.records) < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("first request was not buffered")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	// 남은 공간(1)보다 많은 레코드는 일부만 넣지 않고 거절
+	if got := postIngest(t, src, `[{"id": 3}, {"id": 4}]`, nil); got != http.StatusTooManyRequests {
+		t.Fatalf("full buffer status = %d", got)
+	}
+	// 버퍼보다 큰 요청은 재시도해도 받을 수 없음
+	if got := postIngest(t, src, `[{}, {}, {}, {}]`, nil); got != http.StatusRequestEntityTooLarge {
+		t.Fatalf("oversized status = %d", got)
+	}
+	got := drainRecords(records)
+	if len(got) != 2 {
+		t.Fatalf("records = %d, want 2", len(got))
+	}
+	if err := src.Ack(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	if status := <-first; status != http.StatusOK {
+		t.Fatalf("first status = %d", status)
+	}
+
+	src.Pause()
+	if got := postIngest(t, src, `{"id": 5}`, nil); got != http.StatusTooManyRequests {
+		t.Fatalf("paused status = %d", got)
+	}
+	src.Resume()
+	resumed := postIngestAsync(src, `{"id": 5}`)
+	if err := src.Ack(ctx, receiveRecords(t, records, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if status := <-resumed; status != http.StatusOK {
+		t.Fatalf("resumed status = %d", status)
+	}
+}
+
+func TestHTTPServerSourceWaitsForAck(t *testing.T) {
+	src, records := openIngestSource(t, config.SourceV2{CommitTimeout: 100})
+	ctx := context.Background()
+
+	// 일부 레코드만 Ack되면 기록이 확정되지 않았으므로 504 (클라이언트 재전송 대상)
+	partial := postIngestAsync(src, `[{"id": 1}, {"id": 2}]`)
+	got := receiveRecords(t, records, 2)
+	if err := src.Ack(ctx, got[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if status := <-partial; status != http.StatusGatewayTimeout {
+		t.Fatalf("partial ack status = %d, want %d", status, http.StatusGatewayTimeout)
+	}
+
+	// 요청의 레코드가 모두 Ack되면 200 (다른 요청의 레코드 Ack는 영향 없음)
+	committed := postIngestAsync(src, `[{"id": 3}, {"id": 4}]`)
+	got = receiveRecords(t, records, 2)
+	if got[0].Metadata.Offset == got[1].Metadata.Offset {
+		t.Fatalf("records share offset %s", got[0].Metadata.Offset)
+	}
+	select {
+	case status := <-committed:
+		t.Fatalf("responded with %d before ack", status)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if err := src.Ack(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	if status := <-committed; status != http.StatusOK {
+		t.Fatalf("committed status = %d, want %d", status, http.StatusOK)
+	}
+}
@@ -0,0 +1,210 @@
+package source
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"text/template"
+)
+
+// httpTemplates 상태 값을 참조하는 요청 템플릿 ({{.state.<key>}})
+type httpTemplates struct {
+	url     *template.Template
+	body    *template.Template
+	headers map[string]*template.Template
+}
+
+// parseHTTPTemplates url/body/headers 중 "{{"를 포함하는 값을 템플릿으로 파싱
+func parseHTTPTemplates(rawURL, body string, headers map[string]string) (*httpTemplates, error) {
+	t := &httpTemplates{headers: make(map[string]*template.Template)}
+
+	var err error
+	if t.url, err = parseHTTPTemplate("url", rawURL); err != nil {
+		return nil, err
+	}
+	if t.body, err = parseHTTPTemplate("body", body); err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		tmpl, err := parseHTTPTemplate("header "+k, v)
+		if err != nil {
+			return nil, err
+		}
+		if tmpl != nil {
+			t.headers[k] = tmpl
+		}
+	}
+	return t, nil
+}
+
+func parseHTTPTemplate(name, text string) (*template.Template, error) {
+	if !strings.Contains(text, "{{") {
+		return nil, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s template: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+func renderHTTPTemplate(tmpl *template.Template, text string, data any) (string, error) {
+	if tmpl == nil {
+		return text, nil
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", tmpl.Name(), err)
+	}
+	return sb.String(), nil
+}
+
+// beginRun 현재 상태로 요청 URL/본문/헤더를 만들고 이번 실행의 상태 추적 시작
+func (s *HTTPSource) beginRun() (httpPage, error) {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	values := make(map[string]string, len(s.state))
+	for _, st := range s.state {
+		values[st.Key] = st.Initial
+		if v, ok := s.values[st.Key]; ok {
+			values[st.Key] = formatMark(v)
+		}
+	}
+	data := map[string]any{"state": values}
+
+	requestURL, err := renderHTTPTemplate(s.templates.url, s.url, data)
+	if err != nil {
+		return httpPage{}, err
+	}
+	body, err := renderHTTPTemplate(s.templates.body, s.body, data)
+	if err != nil {
+		return httpPage{}, err
+	}
+	headers := make(map[string]string, len(s.headers))
+	for k, v := range s.headers {
+		if headers[k], err = renderHTTPTemplate(s.templates.headers[k], v, data); err != nil {
+			return httpPage{}, err
+		}
+	}
+
+	s.requestHeaders = headers
+	s.pending = make(map[string]any)
+	return httpPage{url: requestURL, body: body}, nil
+}
+
+// trackRecord field 상태 값 갱신 (이번 실행에서 읽은 값 중 최대값)
+func (s *HTTPSource) trackRecord(data map[string]any) {
+	if len(s.state) == 0 {
+		return
+	}
+
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	for _, st := range s.state {
+		if st.Field == "" {
+			continue
+		}
+		v, ok := lookupJSONPath(data, st.Field)
+		if !ok {
+			continue
+		}
+		mark, ok := jsonMark(v)
+		if !ok {
+			continue
+		}
+		if current, exists := s.pending[st.Key]; !exists || compareMarks(mark, current) > 0 {
+			s.pending[st.Key] = mark
+		}
+	}
+}
+
+// trackResponse cursor 상태 값 갱신 (마지막 응답의 값)
+func (s *HTTPSource) trackResponse(body any) {
+	if len(s.state) == 0 {
+		return
+	}
+
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	for _, st := range s.state {
+		if st.Cursor == "" {
+			continue
+		}
+		v, ok := lookupJSONPath(body, st.Cursor)
+		if !ok {
+			continue
+		}
+		if mark, ok := jsonMark(v); ok && mark != "" {
+			s.pending[st.Key] = mark
+		}
+	}
+}
+
+// commitState 실행이 성공적으로 끝난 경우 추적한 값을 상태에 반영
+// field 상태는 기존 값보다 클 때만 갱신한다
+func (s *HTTPSource) commitState() {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	for _, st := range s.state {
+		v, ok := s.pending[st.Key]
+		if !ok {
+			continue
+		}
+		if current, exists := s.values[st.Key]; exists && st.Field != "" && compareMarks(v, current) <= 0 {
+			continue
+		}
+		s.values[st.Key] = v
+	}
+	s.pending = nil
+}
+
+// GetCheckpoint 현재 상태 값 반환 (성공한 실행까지 반영)
+func (s *HTTPSource) GetCheckpoint() map[string]any {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	checkpoint := make(map[string]any, len(s.values))
+	for key, v := range s.values {
+		checkpoint[key] = map[string]any{
+			"value": formatMark(v),
+			"type":  markType(v),
+		}
+	}
+	return checkpoint
+}
+
+// SetCheckpoint 체크포인트 설정 (복구용)
+func (s *HTTPSource) SetCheckpoint(checkpoint map[string]any) error {
+	s.stateMu.Lock()
+	defer s.stateMu.Unlock()
+
+	for _, st := range s.state {
+		state, ok := checkpoint[st.Key].(map[string]any)
+		if !ok {
+			continue
+		}
+		value, _ := state["value"].(string)
+		kind, _ := state["type"].(string)
+		mark, err := parseMark(value, kind)
+		if err != nil {
+			return fmt.Errorf("invalid state value for %s: %w", st.Key, err)
+		}
+		s.values[st.Key] = mark
+	}
+	return nil
+}
+
+// jsonMark JSON 값을 비교 가능한 상태 값으로 변환 (정수 숫자는 int64)
+func jsonMark(v any) (any, bool) {
+	if f, ok := v.(float64); ok && f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		return int64(f), true
+	}
+	if b, ok := v.(bool); ok {
+		return fmt.Sprint(b), true
+	}
+	return normalizeMark(v)
+}
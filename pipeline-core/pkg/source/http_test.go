@@ -0,0 +1,394 @@
+package source
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func readHTTPRecords(t *testing.T, cfg config.SourceV2) ([]Record, error) {
+	t.Helper()
+
+	src, err := NewHTTPSource(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return readSourceRecords(src)
+}
+
+func readSourceRecords(src *HTTPSource) ([]Record, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	records, errs := src.Read(ctx)
+	var out []Record
+	for r := range records {
+		out = append(out, r)
+	}
+	return out, <-errs
+}
+
+func httpRecordIDs(records []Record) []int {
+	ids := make([]int, len(records))
+	for i, r := range records {
+		if id, ok := r.Data["id"].(float64); ok {
+			ids[i] = int(id)
+		}
+	}
+	return ids
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func items(from, to int) []any {
+	var out []any
+	for i := from; i < to; i++ {
+		out = append(out, map[string]any{"id": i})
+	}
+	return out
+}
+
+func TestHTTPSourcePagination(t *testing.T) {
+	const total = 5
+
+	mux := http.NewServeMux()
+	// next_url: 상대 경로 다음 URL
+	mux.HandleFunc("/next", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("p"))
+		resp := map[string]any{"result": map[string]any{"items": items(page*2, min(page*2+2, total))}}
+		if page*2+2 < total {
+			resp["meta"] = map[string]any{"next": fmt.Sprintf("/next?p=%d", page+1)}
+		}
+		writeJSON(w, resp)
+	})
+	// offset/limit
+	mux.HandleFunc("/offset", func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("take"))
+		writeJSON(w, items(offset, min(offset+limit, total)))
+	})
+	// page number
+	mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start := (page - 1) * size
+		writeJSON(w, map[string]any{"data": items(min(start, total), min(start+size, total))})
+	})
+	// cursor (query)
+	mux.HandleFunc("/cursor", func(w http.ResponseWriter, r *http.Request) {
+		start, _ := strconv.Atoi(r.URL.Query().Get("after"))
+		resp := map[string]any{"records": items(start, min(start+2, total)), "paging": map[string]any{"cursor": nil}}
+		if start+2 < total {
+			resp["paging"] = map[string]any{"cursor": strconv.Itoa(start + 2)}
+		}
+		writeJSON(w, resp)
+	})
+	// cursor (request body)
+	mux.HandleFunc("/search", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query string `json:"query"`
+			After int    `json:"search_after"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		if req.Query != "all" {
+			http.Error(w, "missing query", http.StatusBadRequest)
+			return
+		}
+		resp := map[string]any{"hits": items(req.After, min(req.After+2, total))}
+		if req.After+2 < total {
+			resp["next"] = req.After + 2
+		}
+		writeJSON(w, resp)
+	})
+	// RFC 5988 Link 헤더
+	mux.HandleFunc("/link", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		if page*2 < total {
+			w.Header().Set("Link", fmt.Sprintf(`</link?page=%d>; rel="next", </link?page=3>; rel="last"`, page+1))
+		}
+		writeJSON(w, items((page-1)*2, min(page*2, total)))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		path   string
+		cfg    config.PaginationConfig
+	}{
+		{name: "next_url", path: "/next", cfg: config.PaginationConfig{Type: "next_url", NextField: "$.meta.next", DataField: "$.result.items"}},
+		{name: "offset", path: "/offset", cfg: config.PaginationConfig{Type: "offset", PageSize: 2, OffsetParam: "skip", LimitParam: "take"}},
+		{name: "page", path: "/page", cfg: config.PaginationConfig{Type: "page", PageSize: 2, DataField: "data"}},
+		{name: "cursor", path: "/cursor", cfg: config.PaginationConfig{Type: "cursor", NextField: "paging.cursor", DataField: "records", CursorParam: "after"}},
+		{name: "cursor in body", path: "/search", method: http.MethodPost, body: `{"query":"all"}`,
+			cfg: config.PaginationConfig{Type: "cursor", NextField: "next", DataField: "hits", CursorParam: "search_after", CursorIn: "body"}},
+		{name: "link_header", path: "/link", cfg: config.PaginationConfig{Type: "link_header"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pagination := tt.cfg
+			records, err := readHTTPRecords(t, config.SourceV2{
+				URL:        server.URL + tt.path,
+				Method:     tt.method,
+				Body:       tt.body,
+				Pagination: &pagination,
+			})
+			if err != nil {
+				t.Fatalf("Read failed: %v", err)
+			}
+			if got := httpRecordIDs(records); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+				t.Errorf("ids = %v", got)
+			}
+		})
+	}
+}
+
+func TestHTTPSourceRetry(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/throttled":
+			if calls.Add(1) <= 2 {
+				w.Header().Set("Retry-After", "0")
+				http.Error(w, "slow down", http.StatusTooManyRequests)
+				return
+			}
+			writeJSON(w, map[string]any{"id": 1})
+		case "/broken":
+			calls.Add(1)
+			http.Error(w, "boom", http.StatusBadGateway)
+		case "/invalid":
+			calls.Add(1)
+			http.Error(w, "bad request", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		path      string
+		wantCalls int32
+		wantErr   bool
+	}{
+		{path: "/throttled", wantCalls: 3},
+		{path: "/broken", wantCalls: 3, wantErr: true}, // 최초 요청 + 재시도 2회
+		{path: "/invalid", wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			calls.Store(0)
+			records, err := readHTTPRecords(t, config.SourceV2{
+				URL:        server.URL + tt.path,
+				MaxRetries: 2,
+				RetryDelay: 1,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && len(records) != 1 {
+				t.Errorf("records = %d", len(records))
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestHTTPSourceRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		writeJSON(w, items(page, page+1))
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	records, err := readHTTPRecords(t, config.SourceV2{
+		URL:        server.URL,
+		RateLimit:  20,
+		Pagination: &config.PaginationConfig{Type: "page", MaxPages: 4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("records = %d", len(records))
+	}
+	// 20 req/s: 요청 4개 사이 간격 3개 = 150ms 이상
+	if elapsed := time.Since(start); elapsed < 140*time.Millisecond {
+		t.Errorf("elapsed %v, expected rate limiting", elapsed)
+	}
+}
+
+func TestHTTPSourceState(t *testing.T) {
+	events := []map[string]any{
+		{"id": 1, "updated_at": "2024-01-02T00:00:00Z"},
+		{"id": 2, "updated_at": "2024-01-04T00:00:00Z"},
+		{"id": 3, "updated_at": "2024-01-03T00:00:00Z"},
+	}
+	var failing atomic.Bool
+	var lastToken atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		lastToken.Store(r.Header.Get("X-Sync-Token"))
+		since := r.URL.Query().Get("since")
+		out := []any{}
+		for _, e := range events {
+			if e["updated_at"].(string) > since {
+				out = append(out, e)
+			}
+		}
+		writeJSON(w, map[string]any{"data": out, "sync_token": fmt.Sprintf("t%d", len(out))})
+	}))
+	defer server.Close()
+
+	cfg := config.SourceV2{
+		URL:        server.URL + "/events?since={{.state.updated_at | urlquery}}",
+		Headers:    map[string]string{"X-Sync-Token": "{{.state.token}}"},
+		MaxRetries: -1,
+		State: []config.HTTPStateConfig{
+			{Key: "updated_at", Field: "updated_at", Initial: "2024-01-01T00:00:00Z"},
+			{Key: "token", Cursor: "$.sync_token"},
+		},
+	}
+
+	first, err := NewHTTPSource(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if records, err := readSourceRecords(first); err != nil || len(records) != 3 {
+		t.Fatalf("first run: %d records, err %v", len(records), err)
+	}
+	checkpoint := first.GetCheckpoint()
+	want := map[string]any{
+		"updated_at": map[string]any{"value": "2024-01-04T00:00:00Z", "type": "string"},
+		"token":      map[string]any{"value": "t3", "type": "string"},
+	}
+	if !reflect.DeepEqual(checkpoint, want) {
+		t.Fatalf("checkpoint = %v", checkpoint)
+	}
+
+	// 저장된 상태로 다음 실행: since와 토큰이 요청에 반영됨
+	second, err := NewHTTPSource(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := second.SetCheckpoint(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if records, err := readSourceRecords(second); err != nil || len(records) != 0 {
+		t.Fatalf("second run: %d records, err %v", len(records), err)
+	}
+	if got := lastToken.Load(); got != "t3" {
+		t.Errorf("sync token header = %v", got)
+	}
+	if got := second.GetCheckpoint()["updated_at"]; !reflect.DeepEqual(got, want["updated_at"]) {
+		t.Errorf("updated_at after empty run = %v", got)
+	}
+
+	// 실패한 실행은 상태를 바꾸지 않음
+	failing.Store(true)
+	if _, err := readSourceRecords(second); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := second.GetCheckpoint()["token"]; !reflect.DeepEqual(got, map[string]any{"value": "t0", "type": "string"}) {
+		t.Errorf("token after failed run = %v", got)
+	}
+}
+
+func TestHTTPSourceInvalidTemplate(t *testing.T) {
+	if _, err := NewHTTPSource(config.SourceV2{URL: "http://example.com/?since={{.state.since"}); err == nil {
+		t.Fatal("expected template parse error")
+	}
+}
+
+func TestLookupJSONPath(t *testing.T) {
+	data := map[string]any{
+		"data": map[string]any{
+			"items": []any{
+				map[string]any{"id": 1.0, "tags": []any{"a", "b"}},
+				map[string]any{"id": 2.0},
+			},
+		},
+		"next-page": "/p2",
+	}
+
+	tests := []struct {
+		path string
+		want any
+		ok   bool
+	}{
+		{"data.items[0].id", 1.0, true},
+		{"$.data.items[1].id", 2.0, true},
+		{"$.data.items[-1].id", 2.0, true},
+		{"$['next-page']", "/p2", true},
+		{"$.data.items[*].id", []any{1.0, 2.0}, true},
+		{"data.items[0].tags[1]", "b", true},
+		{"data.items[5]", nil, false},
+		{"data.missing", nil, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := lookupJSONPath(data, tt.path)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("lookupJSONPath(%q) = %v, %v; want %v, %v", tt.path, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseHTTPHeaders(t *testing.T) {
+	links := parseLinkHeader([]string{
+		`<https://api.example.com/items?page=2>; rel="next", <https://api.example.com/items?page=9>; rel="last"`,
+		`<https://api.example.com/items?page=1>; rel="first prev"`,
+	})
+	want := map[string]string{
+		"next":  "https://api.example.com/items?page=2",
+		"last":  "https://api.example.com/items?page=9",
+		"first": "https://api.example.com/items?page=1",
+		"prev":  "https://api.example.com/items?page=1",
+	}
+	if !reflect.DeepEqual(links, want) {
+		t.Errorf("links = %v", links)
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	retryTests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"3", 3 * time.Second, true},
+		{"Mon, 01 Jan 2024 00:00:10 GMT", 10 * time.Second, true},
+		{"Sun, 31 Dec 2023 23:00:00 GMT", 0, true},
+		{"", 0, false},
+		{"soon", 0, false},
+	}
+	for _, tt := range retryTests {
+		got, ok := parseRetryAfter(tt.value, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("parseRetryAfter(%q) = %v, %v", tt.value, got, ok)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package source
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// pathToken JSONPath 경로 구성 요소
+type pathToken struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// lookupJSONPath JSONPath 형식 경로로 값 조회
+// 지원 형식: "$.data.items", "data.items", "items[0].id", "$['next-page']", "results[*].id"
+// [*]는 배열의 각 요소에 나머지 경로를 적용한 결과 배열을 반환한다
+func lookupJSONPath(data any, path string) (any, bool) {
+	tokens, err := parseJSONPath(path)
+	if err != nil {
+		return nil, false
+	}
+	return walkJSONPath(data, tokens)
+}
+
+func walkJSONPath(current any, tokens []pathToken) (any, bool) {
+	for i, tok := range tokens {
+		switch {
+		case tok.wildcard:
+			arr, ok := current.([]any)
+			if !ok {
+				if m, isMap := current.(map[string]any); isMap {
+					arr = make([]any, 0, len(m))
+					for _, v := range m {
+						arr = append(arr, v)
+					}
+				} else {
+					return nil, false
+				}
+			}
+			rest := tokens[i+1:]
+			if len(rest) == 0 {
+				return arr, true
+			}
+			out := make([]any, 0, len(arr))
+			for _, item := range arr {
+				if v, ok := walkJSONPath(item, rest); ok {
+					out = append(out, v)
+				}
+			}
+			return out, true
+
+		case tok.isIndex:
+			arr, ok := current.([]any)
+			if !ok {
+				return nil, false
+			}
+			idx := tok.index
+			if idx < 0 {
+				idx += len(arr)
+			}
+			if idx < 0 || idx >= len(arr) {
+				return nil, false
+			}
+			current = arr[idx]
+
+		default:
+			m, ok := current.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			v, exists := m[tok.key]
+			if !exists {
+				return nil, false
+			}
+			current = v
+		}
+	}
+	return current, true
+}
+
+func parseJSONPath(path string) ([]pathToken, error) {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "$")
+
+	var tokens []pathToken
+	for i := 0; i < len(path); {
+		switch path[i] {
+		case '.':
+			i++
+			if i < len(path) && path[i] == '*' {
+				tokens = append(tokens, pathToken{wildcard: true})
+				i++
+				continue
+			}
+			start := i
+			for i < len(path) && path[i] != '.' && path[i] != '[' {
+				i++
+			}
+			if start == i {
+				return nil, fmt.Errorf("empty key at %d", start)
+			}
+			tokens = append(tokens, pathToken{key: path[start:i]})
+
+		case '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed bracket at %d", i)
+			}
+			inner := strings.TrimSpace(path[i+1 : i+end])
+			i += end + 1
+
+			switch {
+			case inner == "*":
+				tokens = append(tokens, pathToken{wildcard: true})
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				tokens = append(tokens, pathToken{key: inner[1 : len(inner)-1]})
+			default:
+				idx, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid index %q", inner)
+				}
+				tokens = append(tokens, pathToken{index: idx, isIndex: true})
+			}
+
+		default:
+			// 선행 '.' 없이 시작하는 키
+			start := i
+			for i < len(path) && path[i] != '.' && path[i] != '[' {
+				i++
+			}
+			tokens = append(tokens, pathToken{key: path[start:i]})
+		}
+	}
+	return tokens, nil
+}
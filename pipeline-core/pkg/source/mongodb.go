@@ -0,0 +1,380 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// MongoDBSource MongoDB 컬렉션 읽기
+// find 모드: _id 순으로 필터 결과를 읽으며 마지막 _id를 체크포인트로 저장해 중단 지점부터 재개
+// change stream 모드: 변경 이벤트를 CDC 레코드 형식으로 전달하며 resume token으로 재개
+type MongoDBSource struct {
+	uri          string
+	database     string
+	collection   string
+	filter       map[string]any
+	changeStream bool
+	batchSize    int
+
+	client *mongo.Client
+
+	mu          sync.RWMutex
+	lastID      any    // find 모드: 마지막으로 전달한 문서의 _id (전체를 읽으면 초기화)
+	resumeToken string // change stream 모드: 마지막 이벤트의 resume token (_data)
+}
+
+// NewMongoDBSource MongoDB 소스 생성
+func NewMongoDBSource(cfg config.SourceV2) (*MongoDBSource, error) {
+	if cfg.URI == "" {
+		return nil, fmt.Errorf("mongodb uri is required")
+	}
+	if cfg.Database == "" || cfg.Collection == "" {
+		return nil, fmt.Errorf("mongodb database and collection are required")
+	}
+
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	return &MongoDBSource{
+		uri:          cfg.URI,
+		database:     cfg.Database,
+		collection:   cfg.Collection,
+		filter:       cfg.QueryFilter,
+		changeStream: cfg.ChangeStream,
+		batchSize:    batchSize,
+	}, nil
+}
+
+func (s *MongoDBSource) Name() string {
+	return "mongodb"
+}
+
+func (s *MongoDBSource) Open(ctx context.Context) error {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(s.uri))
+	if err != nil {
+		return fmt.Errorf("failed to connect to mongodb: %w", err)
+	}
+	if err := client.Ping(ctx, nil); err != nil {
+		_ = client.Disconnect(context.Background())
+		return fmt.Errorf("failed to ping mongodb: %w", err)
+	}
+	s.client = client
+	return nil
+}
+
+func (s *MongoDBSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
+	records := make(chan Record, s.batchSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(records)
+		defer close(errs)
+
+		var err error
+		if s.changeStream {
+			err = s.watch(ctx, records)
+		} else {
+			err = s.find(ctx, records)
+		}
+		if err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return records, errs
+}
+
+// find 필터 결과를 _id 순으로 읽음
+func (s *MongoDBSource) find(ctx context.Context, records chan<- Record) error {
+	if s.client == nil {
+		return fmt.Errorf("mongodb client not connected")
+	}
+
+	s.mu.RLock()
+	lastID := s.lastID
+	s.mu.RUnlock()
+
+	coll := s.client.Database(s.database).Collection(s.collection)
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetBatchSize(int32(s.batchSize))
+
+	cursor, err := coll.Find(ctx, s.findFilter(lastID), opts)
+	if err != nil {
+		return fmt.Errorf("find failed: %w", err)
+	}
+	defer cursor.Close(context.Background())
+
+	origin := s.database + "." + s.collection
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode document: %w", err)
+		}
+		id := doc["_id"]
+
+		select {
+		case records <- Record{
+			Data: normalizeBSON(doc).(map[string]any),
+			Metadata: Metadata{
+				Source:    "mongodb",
+				Origin:    origin,
+				Offset:    fmt.Sprint(normalizeBSON(id)),
+				Timestamp: time.Now().UnixMilli(),
+			},
+		}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		s.mu.Lock()
+		s.lastID = id
+		s.mu.Unlock()
+	}
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("cursor error: %w", err)
+	}
+
+	s.mu.Lock()
+	s.lastID = nil
+	s.mu.Unlock()
+	return nil
+}
+
+// findFilter query_filter와 재개 조건(_id > lastID) 결합
+func (s *MongoDBSource) findFilter(lastID any) bson.M {
+	var conditions []bson.M
+	if len(s.filter) > 0 {
+		conditions = append(conditions, bson.M(s.filter))
+	}
+	if lastID != nil {
+		conditions = append(conditions, bson.M{"_id": bson.M{"$gt": lastID}})
+	}
+
+	switch len(conditions) {
+	case 0:
+		return bson.M{}
+	case 1:
+		return conditions[0]
+	}
+	return bson.M{"$and": conditions}
+}
+
+// mongoChangeEvent change stream 이벤트 중 필요한 부분
+type mongoChangeEvent struct {
+	OperationType string              `bson:"operationType"`
+	FullDocument  bson.M              `bson:"fullDocument"`
+	DocumentKey   bson.M              `bson:"documentKey"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	Ns            struct {
+		DB   string `bson:"db"`
+		Coll string `bson:"coll"`
+	} `bson:"ns"`
+	UpdateDescription *struct {
+		RemovedFields []string `bson:"removedFields"`
+	} `bson:"updateDescription"`
+}
+
+// watch change stream으로 변경 이벤트 수신 (컨텍스트 취소 또는 스트림 무효화까지)
+func (s *MongoDBSource) watch(ctx context.Context, records chan<- Record) error {
+	if s.client == nil {
+		return fmt.Errorf("mongodb client not connected")
+	}
+
+	pipeline := mongo.Pipeline{}
+	if len(s.filter) > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M(s.filter)}})
+	}
+
+	opts := options.ChangeStream().
+		SetFullDocument(options.UpdateLookup).
+		SetBatchSize(int32(s.batchSize))
+	s.mu.RLock()
+	if s.resumeToken != "" {
+		// startAfter는 invalidate 이후에도 재개 가능
+		opts.SetStartAfter(bson.M{"_data": s.resumeToken})
+	}
+	s.mu.RUnlock()
+
+	coll := s.client.Database(s.database).Collection(s.collection)
+	stream, err := coll.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return fmt.Errorf("failed to open change stream: %w", err)
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var event mongoChangeEvent
+		if err := stream.Decode(&event); err != nil {
+			return fmt.Errorf("failed to decode change event: %w", err)
+		}
+
+		if record, ok := convertChangeEvent(event); ok {
+			select {
+			case records <- record:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if token, ok := stream.ResumeToken().Lookup("_data").StringValueOK(); ok {
+			s.mu.Lock()
+			s.resumeToken = token
+			s.mu.Unlock()
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("change stream error: %w", err)
+	}
+	return nil
+}
+
+// convertChangeEvent change stream 이벤트를 CDC 레코드로 변환 (문서 변경이 아닌 이벤트는 false)
+func convertChangeEvent(event mongoChangeEvent) (Record, bool) {
+	var eventType CDCEventType
+	switch event.OperationType {
+	case "insert":
+		eventType = CDCEventInsert
+	case "update", "replace":
+		eventType = CDCEventUpdate
+	case "delete":
+		eventType = CDCEventDelete
+	default:
+		return Record{}, false
+	}
+
+	timestamp := time.Unix(int64(event.ClusterTime.T), 0)
+	data := map[string]any{
+		"_cdc_type":  string(eventType),
+		"_op":        eventType.Op(),
+		"_database":  event.Ns.DB,
+		"_table":     event.Ns.Coll,
+		"_timestamp": timestamp,
+	}
+
+	// 삭제 이벤트는 문서가 없으므로 documentKey만 전달
+	doc := event.FullDocument
+	if doc == nil {
+		doc = event.DocumentKey
+	}
+	for k, v := range doc {
+		data[k] = normalizeBSON(v)
+	}
+	if id, ok := event.DocumentKey["_id"]; ok {
+		data["_primary_key"] = []any{normalizeBSON(id)}
+	}
+	if event.UpdateDescription != nil && len(event.UpdateDescription.RemovedFields) > 0 {
+		data["_removed_fields"] = event.UpdateDescription.RemovedFields
+	}
+
+	return Record{
+		Data: data,
+		Metadata: Metadata{
+			Source:    "mongodb",
+			Origin:    event.Ns.DB + "." + event.Ns.Coll,
+			Timestamp: timestamp.UnixMilli(),
+		},
+	}, true
+}
+
+// normalizeBSON BSON 값을 일반 Go 값으로 변환
+// ObjectID는 hex 문자열, 날짜는 time.Time, Decimal128은 문자열로 바꾼다
+func normalizeBSON(v any) any {
+	switch val := v.(type) {
+	case bson.M:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[k] = normalizeBSON(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[k] = normalizeBSON(item)
+		}
+		return out
+	case bson.D:
+		out := make(map[string]any, len(val))
+		for _, e := range val {
+			out[e.Key] = normalizeBSON(e.Value)
+		}
+		return out
+	case bson.A:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = normalizeBSON(item)
+		}
+		return out
+	case primitive.ObjectID:
+		return val.Hex()
+	case primitive.DateTime:
+		return val.Time().UTC()
+	case primitive.Timestamp:
+		return time.Unix(int64(val.T), 0).UTC()
+	case primitive.Decimal128:
+		return val.String()
+	case primitive.Binary:
+		return val.Data
+	case primitive.Regex:
+		return val.String()
+	case primitive.Null, primitive.Undefined:
+		return nil
+	}
+	return v
+}
+
+// GetCheckpoint 현재 체크포인트 반환
+// _id는 타입(ObjectID 등)을 유지하도록 Extended JSON으로 저장한다
+func (s *MongoDBSource) GetCheckpoint() map[string]any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	checkpoint := make(map[string]any)
+	if s.resumeToken != "" {
+		checkpoint["resume_token"] = s.resumeToken
+	}
+	if s.lastID != nil {
+		if b, err := bson.MarshalExtJSON(bson.D{{Key: "_id", Value: s.lastID}}, true, false); err == nil {
+			checkpoint["last_id"] = string(b)
+		}
+	}
+	return checkpoint
+}
+
+// SetCheckpoint 체크포인트 설정 (복구용)
+func (s *MongoDBSource) SetCheckpoint(checkpoint map[string]any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if v, ok := checkpoint["resume_token"].(string); ok {
+		s.resumeToken = v
+	}
+	if v, ok := checkpoint["last_id"].(string); ok && v != "" {
+		var doc bson.D
+		if err := bson.UnmarshalExtJSON([]byte(v), true, &doc); err != nil || len(doc) == 0 {
+			return fmt.Errorf("invalid last_id checkpoint %q: %v", v, err)
+		}
+		s.lastID = doc[0].Value
+	}
+	return nil
+}
+
+func (s *MongoDBSource) Close() error {
+	if s.client != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return s.client.Disconnect(ctx)
+	}
+	return nil
+}
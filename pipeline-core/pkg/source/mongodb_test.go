@@ -0,0 +1,117 @@
+package source
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func TestNormalizeBSON(t *testing.T) {
+	oid, _ := primitive.ObjectIDFromHex("65a1b2c3d4e5f60718293a4b")
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	dec, _ := primitive.ParseDecimal128("12.50")
+
+	got := normalizeBSON(bson.M{
+		"_id":     oid,
+		"created": primitive.NewDateTimeFromTime(created),
+		"price":   dec,
+		"tags":    bson.A{"a", bson.M{"k": oid}},
+		"nested":  bson.D{{Key: "n", Value: int32(1)}},
+		"missing": primitive.Null{},
+	})
+
+	want := map[string]any{
+		"_id":     "65a1b2c3d4e5f60718293a4b",
+		"created": created,
+		"price":   "12.50",
+		"tags":    []any{"a", map[string]any{"k": "65a1b2c3d4e5f60718293a4b"}},
+		"nested":  map[string]any{"n": int32(1)},
+		"missing": nil,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("normalizeBSON = %#v", got)
+	}
+}
+
+func TestMongoDBFindFilter(t *testing.T) {
+	src, err := NewMongoDBSource(config.SourceV2{
+		URI:         "mongodb://localhost:27017",
+		Database:    "app",
+		Collection:  "users",
+		QueryFilter: map[string]any{"status": "active"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := src.findFilter(nil); !reflect.DeepEqual(got, bson.M{"status": "active"}) {
+		t.Errorf("filter = %v", got)
+	}
+	want := bson.M{"$and": []bson.M{{"status": "active"}, {"_id": bson.M{"$gt": int64(7)}}}}
+	if got := src.findFilter(int64(7)); !reflect.DeepEqual(got, want) {
+		t.Errorf("resume filter = %v", got)
+	}
+}
+
+func TestMongoDBCheckpoint(t *testing.T) {
+	oid := primitive.NewObjectID()
+	src := &MongoDBSource{lastID: oid, resumeToken: "8263A1"}
+
+	checkpoint := src.GetCheckpoint()
+	restored := &MongoDBSource{}
+	if err := restored.SetCheckpoint(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	// _id 타입(ObjectID)이 유지되어야 $gt 비교가 동작
+	if restored.lastID != oid {
+		t.Errorf("last_id = %#v, want %v", restored.lastID, oid)
+	}
+	if restored.resumeToken != "8263A1" {
+		t.Errorf("resume_token = %q", restored.resumeToken)
+	}
+
+	if err := restored.SetCheckpoint(map[string]any{"last_id": "not json"}); err == nil {
+		t.Error("expected error for invalid last_id")
+	}
+}
+
+func TestConvertChangeEvent(t *testing.T) {
+	oid := primitive.NewObjectID()
+	event := mongoChangeEvent{
+		OperationType: "update",
+		FullDocument:  bson.M{"_id": oid, "name": "kim"},
+		DocumentKey:   bson.M{"_id": oid},
+		ClusterTime:   primitive.Timestamp{T: 1700000000},
+	}
+	event.Ns.DB, event.Ns.Coll = "app", "users"
+
+	record, ok := convertChangeEvent(event)
+	if !ok {
+		t.Fatal("update event should be converted")
+	}
+	if record.Data["_cdc_type"] != "update" || record.Data["_op"] != "u" || record.Data["name"] != "kim" {
+		t.Errorf("data = %v", record.Data)
+	}
+	if !reflect.DeepEqual(record.Data["_primary_key"], []any{oid.Hex()}) {
+		t.Errorf("primary key = %v", record.Data["_primary_key"])
+	}
+	if record.Metadata.Origin != "app.users" || record.Metadata.Timestamp != 1700000000000 {
+		t.Errorf("metadata = %+v", record.Metadata)
+	}
+
+	event.OperationType, event.FullDocument = "delete", nil
+	record, _ = convertChangeEvent(event)
+	if record.Data["_op"] != "d" || record.Data["_id"] != oid.Hex() {
+		t.Errorf("delete data = %v", record.Data)
+	}
+
+	event.OperationType = "drop"
+	if _, ok := convertChangeEvent(event); ok {
+		t.Error("drop event should be skipped")
+	}
+}
@@ -0,0 +1,74 @@
+package source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// Preview 소스에서 최대 limit개의 레코드를 읽고 닫음 (스키마 추론 등 미리보기용)
+// 레코드를 Ack하지 않고 체크포인트도 남기지 않으며, 읽기만으로 외부 상태를 바꾸는 설정
+// (kafka 컨슈머 그룹 커밋, S3 after_read, sql_event outbox 시도 횟수 갱신, 파일 tail)은 끄고 실행한다.
+// ctx가 끝나면 그때까지 읽은 레코드를 반환한다
+func Preview(ctx context.Context, cfg config.SourceV2, limit int) ([]Record, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("preview limit must be positive")
+	}
+
+	switch cfg.Type {
+	case "http_server", "redis", "cdc":
+		// 요청 수신 대기, 컨슈머 그룹 pending 등록, 복제 슬롯 생성처럼 읽기 자체가 상태를 남김
+		return nil, fmt.Errorf("preview is not supported for %s source", cfg.Type)
+	case "kafka":
+		cfg.GroupID = ""
+	case "sql_event":
+		cfg.Outbox = nil // outbox 조회는 attempts 컬럼을 올리므로 일반 이벤트 테이블처럼 읽음
+	}
+	cfg.AfterRead = nil
+	cfg.Tail = false
+
+	src, err := NewSource(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if err := src.Open(ctx); err != nil {
+		return nil, fmt.Errorf("failed to open source: %w", err)
+	}
+	defer func() {
+		cancel() // 읽기 고루틴을 먼저 멈추고 닫음
+		src.Close()
+	}()
+
+	records, errs := src.Read(ctx)
+	result := make([]Record, 0, limit)
+	for len(result) < limit {
+		select {
+		case r, ok := <-records:
+			if !ok {
+				if errs != nil {
+					if err := <-errs; err != nil {
+						return result, err
+					}
+				}
+				return result, nil
+			}
+			result = append(result, r)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			if err != nil {
+				return result, err
+			}
+		case <-ctx.Done():
+			return result, nil
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package source
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func TestPreviewLimit(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// count 없는 generate 소스는 끝나지 않으므로 limit에서 멈춰야 함
+	records, err := Preview(ctx, config.SourceV2{Type: "generate", Mapping: `root.n = this.index`, Seed: 1}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 5 || records[4].Data["n"] != 5 {
+		t.Errorf("records = %v", records)
+	}
+}
+
+func TestPreviewFileTailDisabled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.json")
+	if err := os.WriteFile(path, []byte("{\"id\": 1}\n{\"id\": 2}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// tail이 꺼지므로 파일 끝에서 대기하지 않고 바로 반환
+	start := time.Now()
+	records, err := Preview(ctx, config.SourceV2{Type: "file", Path: path, Format: "ndjson", Tail: true}, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Errorf("records = %v", records)
+	}
+	if time.Since(start) > 3*time.Second {
+		t.Error("preview waited for tail")
+	}
+}
+
+func TestPreviewUnsupported(t *testing.T) {
+	for _, typ := range []string{"http_server", "redis", "cdc"} {
+		if _, err := Preview(context.Background(), config.SourceV2{Type: typ}, 10); err == nil {
+			t.Errorf("%s: expected error", typ)
+		}
+	}
+	if _, err := Preview(context.Background(), config.SourceV2{Type: "generate", Mapping: `root = {}`}, 0); err == nil {
+		t.Error("expected error for zero limit")
+	}
+}
+
+func TestPreviewOutboxReadOnly(t *testing.T) {
+	_, db := newOutboxTestSource(t, &config.OutboxConfig{OnAck: "delete", AttemptsColumn: "attempts", MaxAttempts: 3})
+	insertOutbox(t, db, 1, 2)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var dsn string
+	if err := db.QueryRow(`SELECT file FROM pragma_database_list WHERE name = 'main'`).Scan(&dsn); err != nil {
+		t.Fatal(err)
+	}
+	records, err := Preview(ctx, config.SourceV2{
+		Type:   "sql_event",
+		Driver: "sqlite",
+		DSN:    dsn,
+		Table:  "outbox",
+		Outbox: &config.OutboxConfig{OnAck: "delete", AttemptsColumn: "attempts", MaxAttempts: 3},
+	}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Errorf("records = %v", records)
+	}
+	// outbox 모드가 꺼지므로 시도 횟수가 올라가지 않음
+	if got := queryInts(t, db, `SELECT attempts FROM outbox ORDER BY id`); len(got) != 2 || got[0] != 0 || got[1] != 0 {
+		t.Errorf("attempts = %v", got)
+	}
+}
@@ -0,0 +1,308 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// RedisStreamSource Redis Streams 컨슈머 그룹 소스
+// XREADGROUP으로 읽고 싱크 기록이 확정된 메시지만 XACK한다 (Acknowledger).
+// 시작 시 자신의 확인되지 않은 메시지를 먼저 다시 읽고, 다른 컨슈머가 claim_idle 이상
+// 확인하지 않은 메시지(중단된 컨슈머)는 XAUTOCLAIM으로 가져와 다시 전달한다
+type RedisStreamSource struct {
+	options   *redis.Options
+	streams   []string
+	group     string
+	consumer  string
+	startID   string // 그룹 생성 시 시작 위치 ($: 이후 메시지, 0: 처음부터)
+	batchSize int
+	block     time.Duration
+	claimIdle time.Duration
+
+	client       *redis.Client
+	claimCursors map[string]string // 스트림별 XAUTOCLAIM 다음 시작 ID
+	lastClaim    time.Time
+}
+
+// NewRedisStreamSource Redis Streams 소스 생성
+func NewRedisStreamSource(cfg config.SourceV2) (*RedisStreamSource, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("redis url is required")
+	}
+	if len(cfg.Streams) == 0 {
+		return nil, fmt.Errorf("redis streams are required")
+	}
+	if cfg.GroupID == "" {
+		return nil, fmt.Errorf("redis group_id is required")
+	}
+
+	options, err := redis.ParseURL(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+	if cfg.Password != "" {
+		options.Password = cfg.Password
+	}
+
+	consumer := cfg.Consumer
+	if consumer == "" {
+		if consumer, err = os.Hostname(); err != nil || consumer == "" {
+			consumer = "conduix"
+		}
+	}
+
+	startID := "$" // default: latest
+	if cfg.StartOffset == "earliest" || cfg.StartOffset == "beginning" {
+		startID = "0"
+	}
+
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	block := time.Duration(cfg.MaxWait) * time.Millisecond
+	if block <= 0 {
+		block = time.Second
+	}
+
+	claimIdle := time.Minute
+	if cfg.ClaimIdle > 0 {
+		claimIdle = time.Duration(cfg.ClaimIdle) * time.Millisecond
+	} else if cfg.ClaimIdle < 0 {
+		claimIdle = 0
+	}
+
+	return &RedisStreamSource{
+		options:      options,
+		streams:      cfg.Streams,
+		group:        cfg.GroupID,
+		consumer:     consumer,
+		startID:      startID,
+		batchSize:    batchSize,
+		block:        block,
+		claimIdle:    claimIdle,
+		claimCursors: make(map[string]string),
+	}, nil
+}
+
+func (s *RedisStreamSource) Name() string {
+	return "redis"
+}
+
+func (s *RedisStreamSource) Open(ctx context.Context) error {
+	client := redis.NewClient(s.options)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return fmt.Errorf("failed to connect to redis: %w", err)
+	}
+
+	for _, stream := range s.streams {
+		err := client.XGroupCreateMkStream(ctx, stream, s.group, s.startID).Err()
+		if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			client.Close()
+			return fmt.Errorf("failed to create consumer group %s on %s: %w", s.group, stream, err)
+		}
+	}
+
+	s.client = client
+	return nil
+}
+
+func (s *RedisStreamSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
+	records := make(chan Record, s.batchSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(records)
+		defer close(errs)
+
+		if err := s.consume(ctx, records); err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return records, errs
+}
+
+func (s *RedisStreamSource) consume(ctx context.Context, records chan<- Record) error {
+	if s.client == nil {
+		return fmt.Errorf("redis client not connected")
+	}
+
+	if err := s.readOwnPending(ctx, records); err != nil {
+		return err
+	}
+
+	ids := make([]string, len(s.streams))
+	for i := range ids {
+		ids[i] = ">"
+	}
+
+	for ctx.Err() == nil {
+		if s.claimIdle > 0 && time.Since(s.lastClaim) >= s.claimIdle/2 {
+			if err := s.claimIdlePending(ctx, records); err != nil {
+				return err
+			}
+			s.lastClaim = time.Now()
+		}
+
+		result, err := s.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    s.group,
+			Consumer: s.consumer,
+			Streams:  append(append([]string{}, s.streams...), ids...),
+			Count:    int64(s.batchSize),
+			Block:    s.block,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue // block 시간 동안 새 메시지 없음
+		}
+		if err != nil {
+			return fmt.Errorf("xreadgroup failed: %w", err)
+		}
+
+		for _, stream := range result {
+			if err := s.emit(ctx, stream.Stream, stream.Messages, records); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+// readOwnPending 이전 실행에서 이 컨슈머에 전달되었지만 확인되지 않은 메시지 재전달
+func (s *RedisStreamSource) readOwnPending(ctx context.Context, records chan<- Record) error {
+	for _, stream := range s.streams {
+		after := "0"
+		for {
+			result, err := s.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+				Group:    s.group,
+				Consumer: s.consumer,
+				Streams:  []string{stream, after},
+				Count:    int64(s.batchSize),
+				Block:    -1, // 이력 조회는 대기하지 않음
+			}).Result()
+			if err != nil && !errors.Is(err, redis.Nil) {
+				return fmt.Errorf("failed to read pending messages of %s: %w", stream, err)
+			}
+			if len(result) == 0 || len(result[0].Messages) == 0 {
+				break
+			}
+
+			messages := result[0].Messages
+			if err := s.emit(ctx, stream, messages, records); err != nil {
+				return err
+			}
+			after = messages[len(messages)-1].ID
+		}
+	}
+	return nil
+}
+
+// claimIdlePending 다른 컨슈머가 claim_idle 이상 확인하지 않은 메시지를 가져와 전달
+func (s *RedisStreamSource) claimIdlePending(ctx context.Context, records chan<- Record) error {
+	for _, stream := range s.streams {
+		start := s.claimCursors[stream]
+		if start == "" {
+			start = "0-0"
+		}
+
+		messages, next, err := s.client.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   stream,
+			Group:    s.group,
+			Consumer: s.consumer,
+			MinIdle:  s.claimIdle,
+			Start:    start,
+			Count:    int64(s.batchSize),
+		}).Result()
+		if err != nil {
+			return fmt.Errorf("xautoclaim on %s failed: %w", stream, err)
+		}
+		s.claimCursors[stream] = next
+
+		if err := s.emit(ctx, stream, messages, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emit 스트림 메시지를 레코드로 전달
+// 삭제되어 값이 없는 메시지는 전달하지 않고 바로 확인 처리한다
+func (s *RedisStreamSource) emit(ctx context.Context, stream string, messages []redis.XMessage, records chan<- Record) error {
+	for _, msg := range messages {
+		if msg.Values == nil {
+			if err := s.client.XAck(ctx, stream, s.group, msg.ID).Err(); err != nil {
+				return fmt.Errorf("xack failed: %w", err)
+			}
+			continue
+		}
+
+		select {
+		case records <- Record{
+			Data: msg.Values,
+			Metadata: Metadata{
+				Source:    "redis",
+				Origin:    stream,
+				Offset:    msg.ID,
+				Timestamp: streamIDTime(msg.ID),
+			},
+		}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// streamIDTime 스트림 ID의 밀리초 시각 부분 (형식이 다르면 현재 시각)
+func streamIDTime(id string) int64 {
+	ms, _, _ := strings.Cut(id, "-")
+	if v, err := strconv.ParseInt(ms, 10, 64); err == nil {
+		return v
+	}
+	return time.Now().UnixMilli()
+}
+
+// Ack 싱크 기록이 확정된 메시지를 스트림별로 XACK
+func (s *RedisStreamSource) Ack(ctx context.Context, records []Record) error {
+	if s.client == nil || len(records) == 0 {
+		return nil
+	}
+
+	ids := make(map[string][]string)
+	for _, r := range records {
+		if r.Metadata.Source != "redis" || r.Metadata.Offset == "" {
+			continue
+		}
+		ids[r.Metadata.Origin] = append(ids[r.Metadata.Origin], r.Metadata.Offset)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	pipe := s.client.Pipeline()
+	for stream, streamIDs := range ids {
+		pipe.XAck(ctx, stream, s.group, streamIDs...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("xack failed: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisStreamSource) Close() error {
+	if s.client != nil {
+		return s.client.Close()
+	}
+	return nil
+}
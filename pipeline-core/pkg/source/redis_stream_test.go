@@ -0,0 +1,185 @@
+package source
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func newTestRedisSource(t *testing.T, mr *miniredis.Miniredis, consumer string, claimIdle int) *RedisStreamSource {
+	t.Helper()
+
+	src, err := NewRedisStreamSource(config.SourceV2{
+		URL:         "redis://" + mr.Addr(),
+		Streams:     []string{"orders"},
+		GroupID:     "etl",
+		Consumer:    consumer,
+		StartOffset: "earliest",
+		MaxWait:     50,
+		ClaimIdle:   claimIdle,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { src.Close() })
+	return src
+}
+
+// readRedisRecords n개의 레코드를 받을 때까지 읽고 소스를 멈춤
+func readRedisRecords(t *testing.T, src *RedisStreamSource, n int) []Record {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	records, errs := src.Read(ctx)
+	var out []Record
+	for len(out) < n {
+		select {
+		case r, ok := <-records:
+			if !ok {
+				t.Fatalf("records closed after %d: %v", len(out), <-errs)
+			}
+			out = append(out, r)
+		case <-ctx.Done():
+			t.Fatalf("timed out after %d records", len(out))
+		}
+	}
+	cancel()
+	for range records {
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	return out
+}
+
+func redisIDs(records []Record) []string {
+	ids := make([]string, len(records))
+	for i, r := range records {
+		ids[i], _ = r.Data["id"].(string)
+	}
+	return ids
+}
+
+func addOrders(t *testing.T, client *redis.Client, ids ...string) {
+	t.Helper()
+	for _, id := range ids {
+		if err := client.XAdd(context.Background(), &redis.XAddArgs{
+			Stream: "orders",
+			Values: map[string]any{"id": id},
+		}).Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func pendingCount(t *testing.T, client *redis.Client) int64 {
+	t.Helper()
+	pending, err := client.XPending(context.Background(), "orders", "etl").Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pending.Count
+}
+
+func TestRedisStreamSourceAck(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	addOrders(t, client, "o1", "o2", "o3")
+
+	src := newTestRedisSource(t, mr, "worker-1", -1)
+	records := readRedisRecords(t, src, 3)
+	if got := redisIDs(records); !reflect.DeepEqual(got, []string{"o1", "o2", "o3"}) {
+		t.Fatalf("ids = %v", got)
+	}
+	if records[0].Metadata.Source != "redis" || records[0].Metadata.Origin != "orders" || records[0].Metadata.Offset == "" {
+		t.Errorf("metadata = %+v", records[0].Metadata)
+	}
+	if got := pendingCount(t, client); got != 3 {
+		t.Fatalf("pending before ack = %d", got)
+	}
+
+	if err := src.Ack(context.Background(), records[:2]); err != nil {
+		t.Fatal(err)
+	}
+	if got := pendingCount(t, client); got != 1 {
+		t.Errorf("pending after ack = %d", got)
+	}
+}
+
+func TestRedisStreamSourceRedeliversOwnPending(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	addOrders(t, client, "o1", "o2")
+
+	first := newTestRedisSource(t, mr, "worker-1", -1)
+	records := readRedisRecords(t, first, 2)
+	if err := first.Ack(context.Background(), records[:1]); err != nil {
+		t.Fatal(err)
+	}
+	first.Close()
+
+	// 같은 컨슈머로 재시작하면 확인되지 않은 o2부터 다시 읽고 이어서 새 메시지를 읽음
+	addOrders(t, client, "o3")
+	restarted := newTestRedisSource(t, mr, "worker-1", -1)
+	if got := redisIDs(readRedisRecords(t, restarted, 2)); !reflect.DeepEqual(got, []string{"o2", "o3"}) {
+		t.Errorf("ids after restart = %v", got)
+	}
+}
+
+func TestRedisStreamSourceClaimsIdlePending(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	addOrders(t, client, "o1", "o2")
+
+	// worker-1이 읽고 확인하지 않은 채 중단됨
+	crashed := newTestRedisSource(t, mr, "worker-1", -1)
+	readRedisRecords(t, crashed, 2)
+	crashed.Close()
+
+	time.Sleep(30 * time.Millisecond)
+	survivor := newTestRedisSource(t, mr, "worker-2", 20)
+	records := readRedisRecords(t, survivor, 2)
+	if got := redisIDs(records); !reflect.DeepEqual(got, []string{"o1", "o2"}) {
+		t.Fatalf("claimed ids = %v", got)
+	}
+
+	if err := survivor.Ack(context.Background(), records); err != nil {
+		t.Fatal(err)
+	}
+	if got := pendingCount(t, client); got != 0 {
+		t.Errorf("pending after ack = %d", got)
+	}
+}
+
+func TestNewRedisStreamSourceInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.SourceV2
+	}{
+		{"no url", config.SourceV2{Streams: []string{"s"}, GroupID: "g"}},
+		{"no streams", config.SourceV2{URL: "redis://localhost:6379", GroupID: "g"}},
+		{"no group", config.SourceV2{URL: "redis://localhost:6379", Streams: []string{"s"}}},
+		{"bad url", config.SourceV2{URL: "http://localhost", Streams: []string{"s"}, GroupID: "g"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewRedisStreamSource(tt.cfg); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,444 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// S3Source S3 버킷의 객체 읽기
+// prefix 아래 객체를 나열해 pattern과 일치하는 객체를 파일 소스와 같은 포맷으로 디코딩한다.
+// 레코드가 모두 Ack된 객체의 키와 ETag를 체크포인트에 기록해 같은 객체는 한 번만 읽고,
+// 내용이 바뀐 객체(ETag 변경)는 다시 읽는다. 객체 중간에서 중단되면 다음 실행에서 처음부터 다시 읽는다.
+// after_read(이동/태그/삭제)도 객체의 레코드가 모두 싱크에 기록(Ack)된 뒤에 실행한다
+type S3Source struct {
+	bucket    string
+	prefix    string
+	pattern   string
+	region    string
+	endpoint  string
+	accessKey string
+	secretKey string
+	afterRead *config.S3AfterReadConfig
+	decoder   *FileSource // 다운로드한 객체 디코딩 (format, compression, record_path)
+
+	client *s3.Client
+
+	mu        sync.RWMutex
+	processed map[string]string     // 레코드가 모두 Ack된 객체 키 → ETag
+	pending   map[string]*s3Pending // 읽는 중이거나 Ack를 기다리는 객체 (레코드 Origin 기준)
+}
+
+// s3Object 목록 조회 결과 중 읽을 객체
+type s3Object struct {
+	key  string
+	etag string
+}
+
+// s3Pending 레코드가 모두 Ack되기를 기다리는 객체
+type s3Pending struct {
+	obj     s3Object
+	emitted int  // 전달한 레코드 수
+	acked   int  // Ack된 레코드 수
+	read    bool // 객체 끝까지 전달함
+}
+
+// NewS3Source S3 소스 생성
+func NewS3Source(cfg config.SourceV2) (*S3Source, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if cfg.Pattern != "" {
+		if _, err := path.Match(cfg.Pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid s3 pattern %s: %w", cfg.Pattern, err)
+		}
+	}
+	if a := cfg.AfterRead; a != nil {
+		switch a.Action {
+		case "delete", "tag":
+		case "move":
+			if a.Prefix == "" {
+				return nil, fmt.Errorf("s3 after_read move requires prefix")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported s3 after_read action: %s", a.Action)
+		}
+	}
+
+	decoder, err := NewFileSource(config.SourceV2{
+		Format:      cfg.Format,
+		Compression: cfg.Compression,
+		RecordPath:  cfg.RecordPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+	decoder.decodeOnly = true
+
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &S3Source{
+		bucket:    cfg.Bucket,
+		prefix:    cfg.Prefix,
+		pattern:   cfg.Pattern,
+		region:    region,
+		endpoint:  cfg.Endpoint,
+		accessKey: cfg.AccessKey,
+		secretKey: cfg.SecretKey,
+		afterRead: cfg.AfterRead,
+		decoder:   decoder,
+		processed: make(map[string]string),
+		pending:   make(map[string]*s3Pending),
+	}, nil
+}
+
+func (s *S3Source) Name() string {
+	return "s3"
+}
+
+func (s *S3Source) Open(ctx context.Context) error {
+	opts := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(s.region)}
+	if s.accessKey != "" {
+		opts = append(opts, awsconfig.WithCredentialsProvider(
+			credentials.NewStaticCredentialsProvider(s.accessKey, s.secretKey, "")))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to load aws config: %w", err)
+	}
+
+	s.client = s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if s.endpoint != "" {
+			// MinIO 등 S3 호환 스토리지는 버킷을 경로로 지정
+			o.BaseEndpoint = aws.String(s.endpoint)
+			o.UsePathStyle = true
+		}
+	})
+	return nil
+}
+
+func (s *S3Source) Read(ctx context.Context) (<-chan Record, <-chan error) {
+	records := make(chan Record, 100)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(records)
+		defer close(errs)
+
+		if err := s.readAll(ctx, records); err != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}()
+
+	return records, errs
+}
+
+func (s *S3Source) readAll(ctx context.Context, records chan<- Record) error {
+	if s.client == nil {
+		return fmt.Errorf("s3 client not opened")
+	}
+
+	objects, err := s.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objects {
+		origin := "s3://" + s.bucket + "/" + obj.key
+
+		s.mu.Lock()
+		s.pending[origin] = &s3Pending{obj: obj}
+		s.mu.Unlock()
+
+		if err := s.readObject(ctx, obj, origin, records); err != nil {
+			s.mu.Lock()
+			delete(s.pending, origin)
+			s.mu.Unlock()
+			return fmt.Errorf("failed to read %s: %w", origin, err)
+		}
+
+		// 레코드가 없거나 이미 모두 Ack된 객체는 바로 완료
+		if err := s.advance(ctx, origin, func(p *s3Pending) { p.read = true }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ack 싱크 기록이 확정된 레코드 통지
+// 객체의 레코드가 모두 Ack되면 체크포인트에 기록하고 after_read를 실행한다
+func (s *S3Source) Ack(ctx context.Context, records []Record) error {
+	counts := make(map[string]int)
+	for _, record := range records {
+		counts[record.Metadata.Origin]++
+	}
+	for origin, n := range counts {
+		if err := s.advance(ctx, origin, func(p *s3Pending) { p.acked += n }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// advance 객체 진행 상태 갱신 후 끝까지 읽은 레코드가 모두 Ack되었으면 완료 처리
+func (s *S3Source) advance(ctx context.Context, origin string, update func(*s3Pending)) error {
+	s.mu.Lock()
+	p, ok := s.pending[origin]
+	if !ok {
+		s.mu.Unlock()
+		return nil
+	}
+	update(p)
+	if !p.read || p.acked < p.emitted {
+		s.mu.Unlock()
+		return nil
+	}
+	delete(s.pending, origin)
+	s.processed[p.obj.key] = p.obj.etag
+	s.mu.Unlock()
+
+	if err := s.finish(ctx, p.obj.key); err != nil {
+		return fmt.Errorf("after_read %s failed for %s: %w", s.afterRead.Action, origin, err)
+	}
+	return nil
+}
+
+// list prefix 아래 객체 중 아직 읽지 않은 객체를 키 순으로 반환
+// 목록에 없는 키(이동/삭제됨)는 체크포인트에서 제거해 체크포인트가 계속 커지지 않게 한다
+func (s *S3Source) list(ctx context.Context) ([]s3Object, error) {
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.prefix),
+	})
+
+	var listed []types.Object
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list s3://%s/%s: %w", s.bucket, s.prefix, err)
+		}
+		listed = append(listed, page.Contents...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	present := make(map[string]bool, len(listed))
+	var objects []s3Object
+	for _, item := range listed {
+		key := aws.ToString(item.Key)
+		if !s.matches(key) {
+			continue
+		}
+		present[key] = true
+
+		etag := strings.Trim(aws.ToString(item.ETag), `"`)
+		if s.processed[key] == etag {
+			continue
+		}
+		objects = append(objects, s3Object{key: key, etag: etag})
+	}
+
+	for key := range s.processed {
+		if !present[key] {
+			delete(s.processed, key)
+		}
+	}
+	return objects, nil
+}
+
+// matches 읽을 대상 키인지 확인 (디렉터리 표시 객체와 move 대상 경로는 제외)
+func (s *S3Source) matches(key string) bool {
+	if strings.HasSuffix(key, "/") {
+		return false
+	}
+	if s.afterRead != nil && s.afterRead.Action == "move" && strings.HasPrefix(key, s.afterRead.Prefix) {
+		return false
+	}
+	if s.pattern == "" {
+		return true
+	}
+	ok, _ := path.Match(s.pattern, key)
+	return ok
+}
+
+// readObject 객체를 임시 파일로 내려받아 파일 소스 디코더로 읽음
+// parquet 등 임의 위치 접근이 필요한 포맷과 압축 자동 감지를 그대로 사용하기 위해 전체를 내려받는다
+func (s *S3Source) readObject(ctx context.Context, obj s3Object, origin string, records chan<- Record) error {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket:  aws.String(s.bucket),
+		Key:     aws.String(obj.key),
+		IfMatch: aws.String(`"` + obj.etag + `"`),
+	})
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp("", "conduix-s3-*")
+	if err != nil {
+		out.Body.Close()
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, out.Body)
+	out.Body.Close()
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("download failed: %w", err)
+	}
+
+	decoded := make(chan Record)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(decoded)
+		readErr <- s.decoder.readFile(ctx, &fileState{path: tmp.Name()}, decoded)
+	}()
+
+	for record := range decoded {
+		record.Metadata.Source = "s3"
+		record.Metadata.Origin = origin
+
+		// Ack가 전달보다 먼저 셈에 반영되지 않도록 보내기 전에 센다
+		s.mu.Lock()
+		s.pending[origin].emitted++
+		s.mu.Unlock()
+
+		select {
+		case records <- record:
+		case <-ctx.Done():
+		}
+	}
+	if err := <-readErr; err != nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// finish after_read 설정에 따라 읽기를 마친 객체를 이동/태그/삭제
+func (s *S3Source) finish(ctx context.Context, key string) error {
+	if s.afterRead == nil {
+		return nil
+	}
+
+	switch s.afterRead.Action {
+	case "move":
+		target := s.afterRead.Prefix + strings.TrimPrefix(key, s.prefix)
+		if _, err := s.client.CopyObject(ctx, &s3.CopyObjectInput{
+			Bucket:     aws.String(s.bucket),
+			Key:        aws.String(target),
+			CopySource: aws.String(s.bucket + "/" + escapeKey(key)),
+		}); err != nil {
+			return err
+		}
+		return s.deleteObject(ctx, key)
+
+	case "delete":
+		return s.deleteObject(ctx, key)
+
+	case "tag":
+		current, err := s.client.GetObjectTagging(ctx, &s3.GetObjectTaggingInput{
+			Bucket: aws.String(s.bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return err
+		}
+		tags := make(map[string]string, len(current.TagSet)+len(s.afterRead.Tags))
+		for _, tag := range current.TagSet {
+			tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+		}
+		for k, v := range s.afterRead.Tags {
+			tags[k] = v
+		}
+		tagSet := make([]types.Tag, 0, len(tags))
+		for k, v := range tags {
+			tagSet = append(tagSet, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		_, err = s.client.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
+			Bucket:  aws.String(s.bucket),
+			Key:     aws.String(key),
+			Tagging: &types.Tagging{TagSet: tagSet},
+		})
+		return err
+	}
+	return nil
+}
+
+// escapeKey CopySource용 키 인코딩 (경로 구분자 /는 유지)
+func escapeKey(key string) string {
+	parts := strings.Split(key, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
+
+func (s *S3Source) deleteObject(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+// GetCheckpoint 현재 체크포인트 반환 (레코드가 모두 Ack된 객체 키 → ETag)
+func (s *S3Source) GetCheckpoint() map[string]any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.processed) == 0 {
+		return map[string]any{}
+	}
+	objects := make(map[string]any, len(s.processed))
+	for key, etag := range s.processed {
+		objects[key] = etag
+	}
+	return map[string]any{"objects": objects}
+}
+
+// SetCheckpoint 체크포인트 설정 (복구용)
+func (s *S3Source) SetCheckpoint(checkpoint map[string]any) error {
+	raw, ok := checkpoint["objects"]
+	if !ok {
+		return nil
+	}
+	objects, ok := raw.(map[string]any)
+	if !ok {
+		return fmt.Errorf("invalid s3 checkpoint objects: %T", raw)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, v := range objects {
+		etag, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid etag for %s: %v", key, v)
+		}
+		s.processed[key] = etag
+	}
+	return nil
+}
+
+func (s *S3Source) Close() error {
+	return nil
+}
@@ -0,0 +1,386 @@
+package source
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+// fakeS3 path-style 요청의 ListObjectsV2/GetObject/CopyObject/DeleteObject/태그 API만 지원하는 테스트 서버
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+	tags    map[string]map[string]string
+}
+
+func newFakeS3(bucket string) *fakeS3 {
+	return &fakeS3{
+		bucket:  bucket,
+		objects: make(map[string][]byte),
+		tags:    make(map[string]map[string]string),
+	}
+}
+
+func (f *fakeS3) put(key string, body []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.objects[key] = body
+}
+
+func (f *fakeS3) keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var keys []string
+	for k := range f.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func s3ETag(body []byte) string {
+	sum := md5.Sum(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+type fakeS3Tagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	TagSet  []struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	} `xml:"TagSet>Tag"`
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != f.bucket {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+	query := r.URL.Query()
+
+	switch {
+	case key == "" && r.Method == http.MethodGet:
+		var b strings.Builder
+		b.WriteString(`<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`)
+		fmt.Fprintf(&b, "<Name>%s</Name><IsTruncated>false</IsTruncated>", f.bucket)
+		keys := make([]string, 0, len(f.objects))
+		for k := range f.objects {
+			if strings.HasPrefix(k, query.Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(&b, "<Contents><Key>%s</Key><ETag>%s</ETag><Size>%d</Size></Contents>",
+				k, s3ETag(f.objects[k]), len(f.objects[k]))
+		}
+		fmt.Fprintf(&b, "<KeyCount>%d</KeyCount></ListBucketResult>", len(keys))
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write([]byte(b.String()))
+
+	case query.Has("tagging"):
+		if _, ok := f.objects[key]; !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			var tagging fakeS3Tagging
+			if err := xml.NewDecoder(r.Body).Decode(&tagging); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			tags := make(map[string]string)
+			for _, t := range tagging.TagSet {
+				tags[t.Key] = t.Value
+			}
+			f.tags[key] = tags
+			return
+		}
+		var b strings.Builder
+		b.WriteString("<Tagging><TagSet>")
+		for k, v := range f.tags[key] {
+			fmt.Fprintf(&b, "<Tag><Key>%s</Key><Value>%s</Value></Tag>", k, v)
+		}
+		b.WriteString("</TagSet></Tagging>")
+		_, _ = w.Write([]byte(b.String()))
+
+	case r.Method == http.MethodGet:
+		body, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		if match := r.Header.Get("If-Match"); match != "" && match != s3ETag(body) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		w.Header().Set("ETag", s3ETag(body))
+		_, _ = w.Write(body)
+
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		source, _ := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+		_, srcKey, _ := strings.Cut(strings.TrimPrefix(source, "/"), "/")
+		body, ok := f.objects[srcKey]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		f.objects[key] = body
+		fmt.Fprintf(w, "<CopyObjectResult><ETag>%s</ETag></CopyObjectResult>", s3ETag(body))
+
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		delete(f.tags, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "NotImplemented", http.StatusNotImplemented)
+	}
+}
+
+func gzipBytes(t *testing.T, s string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(s)); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func newTestS3Source(t *testing.T, endpoint string, cfg config.SourceV2) *S3Source {
+	t.Helper()
+
+	cfg.Bucket = "events"
+	cfg.Endpoint = endpoint
+	cfg.AccessKey, cfg.SecretKey = "test", "test"
+	src, err := NewS3Source(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+// readS3Records 모든 레코드를 읽은 뒤 싱크 기록이 끝난 것처럼 Ack
+func readS3Records(t *testing.T, src *S3Source) []Record {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	out := readS3RecordsNoAck(t, ctx, src)
+	if err := src.Ack(ctx, out); err != nil {
+		t.Fatalf("Ack failed: %v", err)
+	}
+	return out
+}
+
+func readS3RecordsNoAck(t *testing.T, ctx context.Context, src *S3Source) []Record {
+	t.Helper()
+
+	if err := src.Open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	records, errs := src.Read(ctx)
+	var out []Record
+	for r := range records {
+		out = append(out, r)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	return out
+}
+
+func s3RecordIDs(records []Record) []string {
+	ids := make([]string, len(records))
+	for i, r := range records {
+		ids[i] = fmt.Sprint(r.Data["id"])
+	}
+	return ids
+}
+
+func TestS3SourceReadOnce(t *testing.T) {
+	fake := newFakeS3("events")
+	fake.put("in/2024/01/a.json", []byte("{\"id\": \"a1\"}\n{\"id\": \"a2\"}\n"))
+	fake.put("in/2024/01/b.json.gz", gzipBytes(t, `[{"id": "b1"}]`))
+	fake.put("in/2024/01/skip.txt", []byte("not matched"))
+	fake.put("in/2024/01/", nil)
+	fake.put("other/c.json", []byte(`{"id": "c1"}`))
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	cfg := config.SourceV2{Prefix: "in/", Pattern: "in/*/*/*.json*"}
+	src := newTestS3Source(t, server.URL, cfg)
+	records := readS3Records(t, src)
+	if got := s3RecordIDs(records); !reflect.DeepEqual(got, []string{"a1", "a2", "b1"}) {
+		t.Fatalf("ids = %v", got)
+	}
+	if records[0].Metadata.Source != "s3" || records[0].Metadata.Origin != "s3://events/in/2024/01/a.json" {
+		t.Errorf("metadata = %+v", records[0].Metadata)
+	}
+
+	// 체크포인트로 복구한 소스는 새 객체와 내용이 바뀐 객체만 읽음
+	checkpoint := src.GetCheckpoint()
+	fake.put("in/2024/01/a.json", []byte(`{"id": "a3"}`))
+	fake.put("in/2024/02/d.json", []byte(`{"id": "d1"}`))
+
+	resumed := newTestS3Source(t, server.URL, cfg)
+	if err := resumed.SetCheckpoint(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if got := s3RecordIDs(readS3Records(t, resumed)); !reflect.DeepEqual(got, []string{"a3", "d1"}) {
+		t.Fatalf("resumed ids = %v", got)
+	}
+	if got := s3RecordIDs(readS3Records(t, resumed)); len(got) != 0 {
+		t.Fatalf("third run ids = %v", got)
+	}
+}
+
+func TestS3SourceCSV(t *testing.T) {
+	fake := newFakeS3("events")
+	fake.put("users.csv", []byte("id,name\n1,kim\n2,lee\n"))
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	src := newTestS3Source(t, server.URL, config.SourceV2{Format: "csv"})
+	records := readS3Records(t, src)
+	if len(records) != 2 || records[1].Data["name"] != "lee" {
+		t.Fatalf("records = %v", records)
+	}
+}
+
+func TestS3SourceAfterRead(t *testing.T) {
+	t.Run("move", func(t *testing.T) {
+		fake := newFakeS3("events")
+		fake.put("in/a b.json", []byte(`{"id": "a"}`))
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		src := newTestS3Source(t, server.URL, config.SourceV2{
+			AfterRead: &config.S3AfterReadConfig{Action: "move", Prefix: "done/"},
+		})
+		if got := s3RecordIDs(readS3Records(t, src)); !reflect.DeepEqual(got, []string{"a"}) {
+			t.Fatalf("ids = %v", got)
+		}
+		if got := fake.keys(); !reflect.DeepEqual(got, []string{"done/in/a b.json"}) {
+			t.Errorf("keys after move = %v", got)
+		}
+		// 이동된 객체는 다시 읽지 않고 체크포인트에서도 제거
+		if got := readS3Records(t, src); len(got) != 0 {
+			t.Errorf("second run records = %v", got)
+		}
+		if cp := src.GetCheckpoint(); len(cp) != 0 {
+			t.Errorf("checkpoint = %v", cp)
+		}
+	})
+
+	t.Run("tag", func(t *testing.T) {
+		fake := newFakeS3("events")
+		fake.put("a.json", []byte(`{"id": "a"}`))
+		fake.tags["a.json"] = map[string]string{"owner": "etl"}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		src := newTestS3Source(t, server.URL, config.SourceV2{
+			AfterRead: &config.S3AfterReadConfig{Action: "tag", Tags: map[string]string{"processed": "true"}},
+		})
+		readS3Records(t, src)
+		want := map[string]string{"owner": "etl", "processed": "true"}
+		if got := fake.tags["a.json"]; !reflect.DeepEqual(got, want) {
+			t.Errorf("tags = %v", got)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		fake := newFakeS3("events")
+		fake.put("a.json", []byte(`{"id": "a"}`))
+		server := httptest.NewServer(fake)
+		defer server.Close()
+
+		src := newTestS3Source(t, server.URL, config.SourceV2{
+			AfterRead: &config.S3AfterReadConfig{Action: "delete"},
+		})
+		readS3Records(t, src)
+		if got := fake.keys(); len(got) != 0 {
+			t.Errorf("keys after delete = %v", got)
+		}
+	})
+}
+
+func TestS3SourceAfterReadWaitsForAck(t *testing.T) {
+	fake := newFakeS3("events")
+	fake.put("a.json", []byte("{\"id\": \"a1\"}\n{\"id\": \"a2\"}\n"))
+	fake.put("b.json", []byte(`{"id": "b1"}`))
+	fake.put("empty.json", nil)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	src := newTestS3Source(t, server.URL, config.SourceV2{
+		AfterRead: &config.S3AfterReadConfig{Action: "delete"},
+	})
+	ctx := context.Background()
+	records := readS3RecordsNoAck(t, ctx, src)
+	if len(records) != 3 {
+		t.Fatalf("records = %d", len(records))
+	}
+
+	// 레코드가 없는 객체만 바로 완료되고, 나머지는 Ack 전까지 삭제/체크포인트 기록하지 않음
+	if got := fake.keys(); !reflect.DeepEqual(got, []string{"a.json", "b.json"}) {
+		t.Fatalf("keys before ack = %v", got)
+	}
+
+	// a.json은 레코드 하나가 남아 있어 그대로 유지
+	if err := src.Ack(ctx, []Record{records[0], records[2]}); err != nil {
+		t.Fatal(err)
+	}
+	if got := fake.keys(); !reflect.DeepEqual(got, []string{"a.json"}) {
+		t.Errorf("keys after partial ack = %v", got)
+	}
+	if err := src.Ack(ctx, records[1:2]); err != nil {
+		t.Fatal(err)
+	}
+	if got := fake.keys(); len(got) != 0 {
+		t.Errorf("keys after ack = %v", got)
+	}
+	objects, _ := src.GetCheckpoint()["objects"].(map[string]any)
+	if len(objects) != 3 {
+		t.Errorf("checkpoint objects = %v", objects)
+	}
+}
+
+func TestS3SourceCheckpointFormat(t *testing.T) {
+	src := &S3Source{processed: make(map[string]string)}
+	if err := src.SetCheckpoint(map[string]any{"objects": map[string]any{"a.json": "etag-1"}}); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{"objects": map[string]any{"a.json": "etag-1"}}
+	if got := src.GetCheckpoint(); !reflect.DeepEqual(got, want) {
+		t.Errorf("checkpoint = %v", got)
+	}
+	if err := src.SetCheckpoint(map[string]any{"objects": "bad"}); err == nil {
+		t.Error("expected error for invalid objects")
+	}
+}
@@ -36,6 +36,36 @@ type Source interface {
 	Name() string
 }
 
+// Acknowledger 싱크 기록이 확정된 레코드를 통지받는 소스 (선택 구현)
+// 파이프라인은 싱크 Flush가 성공한 뒤 해당 레코드들로 Ack를 호출한다
+type Acknowledger interface {
+	Ack(ctx context.Context, records []Record) error
+}
+
+// Checkpointer 체크포인트 저장/복구를 지원하는 소스 (선택 구현)
+// SetCheckpoint는 Read 전에 호출되어야 한다
+type Checkpointer interface {
+	GetCheckpoint() map[string]any
+	SetCheckpoint(checkpoint map[string]any) error
+}
+
+// CheckpointAcker 체크포인트 저장 완료를 통지받는 소스 (선택 구현)
+// 체크포인트를 저장하는 쪽은 GetCheckpoint 값의 저장이 성공한 뒤에만 AckCheckpoint를 호출한다.
+// 실행 중에도 주기적으로 저장되므로 GetCheckpoint는 Ack된 레코드까지만 반영해야 한다
+type CheckpointAcker interface {
+	Checkpointer
+	AckCheckpoint(checkpoint map[string]any) error
+}
+
+// OrderedAcker 앞선 레코드가 모두 Ack된 위치까지만 체크포인트가 전진하는 소스 (선택 구현: cdc, file)
+// Ack되지 않은 레코드를 실행 중에 다시 보내지 않으므로 레코드 하나가 Ack되지 않으면 체크포인트가 멈춘다.
+// 파이프라인은 이런 소스의 레코드 처리가 실패하면 그 앞까지 체크포인트를 저장하고 실행을 오류로 중단한다
+type OrderedAcker interface {
+	Acknowledger
+	// AckInOrder 구현 표시용 (동작 없음)
+	AckInOrder()
+}
+
 // NewSource 소스 설정으로 Source 생성
 func NewSource(cfg config.SourceV2) (Source, error) {
 	switch cfg.Type {
@@ -45,12 +75,24 @@ func NewSource(cfg config.SourceV2) (Source, error) {
 		return NewSQLSource(cfg)
 	case "http", "rest_api":
 		return NewHTTPSource(cfg)
+	case "http_server":
+		return NewHTTPServerSource(cfg)
 	case "kafka":
 		return NewKafkaSource(cfg)
 	case "sql_event":
 		return NewSQLEventSource(cfg)
 	case "cdc":
 		return NewCDCSource(cfg)
+	case "elasticsearch":
+		return NewElasticsearchSource(cfg)
+	case "mongodb":
+		return NewMongoDBSource(cfg)
+	case "s3":
+		return NewS3Source(cfg)
+	case "generate":
+		return NewGenerateSource(cfg)
+	case "redis":
+		return NewRedisStreamSource(cfg)
 	default:
 		return nil, &UnsupportedSourceError{Type: cfg.Type}
 	}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
@@ -12,24 +14,49 @@ import (
 	// _ "github.com/lib/pq"
 )
 
+// sqlSubqueryAlias 사용자 쿼리를 감싸는 서브쿼리 별칭
+const sqlSubqueryAlias = "conduix_src"
+
 // SQLSource SQL 데이터 소스
+// incremental 설정 시 이전 실행의 high-water mark 이후 행만 읽고,
+// partition 설정 시 키 범위를 나눠 여러 쿼리를 동시에 실행한다
 type SQLSource struct {
 	driver      string
 	dsn         string
 	query       string
 	params      []string
 	incremental *config.IncrementalConfig
+	partition   *config.PartitionConfig
 	db          *sql.DB
+
+	mu        sync.RWMutex
+	watermark any // 체크포인트에 저장된 high-water mark (int64, float64, time.Time, string)
+	seen      any // 현재 실행에서 읽은 incremental 컬럼 최대값
 }
 
 // NewSQLSource SQL 소스 생성
 func NewSQLSource(cfg config.SourceV2) (*SQLSource, error) {
+	if cfg.Incremental != nil && cfg.Incremental.Column == "" {
+		return nil, fmt.Errorf("incremental column is required")
+	}
+	if cfg.Partition != nil && cfg.Partition.Column == "" {
+		return nil, fmt.Errorf("partition column is required")
+	}
+
+	partition := cfg.Partition
+	if partition != nil && partition.Count <= 0 {
+		p := *partition
+		p.Count = 4
+		partition = &p
+	}
+
 	return &SQLSource{
 		driver:      cfg.Driver,
 		dsn:         cfg.DSN,
-		query:       cfg.Query,
+		query:       strings.TrimRight(strings.TrimSpace(cfg.Query), ";"),
 		params:      cfg.Params,
 		incremental: cfg.Incremental,
+		partition:   partition,
 	}, nil
 }
 
@@ -61,72 +88,324 @@ func (s *SQLSource) Read(ctx context.Context) (<-chan Record, <-chan error) {
 		defer close(records)
 		defer close(errs)
 
-		// 파라미터 준비
-		params := make([]any, len(s.params))
-		for i, p := range s.params {
-			params[i] = p
-		}
+		s.mu.Lock()
+		s.seen = nil
+		s.mu.Unlock()
 
-		rows, err := s.db.QueryContext(ctx, s.query, params...)
-		if err != nil {
-			errs <- fmt.Errorf("query failed: %w", err)
+		var err error
+		if s.partition != nil {
+			err = s.readPartitioned(ctx, records)
+		} else {
+			err = s.readSerial(ctx, records)
+		}
+		if ctx.Err() != nil {
 			return
 		}
-		defer rows.Close()
-
-		// 컬럼 정보
-		columns, err := rows.Columns()
 		if err != nil {
-			errs <- fmt.Errorf("failed to get columns: %w", err)
+			errs <- err
 			return
 		}
 
-		for rows.Next() {
-			select {
-			case <-ctx.Done():
-				return
-			default:
-			}
+		// 모든 행을 읽은 경우에만 high-water mark 확정
+		s.mu.Lock()
+		if s.seen != nil {
+			s.watermark = s.seen
+		}
+		s.mu.Unlock()
+	}()
 
-			// 값 스캔
-			values := make([]any, len(columns))
-			valuePtrs := make([]any, len(columns))
-			for i := range values {
-				valuePtrs[i] = &values[i]
-			}
+	return records, errs
+}
 
-			if err := rows.Scan(valuePtrs...); err != nil {
-				errs <- fmt.Errorf("scan failed: %w", err)
-				return
-			}
+// readSerial 단일 쿼리로 순차 조회
+// incremental 모드에서는 컬럼 순으로 정렬하여 값이 바뀔 때마다 직전 값까지를 확정한다
+func (s *SQLSource) readSerial(ctx context.Context, records chan<- Record) error {
+	args := s.baseArgs()
+	var conditions []string
+	orderBy := ""
+
+	if s.incremental != nil {
+		s.mu.RLock()
+		watermark := s.watermark
+		s.mu.RUnlock()
 
-			// map으로 변환
-			data := make(map[string]any)
-			for i, col := range columns {
-				val := values[i]
-				// []byte를 string으로 변환
-				if b, ok := val.([]byte); ok {
-					val = string(b)
-				}
-				data[col] = val
+		if watermark != nil {
+			args = append(args, watermark)
+			conditions = append(conditions, fmt.Sprintf("%s > %s", s.incremental.Column, s.placeholder(len(args))))
+		}
+		orderBy = s.incremental.Column
+	}
+
+	return s.readQuery(ctx, s.wrapQuery(conditions, orderBy), args, records, orderBy != "")
+}
+
+// readPartitioned partition 컬럼의 범위를 나눠 동시에 조회
+// 파티션 간 순서가 없으므로 high-water mark는 모든 파티션이 끝난 뒤에만 확정된다
+func (s *SQLSource) readPartitioned(ctx context.Context, records chan<- Record) error {
+	args := s.baseArgs()
+	var conditions []string
+
+	if s.incremental != nil {
+		s.mu.RLock()
+		watermark := s.watermark
+		s.mu.RUnlock()
+
+		if watermark != nil {
+			args = append(args, watermark)
+			conditions = append(conditions, fmt.Sprintf("%s > %s", s.incremental.Column, s.placeholder(len(args))))
+		}
+	}
+
+	lower, upper, err := s.partitionBounds(ctx, conditions, args)
+	if err != nil {
+		return err
+	}
+	if lower == nil || upper == nil {
+		// 조회 대상 행 없음
+		return nil
+	}
+
+	ranges, err := splitKeyRange(lower, upper, s.partition.Count)
+	if err != nil {
+		return fmt.Errorf("partition %s: %w", s.partition.Column, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(ranges))
+	for _, r := range ranges {
+		rangeArgs := append(append([]any(nil), args...), r.lower, r.upper)
+		upperOp := "<"
+		if r.last {
+			upperOp = "<="
+		}
+		rangeConditions := append(append([]string(nil), conditions...),
+			fmt.Sprintf("%s >= %s", s.partition.Column, s.placeholder(len(rangeArgs)-1)),
+			fmt.Sprintf("%s %s %s", s.partition.Column, upperOp, s.placeholder(len(rangeArgs))),
+		)
+		query := s.wrapQuery(rangeConditions, "")
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := s.readQuery(ctx, query, rangeArgs, records, false); err != nil {
+				errCh <- err
+				cancel()
 			}
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	return <-errCh
+}
+
+// partitionBounds partition 컬럼의 MIN/MAX 조회 (행이 없으면 nil)
+func (s *SQLSource) partitionBounds(ctx context.Context, conditions []string, args []any) (any, any, error) {
+	column := s.partition.Column
+	query := fmt.Sprintf("SELECT MIN(%s), MAX(%s) FROM (%s) %s", column, column, s.query, sqlSubqueryAlias)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("partition bounds query failed: %w", err)
+	}
+	defer rows.Close()
+
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	var lower, upper any
+	if rows.Next() {
+		if err := rows.Scan(&lower, &upper); err != nil {
+			return nil, nil, fmt.Errorf("scan failed: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return convertSQLValue(lower, types[0].DatabaseTypeName()), convertSQLValue(upper, types[1].DatabaseTypeName()), nil
+}
+
+// readQuery 쿼리 결과를 레코드로 변환하여 전달
+func (s *SQLSource) readQuery(ctx context.Context, query string, args []any, records chan<- Record, ordered bool) error {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
 
-			records <- Record{
-				Data: data,
-				Metadata: Metadata{
-					Source:    "sql",
-					Origin:    s.dsn,
-					Timestamp: time.Now().UnixMilli(),
-				},
+	// 컬럼 정보
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("failed to get columns: %w", err)
+	}
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	for rows.Next() {
+		// 값 스캔
+		values := make([]any, len(columns))
+		valuePtrs := make([]any, len(columns))
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return fmt.Errorf("scan failed: %w", err)
+		}
+
+		// 컬럼 타입에 따라 변환
+		data := make(map[string]any, len(columns))
+		for i, col := range columns {
+			data[col] = convertSQLValue(values[i], types[i].DatabaseTypeName())
+		}
+
+		record := Record{
+			Data: data,
+			Metadata: Metadata{
+				Source:    "sql",
+				Origin:    s.dsn,
+				Timestamp: time.Now().UnixMilli(),
+			},
+		}
+
+		var mark any
+		if s.incremental != nil {
+			if v, ok := normalizeMark(data[s.incremental.Column]); ok {
+				mark = v
+				record.Metadata.Offset = formatMark(v)
 			}
 		}
 
-		if err := rows.Err(); err != nil {
-			errs <- fmt.Errorf("rows error: %w", err)
+		select {
+		case records <- record:
+		case <-ctx.Done():
+			return ctx.Err()
 		}
-	}()
 
-	return records, errs
+		if mark != nil {
+			s.observe(mark, ordered)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows error: %w", err)
+	}
+	return nil
+}
+
+// observe 읽은 incremental 값 반영
+// 정렬된 조회에서는 더 큰 값이 나오면 직전 값의 행은 모두 읽은 것이므로 바로 확정한다
+func (s *SQLSource) observe(mark any, ordered bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.seen != nil && compareMarks(mark, s.seen) <= 0 {
+		return
+	}
+	if ordered && s.seen != nil {
+		s.watermark = s.seen
+	}
+	s.seen = mark
+}
+
+// wrapQuery 사용자 쿼리를 서브쿼리로 감싸 조건과 정렬 추가
+func (s *SQLSource) wrapQuery(conditions []string, orderBy string) string {
+	if len(conditions) == 0 && orderBy == "" {
+		return s.query
+	}
+
+	query := fmt.Sprintf("SELECT * FROM (%s) %s", s.query, sqlSubqueryAlias)
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	if orderBy != "" {
+		query += " ORDER BY " + orderBy
+	}
+	return query
+}
+
+func (s *SQLSource) baseArgs() []any {
+	args := make([]any, len(s.params))
+	for i, p := range s.params {
+		args[i] = p
+	}
+	return args
+}
+
+func (s *SQLSource) placeholder(n int) string {
+	return sqlPlaceholder(s.driver, n)
+}
+
+// sqlPlaceholder n번째 바인드 파라미터 표기 (드라이버별)
+func sqlPlaceholder(driver string, n int) string {
+	switch driver {
+	case "postgres", "pgx":
+		return fmt.Sprintf("$%d", n)
+	default:
+		return "?"
+	}
+}
+
+// stateKey 체크포인트에서 high-water mark를 저장하는 키
+func (s *SQLSource) stateKey() string {
+	if s.incremental.StateKey != "" {
+		return s.incremental.StateKey
+	}
+	return s.incremental.Column
+}
+
+// GetCheckpoint 현재 체크포인트(high-water mark) 반환
+func (s *SQLSource) GetCheckpoint() map[string]any {
+	checkpoint := make(map[string]any)
+	if s.incremental == nil {
+		return checkpoint
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.watermark != nil {
+		checkpoint[s.stateKey()] = map[string]any{
+			"value": formatMark(s.watermark),
+			"type":  markType(s.watermark),
+		}
+	}
+	return checkpoint
+}
+
+// SetCheckpoint 체크포인트 설정 (복구용)
+func (s *SQLSource) SetCheckpoint(checkpoint map[string]any) error {
+	if s.incremental == nil {
+		return nil
+	}
+
+	state, ok := checkpoint[s.stateKey()].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	value, _ := state["value"].(string)
+	kind, _ := state["type"].(string)
+	mark, err := parseMark(value, kind)
+	if err != nil {
+		return fmt.Errorf("invalid high-water mark for %s: %w", s.stateKey(), err)
+	}
+
+	s.mu.Lock()
+	s.watermark = mark
+	s.mu.Unlock()
+	return nil
 }
 
 func (s *SQLSource) Close() error {
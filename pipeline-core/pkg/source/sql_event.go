@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
 )
 
 // SQLEventSource SQL 이벤트 테이블 폴링 소스
@@ -29,6 +30,13 @@ type SQLEventSource struct {
 	lastID        int64     // 마지막으로 처리한 ID
 	lastTimestamp time.Time // 마지막으로 처리한 타임스탬프
 	running       bool
+
+	// outbox 모드
+	outbox            *config.OutboxConfig
+	visibilityTimeout time.Duration          // 확인되지 않은 행 재전달 대기 시간
+	lookback          time.Duration          // timestamp_column 기준 재조회 구간
+	dedup             dedup.DedupService     // 재조회된 행 중복 제거
+	inflight          map[string]inflightRow // 전달 후 확인 대기 중인 행 (ID 문자열 기준)
 }
 
 // NewSQLEventSource SQL 이벤트 소스 생성
@@ -53,7 +61,7 @@ func NewSQLEventSource(cfg config.SourceV2) (*SQLEventSource, error) {
 		orderBy = idColumn + " ASC"
 	}
 
-	return &SQLEventSource{
+	s := &SQLEventSource{
 		driver:       cfg.Driver,
 		dsn:          cfg.DSN,
 		table:        cfg.Table,
@@ -64,7 +72,39 @@ func NewSQLEventSource(cfg config.SourceV2) (*SQLEventSource, error) {
 		orderBy:      orderBy,
 		batchSize:    batchSize,
 		pollInterval: pollInterval,
-	}, nil
+	}
+
+	if cfg.Outbox != nil {
+		outbox := *cfg.Outbox
+		if outbox.OnAck == "" {
+			outbox.OnAck = "none"
+			if outbox.ProcessedColumn != "" {
+				outbox.OnAck = "mark"
+			}
+		}
+		if outbox.OnAck == "mark" && outbox.ProcessedColumn == "" {
+			return nil, fmt.Errorf("outbox on_ack mark requires processed_column")
+		}
+		if outbox.Lookback > 0 && cfg.TimestampColumn == "" {
+			return nil, fmt.Errorf("outbox lookback requires timestamp_column")
+		}
+		if outbox.MaxAttempts > 0 && outbox.AttemptsColumn == "" {
+			return nil, fmt.Errorf("outbox max_attempts requires attempts_column")
+		}
+		if outbox.DedupStorage == dedup.StorageRedis && outbox.DedupRedisURL == "" {
+			return nil, fmt.Errorf("outbox dedup_storage redis requires dedup_redis_url")
+		}
+
+		s.outbox = &outbox
+		s.visibilityTimeout = 30 * time.Second
+		if outbox.VisibilityTimeout > 0 {
+			s.visibilityTimeout = time.Duration(outbox.VisibilityTimeout) * time.Millisecond
+		}
+		s.lookback = time.Duration(outbox.Lookback) * time.Millisecond
+		s.inflight = make(map[string]inflightRow)
+	}
+
+	return s, nil
 }
 
 func (s *SQLEventSource) Name() string {
@@ -82,6 +122,27 @@ func (s *SQLEventSource) Open(ctx context.Context) error {
 		return fmt.Errorf("failed to ping database: %w", err)
 	}
 
+	if s.outbox != nil && s.dedup == nil {
+		ttl := 24 * time.Hour
+		if s.outbox.DedupTTL != "" {
+			if d, err := time.ParseDuration(s.outbox.DedupTTL); err == nil {
+				ttl = d
+			}
+		}
+		svc, err := dedup.New(dedup.Options{
+			Storage:  s.outbox.DedupStorage,
+			TTL:      ttl,
+			RedisURL: s.outbox.DedupRedisURL,
+			// 처리된 행 ID는 outbox 테이블별로 구분
+			Namespace: dedup.NamespaceOf("outbox", s.table),
+		})
+		if err != nil {
+			db.Close()
+			return fmt.Errorf("failed to create dedup service: %w", err)
+		}
+		s.dedup = svc
+	}
+
 	s.mu.Lock()
 	s.db = db
 	s.mu.Unlock()
@@ -139,6 +200,10 @@ func (s *SQLEventSource) Read(ctx context.Context) (<-chan Record, <-chan error)
 }
 
 func (s *SQLEventSource) poll(ctx context.Context, records chan<- Record) error {
+	if s.outbox != nil {
+		return s.pollOutbox(ctx, records)
+	}
+
 	s.mu.RLock()
 	db := s.db
 	lastID := s.lastID
@@ -307,6 +372,11 @@ func (s *SQLEventSource) Close() error {
 
 	s.running = false
 
+	if s.dedup != nil {
+		_ = s.dedup.Close()
+		s.dedup = nil
+	}
+
 	if s.db != nil {
 		err := s.db.Close()
 		s.db = nil
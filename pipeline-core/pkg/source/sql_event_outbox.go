@@ -0,0 +1,370 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// inflightRow 전달 후 확인(Ack) 대기 중인 행
+type inflightRow struct {
+	id   any       // 원본 ID 값 (DELETE/UPDATE 바인드용)
+	sent time.Time // 마지막 전달 시각
+}
+
+// pollOutbox outbox 모드 폴링
+// on_ack delete/mark: 처리되지 않은 행 전체를 ID 순으로 조회 (늦게 커밋된 행도 포함)
+// on_ack none: lastID 이후 행과 lookback 구간의 행을 조회하고 이미 처리된 행은 dedup으로 제외
+func (s *SQLEventSource) pollOutbox(ctx context.Context, records chan<- Record) error {
+	s.mu.RLock()
+	db := s.db
+	lastID := s.lastID
+	s.mu.RUnlock()
+
+	if db == nil {
+		return fmt.Errorf("database not connected")
+	}
+
+	if s.outbox.OnAck != "none" {
+		var conditions []string
+		var args []any
+		if s.outbox.ProcessedColumn != "" {
+			conditions = append(conditions, s.outbox.ProcessedColumn+" IS NULL")
+		}
+		if s.outbox.MaxAttempts > 0 {
+			conditions = append(conditions, fmt.Sprintf("COALESCE(%s, 0) < ?", s.outbox.AttemptsColumn))
+			args = append(args, s.outbox.MaxAttempts)
+		}
+		_, err := s.scanOutbox(ctx, conditions, args, records)
+		return err
+	}
+
+	maxID, err := s.scanOutbox(ctx, []string{s.idColumn + " > ?"}, []any{lastID}, records)
+	if err != nil {
+		return err
+	}
+	if maxID > lastID {
+		s.mu.Lock()
+		if maxID > s.lastID {
+			s.lastID = maxID
+		}
+		s.mu.Unlock()
+	}
+
+	if s.lookback > 0 {
+		// 긴 트랜잭션으로 lastID보다 작은 ID가 늦게 커밋된 경우를 다시 확인
+		cutoff := time.Now().Add(-s.lookback)
+		conditions := []string{s.idColumn + " <= ?", s.timestampCol + " >= ?"}
+		if _, err := s.scanOutbox(ctx, conditions, []any{lastID, cutoff}, records); err != nil {
+			return fmt.Errorf("lookback scan failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// scanOutbox 조건에 맞는 행을 ID 순으로 페이지 단위 조회하여 전달 (한 번에 최대 batchSize개)
+// 확인 대기 중이거나 이미 처리된 행은 건너뛰고 다음 페이지를 읽는다
+func (s *SQLEventSource) scanOutbox(ctx context.Context, conditions []string, args []any, records chan<- Record) (int64, error) {
+	var maxID int64
+	var cursor any
+	emitted := 0
+
+	for emitted < s.batchSize {
+		pageConditions := conditions
+		pageArgs := args
+		if cursor != nil {
+			pageConditions = append(append([]string(nil), conditions...), s.idColumn+" > ?")
+			pageArgs = append(append([]any(nil), args...), cursor)
+		}
+
+		rows, err := s.fetchRows(ctx, s.buildOutboxQuery(pageConditions), pageArgs)
+		if err != nil {
+			return maxID, err
+		}
+
+		var batch []Record
+		var sent, processed []any
+		for _, data := range rows {
+			id := data[s.idColumn]
+			cursor = id
+			if n, ok := toInt64(id); ok && n > maxID {
+				maxID = n
+			}
+			if emitted+len(batch) >= s.batchSize {
+				break
+			}
+
+			admit, duplicate, err := s.admit(ctx, id)
+			if err != nil {
+				return maxID, err
+			}
+			if duplicate {
+				processed = append(processed, id)
+				continue
+			}
+			if !admit {
+				continue
+			}
+
+			batch = append(batch, Record{
+				Data: data,
+				Metadata: Metadata{
+					Source:    "sql_event",
+					Origin:    s.table,
+					Offset:    fmt.Sprintf("%v", id),
+					Timestamp: time.Now().UnixMilli(),
+				},
+			})
+			sent = append(sent, id)
+		}
+
+		// 이미 싱크에 전달된 행은 Ack 처리가 실패했던 것이므로 다시 정리
+		if err := s.finalize(ctx, processed); err != nil {
+			return maxID, err
+		}
+		// 전달 전에 시도 횟수를 올려 반복 실패하는 행이 max_attempts에서 멈추도록 함
+		if err := s.incrementAttempts(ctx, sent); err != nil {
+			return maxID, err
+		}
+
+		for _, record := range batch {
+			select {
+			case records <- record:
+				emitted++
+			case <-ctx.Done():
+				return maxID, ctx.Err()
+			}
+		}
+
+		if len(rows) < s.batchSize {
+			break
+		}
+	}
+
+	return maxID, nil
+}
+
+// admit 행 전달 여부 판단
+// 확인 대기 중(visibility timeout 이내)이면 건너뛰고, dedup에 처리됨으로 기록된 행은 duplicate 반환
+func (s *SQLEventSource) admit(ctx context.Context, id any) (admit bool, duplicate bool, err error) {
+	key := fmt.Sprintf("%v", id)
+
+	s.mu.RLock()
+	row, pending := s.inflight[key]
+	s.mu.RUnlock()
+	if pending && time.Since(row.sent) < s.visibilityTimeout {
+		return false, false, nil
+	}
+
+	if s.dedup != nil {
+		dup, err := s.dedup.IsDuplicate(ctx, s.dedupKey(key))
+		if err != nil {
+			return false, false, fmt.Errorf("dedup check failed: %w", err)
+		}
+		if dup {
+			return false, true, nil
+		}
+	}
+
+	s.mu.Lock()
+	s.inflight[key] = inflightRow{id: id, sent: time.Now()}
+	s.mu.Unlock()
+	return true, false, nil
+}
+
+// Ack 싱크 기록이 확정된 행을 처리 완료로 표시하고 on_ack 설정에 따라 삭제/갱신
+func (s *SQLEventSource) Ack(ctx context.Context, records []Record) error {
+	if s.outbox == nil || len(records) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(records))
+	ids := make([]any, 0, len(records))
+	s.mu.RLock()
+	for _, r := range records {
+		if r.Metadata.Source != "sql_event" || r.Metadata.Offset == "" {
+			continue
+		}
+		id := any(r.Metadata.Offset)
+		if row, ok := s.inflight[r.Metadata.Offset]; ok {
+			id = row.id
+		}
+		keys = append(keys, r.Metadata.Offset)
+		ids = append(ids, id)
+	}
+	s.mu.RUnlock()
+
+	if s.dedup != nil {
+		for _, key := range keys {
+			if err := s.dedup.MarkProcessed(ctx, s.dedupKey(key)); err != nil {
+				return fmt.Errorf("failed to mark processed: %w", err)
+			}
+		}
+	}
+
+	// 실패하면 inflight에 남기며, visibility timeout 후 재조회될 때 dedup에 걸려 전달 없이 정리된다
+	if err := s.finalize(ctx, ids); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	for _, key := range keys {
+		delete(s.inflight, key)
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+// finalize on_ack 설정에 따라 행 삭제 또는 처리 시각 기록
+func (s *SQLEventSource) finalize(ctx context.Context, ids []any) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var query string
+	var args []any
+	switch s.outbox.OnAck {
+	case "delete":
+		query = fmt.Sprintf("DELETE FROM %s WHERE %s", s.table, s.inClause(len(ids), 1))
+		args = ids
+	case "mark":
+		query = fmt.Sprintf("UPDATE %s SET %s = %s WHERE %s",
+			s.table, s.outbox.ProcessedColumn, sqlPlaceholder(s.driver, 1), s.inClause(len(ids), 2))
+		args = append([]any{time.Now().UTC()}, ids...)
+	default:
+		return nil
+	}
+
+	if _, err := s.execContext(ctx, query, args); err != nil {
+		return fmt.Errorf("outbox %s failed: %w", s.outbox.OnAck, err)
+	}
+	return nil
+}
+
+// incrementAttempts 전달하는 행의 시도 횟수 증가
+func (s *SQLEventSource) incrementAttempts(ctx context.Context, ids []any) error {
+	if s.outbox.AttemptsColumn == "" || len(ids) == 0 {
+		return nil
+	}
+
+	column := s.outbox.AttemptsColumn
+	query := fmt.Sprintf("UPDATE %s SET %s = COALESCE(%s, 0) + 1 WHERE %s",
+		s.table, column, column, s.inClause(len(ids), 1))
+	if _, err := s.execContext(ctx, query, ids); err != nil {
+		return fmt.Errorf("failed to update %s: %w", column, err)
+	}
+	return nil
+}
+
+func (s *SQLEventSource) execContext(ctx context.Context, query string, args []any) (int64, error) {
+	s.mu.RLock()
+	db := s.db
+	s.mu.RUnlock()
+
+	if db == nil {
+		return 0, fmt.Errorf("database not connected")
+	}
+
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// buildOutboxQuery outbox 조회 쿼리 생성 (페이지 이동을 위해 항상 ID 순 정렬)
+// conditions의 '?'는 드라이버에 맞는 바인드 표기로 바뀐다
+func (s *SQLEventSource) buildOutboxQuery(conditions []string) string {
+	selectCols := "*"
+	if len(s.columns) > 0 {
+		selectCols = strings.Join(s.columns, ", ")
+	}
+
+	var where []string
+	n := 0
+	for _, cond := range conditions {
+		parts := strings.Split(cond, "?")
+		var sb strings.Builder
+		for i, part := range parts {
+			if i > 0 {
+				n++
+				sb.WriteString(sqlPlaceholder(s.driver, n))
+			}
+			sb.WriteString(part)
+		}
+		where = append(where, sb.String())
+	}
+	if s.whereClause != "" {
+		where = append(where, "("+s.whereClause+")")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", selectCols, s.table)
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	return query + fmt.Sprintf(" ORDER BY %s LIMIT %d", s.idColumn, s.batchSize)
+}
+
+// inClause "id IN (...)" 절 생성 (바인드 번호는 start부터)
+func (s *SQLEventSource) inClause(count, start int) string {
+	placeholders := make([]string, count)
+	for i := range placeholders {
+		placeholders[i] = sqlPlaceholder(s.driver, start+i)
+	}
+	return fmt.Sprintf("%s IN (%s)", s.idColumn, strings.Join(placeholders, ", "))
+}
+
+// fetchRows 쿼리 결과를 컬럼 타입에 맞게 변환하여 반환
+func (s *SQLEventSource) fetchRows(ctx context.Context, query string, args []any) ([]map[string]any, error) {
+	s.mu.RLock()
+	db := s.db
+	s.mu.RUnlock()
+
+	if db == nil {
+		return nil, fmt.Errorf("database not connected")
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get columns: %w", err)
+	}
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	var result []map[string]any
+	for rows.Next() {
+		values := make([]any, len(columns))
+		valuePtrs := make([]any, len(columns))
+		for i := range values {
+			valuePtrs[i] = &values[i]
+		}
+
+		if err := rows.Scan(valuePtrs...); err != nil {
+			return nil, fmt.Errorf("scan failed: %w", err)
+		}
+
+		data := make(map[string]any, len(columns))
+		for i, col := range columns {
+			data[col] = convertSQLValue(values[i], types[i].DatabaseTypeName())
+		}
+		result = append(result, data)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return result, nil
+}
+
+func (s *SQLEventSource) dedupKey(id string) string {
+	return "sql_event:" + s.table + ":" + id
+}
@@ -0,0 +1,219 @@
+package source
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func newOutboxTestSource(t *testing.T, outbox *config.OutboxConfig) (*SQLEventSource, *sql.DB) {
+	t.Helper()
+
+	dsn := filepath.Join(t.TempDir(), "outbox.db")
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := db.Exec(`CREATE TABLE outbox (
+		id INTEGER PRIMARY KEY,
+		payload TEXT,
+		created_at DATETIME,
+		processed_at DATETIME,
+		attempts INTEGER NOT NULL DEFAULT 0
+	)`); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := NewSQLEventSource(config.SourceV2{
+		Driver:          "sqlite",
+		DSN:             dsn,
+		Table:           "outbox",
+		TimestampColumn: "created_at",
+		BatchSize:       2,
+		Outbox:          outbox,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := src.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { src.Close() })
+
+	return src, db
+}
+
+func insertOutbox(t *testing.T, db *sql.DB, ids ...int) {
+	t.Helper()
+	for _, id := range ids {
+		if _, err := db.Exec(`INSERT INTO outbox (id, payload, created_at) VALUES (?, ?, ?)`, id, "event", time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func pollOutboxRecords(t *testing.T, src *SQLEventSource) []Record {
+	t.Helper()
+
+	records := make(chan Record, 100)
+	if err := src.poll(context.Background(), records); err != nil {
+		t.Fatalf("poll failed: %v", err)
+	}
+	close(records)
+
+	var out []Record
+	for r := range records {
+		out = append(out, r)
+	}
+	return out
+}
+
+func recordIDs(records []Record) []int64 {
+	ids := make([]int64, len(records))
+	for i, r := range records {
+		ids[i], _ = r.Data["id"].(int64)
+	}
+	return ids
+}
+
+func queryInts(t *testing.T, db *sql.DB, query string) []int64 {
+	t.Helper()
+
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var out []int64
+	for rows.Next() {
+		var v int64
+		if err := rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestSQLEventOutboxDelete(t *testing.T) {
+	src, db := newOutboxTestSource(t, &config.OutboxConfig{OnAck: "delete", AttemptsColumn: "attempts"})
+	insertOutbox(t, db, 10, 11, 12)
+
+	// batch_size(2)만큼 전달, 확인 대기 중인 행은 다시 전달하지 않음
+	first := pollOutboxRecords(t, src)
+	if got := recordIDs(first); !reflect.DeepEqual(got, []int64{10, 11}) {
+		t.Fatalf("first poll = %v", got)
+	}
+	if got := recordIDs(pollOutboxRecords(t, src)); !reflect.DeepEqual(got, []int64{12}) {
+		t.Fatalf("second poll = %v", got)
+	}
+	if got := queryInts(t, db, `SELECT attempts FROM outbox ORDER BY id`); !reflect.DeepEqual(got, []int64{1, 1, 1}) {
+		t.Errorf("attempts = %v", got)
+	}
+
+	if err := src.Ack(context.Background(), first); err != nil {
+		t.Fatal(err)
+	}
+	if got := queryInts(t, db, `SELECT id FROM outbox ORDER BY id`); !reflect.DeepEqual(got, []int64{12}) {
+		t.Fatalf("remaining rows = %v", got)
+	}
+
+	// 늦게 커밋된 작은 ID도 조회됨
+	insertOutbox(t, db, 5)
+	if got := recordIDs(pollOutboxRecords(t, src)); !reflect.DeepEqual(got, []int64{5}) {
+		t.Fatalf("late commit poll = %v", got)
+	}
+
+	// Ack 후 삭제에 실패해 남은 행은 다시 전달하지 않고 정리
+	_ = src.dedup.MarkProcessed(context.Background(), src.dedupKey("12"))
+	src.mu.Lock()
+	delete(src.inflight, "12")
+	src.mu.Unlock()
+	if got := pollOutboxRecords(t, src); len(got) != 0 {
+		t.Fatalf("expected no records, got %v", recordIDs(got))
+	}
+	if got := queryInts(t, db, `SELECT id FROM outbox ORDER BY id`); !reflect.DeepEqual(got, []int64{5}) {
+		t.Errorf("remaining rows = %v", got)
+	}
+}
+
+func TestSQLEventOutboxVisibilityTimeout(t *testing.T) {
+	src, db := newOutboxTestSource(t, &config.OutboxConfig{
+		ProcessedColumn:   "processed_at",
+		AttemptsColumn:    "attempts",
+		MaxAttempts:       2,
+		VisibilityTimeout: 1,
+	})
+	insertOutbox(t, db, 1, 2)
+
+	first := pollOutboxRecords(t, src)
+	if len(first) != 2 {
+		t.Fatalf("first poll = %v", recordIDs(first))
+	}
+	if err := src.Ack(context.Background(), first[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if got := queryInts(t, db, `SELECT id FROM outbox WHERE processed_at IS NOT NULL`); !reflect.DeepEqual(got, []int64{1}) {
+		t.Fatalf("processed rows = %v", got)
+	}
+
+	// 확인되지 않은 행은 visibility timeout 후 재전달, max_attempts 이후에는 중단
+	time.Sleep(5 * time.Millisecond)
+	if got := recordIDs(pollOutboxRecords(t, src)); !reflect.DeepEqual(got, []int64{2}) {
+		t.Fatalf("redelivery poll = %v", got)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if got := pollOutboxRecords(t, src); len(got) != 0 {
+		t.Fatalf("expected no records after max attempts, got %v", recordIDs(got))
+	}
+	if got := queryInts(t, db, `SELECT attempts FROM outbox WHERE id = 2`); !reflect.DeepEqual(got, []int64{2}) {
+		t.Errorf("attempts = %v", got)
+	}
+}
+
+func TestSQLEventOutboxLookback(t *testing.T) {
+	src, db := newOutboxTestSource(t, &config.OutboxConfig{Lookback: 60000})
+	insertOutbox(t, db, 1, 3)
+
+	first := pollOutboxRecords(t, src)
+	if got := recordIDs(first); !reflect.DeepEqual(got, []int64{1, 3}) {
+		t.Fatalf("first poll = %v", got)
+	}
+	if err := src.Ack(context.Background(), first); err != nil {
+		t.Fatal(err)
+	}
+
+	// ID 2가 lastID(3) 이후에 커밋된 경우 lookback 구간에서 발견, 처리된 1, 3은 제외
+	insertOutbox(t, db, 2)
+	if got := recordIDs(pollOutboxRecords(t, src)); !reflect.DeepEqual(got, []int64{2}) {
+		t.Fatalf("lookback poll = %v", got)
+	}
+	if got := src.GetCheckpoint()["last_id"]; got != int64(3) {
+		t.Errorf("last_id = %v", got)
+	}
+}
+
+func TestNewSQLEventSourceInvalidOutbox(t *testing.T) {
+	tests := []struct {
+		name   string
+		outbox config.OutboxConfig
+	}{
+		{"mark without processed_column", config.OutboxConfig{OnAck: "mark"}},
+		{"max_attempts without attempts_column", config.OutboxConfig{MaxAttempts: 3}},
+		{"redis without url", config.OutboxConfig{DedupStorage: "redis"}},
+	}
+	for _, tt := range tests {
+		outbox := tt.outbox
+		if _, err := NewSQLEventSource(config.SourceV2{Driver: "sqlite", Table: "outbox", Outbox: &outbox}); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package source
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/config"
+)
+
+func openTestSQLite(t *testing.T, rows int) string {
+	t.Helper()
+
+	dsn := filepath.Join(t.TempDir(), "test.db")
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE orders (id INTEGER PRIMARY KEY, amount REAL, status TEXT)`); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= rows; i++ {
+		if _, err := db.Exec(`INSERT INTO orders VALUES (?, ?, ?)`, i, float64(i)*1.5, "paid"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dsn
+}
+
+func insertTestOrders(t *testing.T, dsn string, from, to int) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	for i := from; i <= to; i++ {
+		if _, err := db.Exec(`INSERT INTO orders VALUES (?, ?, ?)`, i, float64(i)*1.5, "new"); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readSQLIDs(t *testing.T, src *SQLSource) []int64 {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := src.Open(ctx); err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer src.Close()
+
+	records, errs := src.Read(ctx)
+	var ids []int64
+	for r := range records {
+		id, ok := r.Data["id"].(int64)
+		if !ok {
+			t.Fatalf("id has type %T", r.Data["id"])
+		}
+		ids = append(ids, id)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func seq(from, to int64) []int64 {
+	var out []int64
+	for i := from; i <= to; i++ {
+		out = append(out, i)
+	}
+	return out
+}
+
+func TestSQLSourceIncremental(t *testing.T) {
+	dsn := openTestSQLite(t, 5)
+	cfg := config.SourceV2{
+		Type:        "sql",
+		Driver:      "sqlite",
+		DSN:         dsn,
+		Query:       "SELECT id, amount, status FROM orders WHERE status <> ?;",
+		Params:      []string{"void"},
+		Incremental: &config.IncrementalConfig{Column: "id", StateKey: "orders_id"},
+	}
+
+	src, err := NewSQLSource(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := readSQLIDs(t, src); !reflect.DeepEqual(ids, seq(1, 5)) {
+		t.Fatalf("first run ids = %v", ids)
+	}
+
+	checkpoint := src.GetCheckpoint()
+	want := map[string]any{"orders_id": map[string]any{"value": "5", "type": "int"}}
+	if !reflect.DeepEqual(checkpoint, want) {
+		t.Fatalf("checkpoint = %v, want %v", checkpoint, want)
+	}
+
+	// 새 소스 인스턴스에서 체크포인트 복원 후 새 행만 읽음
+	insertTestOrders(t, dsn, 6, 8)
+	resumed, _ := NewSQLSource(cfg)
+	if err := resumed.SetCheckpoint(checkpoint); err != nil {
+		t.Fatal(err)
+	}
+	if ids := readSQLIDs(t, resumed); !reflect.DeepEqual(ids, seq(6, 8)) {
+		t.Fatalf("resumed run ids = %v", ids)
+	}
+	if got := resumed.GetCheckpoint()["orders_id"]; !reflect.DeepEqual(got, map[string]any{"value": "8", "type": "int"}) {
+		t.Errorf("resumed checkpoint = %v", got)
+	}
+
+	// 새 행이 없으면 high-water mark 유지
+	if ids := readSQLIDs(t, resumed); len(ids) != 0 {
+		t.Errorf("expected no rows, got %v", ids)
+	}
+	if got := resumed.GetCheckpoint()["orders_id"]; !reflect.DeepEqual(got, map[string]any{"value": "8", "type": "int"}) {
+		t.Errorf("checkpoint after empty run = %v", got)
+	}
+}
+
+func TestSQLSourcePartitioned(t *testing.T) {
+	dsn := openTestSQLite(t, 103)
+
+	src, err := NewSQLSource(config.SourceV2{
+		Type:        "sql",
+		Driver:      "sqlite",
+		DSN:         dsn,
+		Query:       "SELECT * FROM orders",
+		Partition:   &config.PartitionConfig{Column: "id", Count: 4},
+		Incremental: &config.IncrementalConfig{Column: "id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := readSQLIDs(t, src); !reflect.DeepEqual(ids, seq(1, 103)) {
+		t.Fatalf("partitioned ids = %v", ids)
+	}
+
+	insertTestOrders(t, dsn, 104, 110)
+	if ids := readSQLIDs(t, src); !reflect.DeepEqual(ids, seq(104, 110)) {
+		t.Fatalf("incremental partitioned ids = %v", ids)
+	}
+}
+
+func TestSplitKeyRange(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		lower, upper any
+		n            int
+		want         []keyRange
+	}{
+		{
+			name: "int", lower: int64(1), upper: int64(10), n: 3,
+			want: []keyRange{{int64(1), int64(5), false}, {int64(5), int64(9), false}, {int64(9), int64(10), true}},
+		},
+		{
+			name: "int single value", lower: int64(7), upper: int64(7), n: 4,
+			want: []keyRange{{int64(7), int64(7), true}},
+		},
+		{
+			name: "int more partitions than keys", lower: int64(1), upper: int64(3), n: 8,
+			want: []keyRange{{int64(1), int64(2), false}, {int64(2), int64(3), false}, {int64(3), int64(3), true}},
+		},
+		{
+			name: "float", lower: 0.0, upper: 1.0, n: 2,
+			want: []keyRange{{0.0, 0.5, false}, {0.5, 1.0, true}},
+		},
+		{
+			name: "time", lower: day, upper: day.Add(48 * time.Hour), n: 2,
+			want: []keyRange{{day, day.Add(24 * time.Hour), false}, {day.Add(24 * time.Hour), day.Add(48 * time.Hour), true}},
+		},
+		{
+			name: "text date", lower: "2024-01-01 00:00:00", upper: "2024-01-03 00:00:00", n: 2,
+			want: []keyRange{{day, day.Add(24 * time.Hour), false}, {day.Add(24 * time.Hour), day.Add(48 * time.Hour), true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitKeyRange(tt.lower, tt.upper, tt.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := splitKeyRange("a", "z", 2); err == nil {
+		t.Error("expected error for non numeric key")
+	}
+}
+
+func TestConvertSQLValue(t *testing.T) {
+	tests := []struct {
+		dbType string
+		in     any
+		want   any
+	}{
+		{"BIGINT", []byte("42"), int64(42)},
+		{"UNSIGNED BIGINT", []byte("18446744073709551615"), uint64(18446744073709551615)},
+		{"INT4", []byte("-7"), int64(-7)},
+		{"DECIMAL", []byte("12.50"), 12.5},
+		{"NUMERIC", []byte("100"), int64(100)},
+		{"DOUBLE", []byte("1e3"), 1000.0},
+		{"BOOL", []byte("t"), true},
+		{"BIT", []byte{0x01}, int64(1)},
+		{"DATETIME", []byte("2024-03-01 12:30:00"), time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)},
+		{"DATE", []byte("2024-03-01"), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"DATETIME", []byte("0000-00-00 00:00:00"), "0000-00-00 00:00:00"},
+		{"JSON", []byte(`{"a":[1,"b"]}`), map[string]any{"a": []any{1.0, "b"}}},
+		{"BLOB", []byte{0xff, 0x00}, []byte{0xff, 0x00}},
+		{"VARCHAR", []byte("hello"), "hello"},
+		{"INT", []byte("abc"), "abc"},
+		{"INT", int64(5), int64(5)},
+		{"TEXT", nil, nil},
+	}
+
+	for _, tt := range tests {
+		if got := convertSQLValue(tt.in, tt.dbType); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("convertSQLValue(%v, %s) = %#v, want %#v", tt.in, tt.dbType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package source
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqlTimeLayouts 드라이버가 텍스트로 돌려주는 날짜/시간 형식
+var sqlTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC3339Nano,
+	"2006-01-02",
+}
+
+// convertSQLValue 컬럼 타입(DatabaseTypeName)에 따라 드라이버 값을 Go 값으로 변환
+// 드라이버가 이미 타입을 지정한 값은 그대로 두고, []byte로 받은 값만 변환한다
+// 변환할 수 없는 값은 문자열로 유지
+func convertSQLValue(val any, dbType string) any {
+	b, ok := val.([]byte)
+	if !ok {
+		return val
+	}
+
+	s := string(b)
+	t := strings.ToUpper(dbType)
+	unsigned := strings.HasPrefix(t, "UNSIGNED ")
+	t = strings.TrimPrefix(t, "UNSIGNED ")
+
+	switch t {
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT", "YEAR",
+		"INT2", "INT4", "INT8", "SERIAL", "BIGSERIAL":
+		if unsigned {
+			if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+				if u <= math.MaxInt64 {
+					return int64(u)
+				}
+				return u
+			}
+			return s
+		}
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+
+	case "DECIMAL", "NUMERIC":
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+
+	case "FLOAT", "DOUBLE", "REAL", "FLOAT4", "FLOAT8", "DOUBLE PRECISION":
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+
+	case "BOOL", "BOOLEAN":
+		if v, err := strconv.ParseBool(s); err == nil {
+			return v
+		}
+
+	case "BIT":
+		if len(b) <= 8 {
+			var buf [8]byte
+			copy(buf[8-len(b):], b)
+			return int64(binary.BigEndian.Uint64(buf[:]))
+		}
+
+	case "DATE", "DATETIME", "TIMESTAMP", "TIMESTAMPTZ":
+		if ts, ok := parseSQLTime(s); ok {
+			return ts
+		}
+
+	case "JSON", "JSONB":
+		var v any
+		if err := json.Unmarshal(b, &v); err == nil {
+			return v
+		}
+
+	case "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "BINARY", "VARBINARY", "BYTEA", "GEOMETRY":
+		return b
+	}
+
+	return s
+}
+
+func parseSQLTime(s string) (time.Time, bool) {
+	for _, layout := range sqlTimeLayouts {
+		if ts, err := time.Parse(layout, s); err == nil {
+			return ts, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// normalizeMark high-water mark 비교용 값으로 정규화 (int64, float64, time.Time, string)
+func normalizeMark(v any) (any, bool) {
+	switch val := v.(type) {
+	case int64, float64, string, time.Time:
+		return val, true
+	case int:
+		return int64(val), true
+	case int32:
+		return int64(val), true
+	case int16:
+		return int64(val), true
+	case int8:
+		return int64(val), true
+	case uint64:
+		if val <= math.MaxInt64 {
+			return int64(val), true
+		}
+		return float64(val), true
+	case uint32:
+		return int64(val), true
+	case uint16:
+		return int64(val), true
+	case uint8:
+		return int64(val), true
+	case float32:
+		return float64(val), true
+	case []byte:
+		return string(val), true
+	}
+	return nil, false
+}
+
+// compareMarks 정규화된 두 값 비교 (-1, 0, 1)
+func compareMarks(a, b any) int {
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return compareOrdered(x, y)
+		case float64:
+			return compareOrdered(float64(x), y)
+		}
+	case float64:
+		switch y := b.(type) {
+		case float64:
+			return compareOrdered(x, y)
+		case int64:
+			return compareOrdered(x, float64(y))
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y)
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y)
+		}
+	}
+	return strings.Compare(formatMark(a), formatMark(b))
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// formatMark 체크포인트 저장용 문자열 (JSON 왕복에도 정밀도 유지)
+func formatMark(v any) string {
+	switch val := v.(type) {
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	case string:
+		return val
+	}
+	return fmt.Sprint(v)
+}
+
+func markType(v any) string {
+	switch v.(type) {
+	case int64:
+		return "int"
+	case float64:
+		return "float"
+	case time.Time:
+		return "time"
+	}
+	return "string"
+}
+
+// parseMark formatMark/markType 결과에서 값 복원
+func parseMark(value, kind string) (any, error) {
+	switch kind {
+	case "int":
+		return strconv.ParseInt(value, 10, 64)
+	case "float":
+		return strconv.ParseFloat(value, 64)
+	case "time":
+		return time.Parse(time.RFC3339Nano, value)
+	case "string", "":
+		return value, nil
+	}
+	return nil, fmt.Errorf("unknown type %s", kind)
+}
+
+// keyRange 파티션 하나의 키 범위 [lower, upper) (마지막 파티션은 upper 포함)
+type keyRange struct {
+	lower any
+	upper any
+	last  bool
+}
+
+// splitKeyRange [lower, upper]를 최대 n개의 범위로 분할 (숫자 또는 날짜)
+func splitKeyRange(lower, upper any, n int) ([]keyRange, error) {
+	lo, ok := normalizeMark(lower)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T", lower)
+	}
+	hi, ok := normalizeMark(upper)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T", upper)
+	}
+
+	// 텍스트로 받은 숫자/날짜 허용
+	if s, ok := lo.(string); ok {
+		lo = parseKeyString(s)
+	}
+	if s, ok := hi.(string); ok {
+		hi = parseKeyString(s)
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	var ranges []keyRange
+	switch l := lo.(type) {
+	case int64:
+		h, ok := hi.(int64)
+		if !ok {
+			return nil, fmt.Errorf("mismatched key types %T and %T", lo, hi)
+		}
+		// 구간 길이 계산 시 오버플로를 피하기 위해 float64 사용
+		step := int64(math.Ceil((float64(h) - float64(l) + 1) / float64(n)))
+		if step < 1 {
+			step = 1
+		}
+		for start := l; start <= h; start += step {
+			end := start + step
+			if end > h || end < start {
+				ranges = append(ranges, keyRange{lower: start, upper: h, last: true})
+				break
+			}
+			ranges = append(ranges, keyRange{lower: start, upper: end})
+		}
+
+	case float64:
+		h, ok := hi.(float64)
+		if !ok {
+			return nil, fmt.Errorf("mismatched key types %T and %T", lo, hi)
+		}
+		step := (h - l) / float64(n)
+		if step <= 0 {
+			return []keyRange{{lower: l, upper: h, last: true}}, nil
+		}
+		for i := 0; i < n; i++ {
+			r := keyRange{lower: l + step*float64(i), upper: l + step*float64(i+1)}
+			if i == n-1 {
+				r.upper, r.last = h, true
+			}
+			ranges = append(ranges, r)
+		}
+
+	case time.Time:
+		h, ok := hi.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("mismatched key types %T and %T", lo, hi)
+		}
+		step := h.Sub(l) / time.Duration(n)
+		if step <= 0 {
+			return []keyRange{{lower: l, upper: h, last: true}}, nil
+		}
+		for i := 0; i < n; i++ {
+			r := keyRange{lower: l.Add(step * time.Duration(i)), upper: l.Add(step * time.Duration(i+1))}
+			if i == n-1 {
+				r.upper, r.last = h, true
+			}
+			ranges = append(ranges, r)
+		}
+
+	default:
+		return nil, fmt.Errorf("key must be numeric or date, got %T", lo)
+	}
+
+	return ranges, nil
+}
+
+func parseKeyString(s string) any {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	if ts, ok := parseSQLTime(s); ok {
+		return ts
+	}
+	return s
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/conduix/conduix/pipeline-core/pkg/actor"
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
 )
 
 // PipelineActor is an actor that wraps StreamProcessor.
@@ -136,6 +137,9 @@ func (p *PipelineActor) PreStart(ctx actor.ActorContext) error {
 	// Create stages
 	var stages []Stage
 	for _, sc := range p.config.Stages {
+		if sc.Type == "dedup" {
+			sc.Config = withDedupNamespace(sc.Config, dedup.NamespaceOf(p.name, sc.Name))
+		}
 		s, err := NewStage(sc)
 		if err != nil {
 			return fmt.Errorf("create stage %s: %w", sc.Name, err)
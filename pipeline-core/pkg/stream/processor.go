@@ -2,6 +2,7 @@ package stream
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sync"
@@ -9,6 +10,9 @@ import (
 	"time"
 )
 
+// drainInterval is how often records held by buffering stages are released
+const drainInterval = time.Second
+
 // ProcessorState represents the current state of the processor
 type ProcessorState int32
 
@@ -164,17 +168,32 @@ func (p *StreamProcessor) Start(ctx context.Context) error {
 func (p *StreamProcessor) processLoop(records <-chan *Record) {
 	defer p.wg.Done()
 	defer func() {
-		// Flush sink on exit
+		// Release records still held by stages, then flush sink on exit
+		p.drainStages(context.Background(), true)
 		if err := p.sink.Flush(context.Background()); err != nil {
 			p.logger.Error("Sink flush error on shutdown", "error", err)
 		}
 	}()
 
+	// Periodically release held records only when a stage buffers them
+	var drainTick <-chan time.Time
+	for _, s := range p.stages {
+		if _, ok := s.(Drainer); ok {
+			ticker := time.NewTicker(drainInterval)
+			defer ticker.Stop()
+			drainTick = ticker.C
+			break
+		}
+	}
+
 	for {
 		select {
 		case <-p.ctx.Done():
 			return
 
+		case <-drainTick:
+			p.drainStages(p.ctx, false)
+
 		case record, ok := <-records:
 			if !ok {
 				// Channel closed, source finished
@@ -196,47 +215,103 @@ func (p *StreamProcessor) processLoop(records <-chan *Record) {
 
 			// Process through stage chain using DIRECT CALLS
 			// This is the key optimization: no message passing, no actor overhead
-			result, err := p.processRecord(record)
-			if err != nil {
-				p.updateStats(func(s *ProcessorStats) {
-					s.ErrorCount++
-				})
-				p.logger.Debug("Stage error", "error", err)
-				continue
-			}
+			result, err := p.processRecord(p.ctx, record, 0)
+			p.deliver(p.ctx, result, err)
+		}
+	}
+}
 
-			if result == nil {
-				// Record was filtered out
-				p.updateStats(func(s *ProcessorStats) {
-					s.FilteredCount++
-				})
-				continue
-			}
+// deliver writes the result of the stage chain to the sink and updates stats
+func (p *StreamProcessor) deliver(ctx context.Context, result *Record, err error) {
+	var held *HeldError
+	if errors.As(err, &held) {
+		// The record comes back through Drain; only a superseded record is dropped now
+		p.settleStages(ctx, true)
+		if held.Superseded {
+			p.updateStats(func(s *ProcessorStats) {
+				s.FilteredCount++
+			})
+		}
+		return
+	}
 
-			// Write to sink (direct call, sink handles batching internally)
-			if err := p.sink.Write(p.ctx, result); err != nil {
-				p.updateStats(func(s *ProcessorStats) {
-					s.ErrorCount++
-				})
-				p.logger.Error("Sink write error", "error", err)
-				continue
-			}
+	if err != nil {
+		p.settleStages(ctx, false)
+		p.updateStats(func(s *ProcessorStats) {
+			s.ErrorCount++
+		})
+		p.logger.Debug("Stage error", "error", err)
+		return
+	}
 
-			// Update output stats
-			p.updateStats(func(s *ProcessorStats) {
+	if result == nil {
+		// Record was filtered out
+		p.settleStages(ctx, true)
+		p.updateStats(func(s *ProcessorStats) {
+			s.FilteredCount++
+		})
+		return
+	}
+
+	// Write to sink (direct call, sink handles batching internally)
+	if err := p.sink.Write(ctx, result); err != nil {
+		p.settleStages(ctx, false)
+		p.updateStats(func(s *ProcessorStats) {
+			s.ErrorCount++
+		})
+		p.logger.Error("Sink write error", "error", err)
+		return
+	}
+	p.settleStages(ctx, true)
+
+	// Update output stats
+	p.updateStats(func(s *ProcessorStats) {
+		s.OutputCount++
+	})
+}
+
+// settleStages tells committing stages whether the current record was delivered
+func (p *StreamProcessor) settleStages(ctx context.Context, delivered bool) {
+	for _, stage := range p.stages {
+		c, ok := stage.(Committer)
+		if !ok {
+			continue
+		}
+		if !delivered {
+			c.Abandon()
+			continue
+		}
+		if err := c.Commit(ctx); err != nil {
+			p.logger.Warn("Stage commit error", "stage", stage.Name(), "error", err)
+		}
+	}
+}
+
+// drainStages releases records held by buffering stages and runs them
+// through the rest of the chain. force releases everything (shutdown).
+func (p *StreamProcessor) drainStages(ctx context.Context, force bool) {
+	for i, stage := range p.stages {
+		d, ok := stage.(Drainer)
+		if !ok {
+			continue
+		}
+		for _, record := range d.Drain(force) {
+			p.updateStageStats(stage.Name(), func(s *StageStats) {
 				s.OutputCount++
 			})
+			result, err := p.processRecord(ctx, record, i+1)
+			p.deliver(ctx, result, err)
 		}
 	}
 }
 
-// processRecord applies the stage chain to a single record.
+// processRecord applies the stage chain, starting at stage index start, to a single record.
 // This uses DIRECT FUNCTION CALLS - no message passing.
 // All stages execute in the same goroutine for cache locality.
-func (p *StreamProcessor) processRecord(record *Record) (*Record, error) {
+func (p *StreamProcessor) processRecord(ctx context.Context, record *Record, start int) (*Record, error) {
 	current := record
 
-	for _, stage := range p.stages {
+	for _, stage := range p.stages[start:] {
 		if current == nil {
 			return nil, nil
 		}
@@ -244,14 +319,20 @@ func (p *StreamProcessor) processRecord(record *Record) (*Record, error) {
 		startTime := time.Now()
 
 		// DIRECT FUNCTION CALL - no actor, no message
-		result, err := stage.Process(p.ctx, current)
+		result, err := stage.Process(ctx, current)
 
 		latency := time.Since(startTime)
 
-		// Update per-stage stats
+		// Update per-stage stats (a held record is counted once it is released or superseded)
+		var held *HeldError
+		isHeld := errors.As(err, &held)
 		p.updateStageStats(stage.Name(), func(s *StageStats) {
 			s.InputCount++
-			if err != nil {
+			if isHeld {
+				if held.Superseded {
+					s.FilteredCount++
+				}
+			} else if err != nil {
 				s.ErrorCount++
 			} else if result == nil {
 				s.FilteredCount++
@@ -266,6 +347,9 @@ func (p *StreamProcessor) processRecord(record *Record) (*Record, error) {
 			}
 		})
 
+		if isHeld {
+			return nil, err
+		}
 		if err != nil {
 			return nil, fmt.Errorf("stage %s: %w", stage.Name(), err)
 		}
@@ -367,6 +451,19 @@ func (p *StreamProcessor) Stats() ProcessorStats {
 		copied := *v
 		stats.StageStats[k] = &copied
 	}
+	for _, s := range p.stages {
+		ss, ok := stats.StageStats[s.Name()]
+		if !ok {
+			continue
+		}
+		if dc, ok := s.(interface{ Duplicates() int64 }); ok {
+			ss.DuplicateCount = dc.Duplicates()
+		}
+		if hc, ok := s.(interface{ Pending() int }); ok {
+			ss.HeldCount = int64(hc.Pending())
+			stats.HeldCount += ss.HeldCount
+		}
+	}
 
 	return stats
 }
@@ -5,11 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"sync"
 	"time"
 
+	"github.com/conduix/conduix/pipeline-core/pkg/codec"
 	"github.com/conduix/conduix/pipeline-core/pkg/schema"
 )
 
@@ -359,10 +361,18 @@ func (s *KafkaSink) writeBatch(ctx context.Context, records []*Record) error {
 }
 
 // FileSink writes to files
+// Supported formats are ndjson (default), parquet and avro, optionally
+// compressed with gzip or zstd.
 type FileSink struct {
 	BufferedSink
-	path string
-	file *os.File
+	path        string
+	format      string
+	compression string
+
+	writeMu    sync.Mutex
+	file       *os.File
+	compressor io.WriteCloser
+	encoder    codec.Encoder
 }
 
 func NewFileSink(name string, config map[string]any) *FileSink {
@@ -371,6 +381,16 @@ func NewFileSink(name string, config map[string]any) *FileSink {
 		path = p
 	}
 
+	format := codec.FormatNDJSON
+	if f, ok := config["format"].(string); ok && f != "" {
+		format = f
+	}
+
+	compression := codec.CompressionNone
+	if c, ok := config["compression"].(string); ok && c != "" {
+		compression = c
+	}
+
 	s := &FileSink{
 		BufferedSink: BufferedSink{
 			BaseSink: BaseSink{
@@ -381,7 +401,9 @@ func NewFileSink(name string, config map[string]any) *FileSink {
 				flushTimeout: 10 * time.Second,
 			},
 		},
-		path: path,
+		path:        path,
+		format:      format,
+		compression: compression,
 	}
 	s.init()
 	s.writeFunc = s.writeBatch
@@ -389,24 +411,77 @@ func NewFileSink(name string, config map[string]any) *FileSink {
 	return s
 }
 
+// open lazily creates the output file and encoder on first write
+func (s *FileSink) open() error {
+	if s.encoder != nil {
+		return nil
+	}
+
+	file, err := os.Create(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", s.path, err)
+	}
+
+	compressor, err := codec.NewCompressor(file, s.compression)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	encoder, err := codec.NewEncoder(s.format, compressor, codec.Options{})
+	if err != nil {
+		compressor.Close()
+		file.Close()
+		return err
+	}
+
+	s.file = file
+	s.compressor = compressor
+	s.encoder = encoder
+	return nil
+}
+
 func (s *FileSink) writeBatch(ctx context.Context, records []*Record) error {
-	// TODO: Implement actual file writing
-	fmt.Printf("[File] Would write %d records to %s\n", len(records), s.path)
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if err := s.open(); err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		if err := s.encoder.Encode(codec.NormalizeRecord(record.Data)); err != nil {
+			return fmt.Errorf("failed to encode record: %w", err)
+		}
+	}
 	return nil
 }
 
+// Close flushes buffered records, then finalizes the encoder, the
+// compressor and the file in that order
 func (s *FileSink) Close() error {
-	if s.file != nil {
-		s.file.Close()
+	err := s.BufferedSink.Close()
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	if s.encoder == nil {
+		return err
 	}
-	return s.BufferedSink.Close()
+	for _, closer := range []io.Closer{s.encoder, s.compressor, s.file} {
+		if cerr := closer.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	s.encoder, s.compressor, s.file = nil, nil, nil
+	return err
 }
 
 // ValidatingSink wraps a sink with schema validation
 // Used for output validation (before Writer)
 type ValidatingSink struct {
+	recordValidator
 	inner      Sink
-	schema     *schema.DataSchema
 	dropOnFail bool // true면 검증 실패 시 레코드 드롭, false면 에러 반환
 
 	// Stats
@@ -418,9 +493,9 @@ type ValidatingSink struct {
 // NewValidatingSink creates a validating sink wrapper
 func NewValidatingSink(inner Sink, s *schema.DataSchema, dropOnFail bool) *ValidatingSink {
 	return &ValidatingSink{
-		inner:      inner,
-		schema:     s,
-		dropOnFail: dropOnFail,
+		recordValidator: recordValidator{schema: s},
+		inner:           inner,
+		dropOnFail:      dropOnFail,
 	}
 }
 
@@ -429,18 +504,20 @@ func (s *ValidatingSink) Type() string { return "validating_" + s.inner.Type() }
 
 // Write validates and then writes the record
 func (s *ValidatingSink) Write(ctx context.Context, record *Record) error {
-	if s.schema != nil {
-		if err := s.schema.Validate(record.Data); err != nil {
-			s.statsMu.Lock()
-			s.invalidCount++
-			s.statsMu.Unlock()
-
-			if s.dropOnFail {
-				// 드롭 모드: 검증 실패 레코드 무시
-				return nil
-			}
-			return fmt.Errorf("output validation failed: %w", err)
+	coercions, err := s.validate(record)
+	if err != nil {
+		s.statsMu.Lock()
+		s.invalidCount++
+		s.statsMu.Unlock()
+
+		if s.deadLetter != nil {
+			return s.sendDeadLetter(ctx, s.Name(), record, coercions, err)
+		}
+		if s.dropOnFail {
+			// 드롭 모드: 검증 실패 레코드 무시
+			return nil
 		}
+		return fmt.Errorf("output validation failed: %w", err)
 	}
 
 	s.statsMu.Lock()
@@ -450,14 +527,26 @@ func (s *ValidatingSink) Write(ctx context.Context, record *Record) error {
 	return s.inner.Write(ctx, record)
 }
 
-// Flush flushes the underlying sink
+// Flush flushes the underlying sink and the dead letter sink
 func (s *ValidatingSink) Flush(ctx context.Context) error {
-	return s.inner.Flush(ctx)
+	err := s.inner.Flush(ctx)
+	if s.deadLetter != nil {
+		if dlErr := s.deadLetter.Flush(ctx); dlErr != nil && err == nil {
+			err = dlErr
+		}
+	}
+	return err
 }
 
-// Close closes the underlying sink
+// Close closes the underlying sink and the dead letter sink
 func (s *ValidatingSink) Close() error {
-	return s.inner.Close()
+	err := s.inner.Close()
+	if s.deadLetter != nil {
+		if dlErr := s.deadLetter.Close(); dlErr != nil && err == nil {
+			err = dlErr
+		}
+	}
+	return err
 }
 
 // ValidationStats returns validation statistics
@@ -472,6 +561,16 @@ func (s *ValidatingSink) SetSchema(schema *schema.DataSchema) {
 	s.schema = schema
 }
 
+// SetCoerce enables coercion and defaults before validation
+func (s *ValidatingSink) SetCoerce(coerce bool) {
+	s.coerce = coerce
+}
+
+// SetDeadLetter routes records that fail validation to sink
+func (s *ValidatingSink) SetDeadLetter(sink Sink) {
+	s.deadLetter = sink
+}
+
 // NewSink creates a sink from configuration
 func NewSink(cfg SinkConfig) (Sink, error) {
 	switch cfg.Type {
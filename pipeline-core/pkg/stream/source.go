@@ -2,10 +2,15 @@ package stream
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
+
+	pkgconfig "github.com/conduix/conduix/pipeline-core/pkg/config"
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+	"github.com/conduix/conduix/pipeline-core/pkg/source"
 )
 
 // BaseSource provides common source functionality
@@ -300,22 +305,47 @@ func (s *FileSource) Close() error {
 	return nil
 }
 
-// HTTPSource receives data via HTTP
+// HTTPSource receives data pushed over HTTP (webhook ingest).
+// Stream stages and sinks do not report written records back to the source, so a
+// request is answered with 200 as soon as its records are handed to the processor.
+// Delivery is therefore at-most-once: records still in flight are lost if the
+// process stops. Use the http_server source of a v2 pipeline, which answers only
+// after the sink has written the records, when a 200 must mean they are stored.
+// The server answers 429 while paused or when the buffer stays full.
 type HTTPSource struct {
 	BaseSource
-	address string
-	path    string
+	server *source.HTTPServerSource
 }
 
-func NewHTTPSource(name string, config map[string]any) *HTTPSource {
-	address := ":8080"
+func NewHTTPSource(name string, config map[string]any) (*HTTPSource, error) {
+	cfg := pkgconfig.SourceV2{
+		Type:    "http_server",
+		Address: ":8080",
+		Path:    "/events",
+	}
 	if a, ok := config["address"].(string); ok {
-		address = a
+		cfg.Address = a
 	}
-
-	path := "/events"
 	if p, ok := config["path"].(string); ok {
-		path = p
+		cfg.Path = p
+	}
+	cfg.BufferSize = intOption(config, "buffer_size", 10000)
+	cfg.MaxBodySize = intOption(config, "max_body_size", 0)
+	cfg.AckTimeout = intOption(config, "ack_timeout", 0)
+
+	if auth, ok := config["auth"].(map[string]any); ok {
+		cfg.IngestAuth = &pkgconfig.IngestAuthConfig{}
+		cfg.IngestAuth.Type, _ = auth["type"].(string)
+		cfg.IngestAuth.Header, _ = auth["header"].(string)
+		cfg.IngestAuth.Secret, _ = auth["secret"].(string)
+		cfg.IngestAuth.Algorithm, _ = auth["algorithm"].(string)
+		cfg.IngestAuth.Encoding, _ = auth["encoding"].(string)
+		cfg.IngestAuth.Prefix, _ = auth["prefix"].(string)
+	}
+
+	server, err := source.NewHTTPServerSource(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	return &HTTPSource{
@@ -323,24 +353,195 @@ func NewHTTPSource(name string, config map[string]any) *HTTPSource {
 			name:       name,
 			typ:        "http_server",
 			config:     config,
-			bufferSize: 10000,
+			bufferSize: cfg.BufferSize,
 		},
-		address: address,
-		path:    path,
-	}
+		server: server,
+	}, nil
+}
+
+// Addr returns the address the server is listening on
+func (s *HTTPSource) Addr() string {
+	return s.server.Addr()
+}
+
+func (s *HTTPSource) Pause() {
+	s.BaseSource.Pause()
+	s.server.Pause()
+}
+
+func (s *HTTPSource) Resume() {
+	s.BaseSource.Resume()
+	s.server.Resume()
 }
 
 func (s *HTTPSource) Start(ctx context.Context, out chan<- *Record) error {
 	defer close(out)
 
-	// TODO: Implement actual HTTP server
-	// For now, wait for context cancellation
-	<-ctx.Done()
-	return ctx.Err()
+	if err := s.server.Open(ctx); err != nil {
+		return err
+	}
+	records, errs := s.server.Read(ctx)
+
+	var offset int64
+	for {
+		select {
+		case rec, ok := <-records:
+			if !ok {
+				return ctx.Err()
+			}
+			offset++
+			s.incrementInput()
+
+			record := &Record{
+				Data: rec.Data,
+				Metadata: RecordMetadata{
+					Source: s.name,
+					Offset: offset,
+				},
+				Timestamp: time.UnixMilli(rec.Metadata.Timestamp),
+			}
+
+			select {
+			case out <- record:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			// Nothing downstream acks, so release the request once the record is handed off
+			_ = s.server.Ack(ctx, []source.Record{rec})
+
+		case err, ok := <-errs:
+			if ok && err != nil {
+				return err
+			}
+			errs = nil
+		}
+	}
 }
 
 func (s *HTTPSource) Close() error {
-	return nil
+	return s.server.Close()
+}
+
+// GenerateSource generates synthetic records from a Bloblang mapping or a data schema
+type GenerateSource struct {
+	BaseSource
+	generator *source.GenerateSource
+}
+
+// NewGenerateSource creates a generate source. The rate can be given as
+// rate_limit (records per second) or as an interval between records.
+func NewGenerateSource(name string, config map[string]any) (*GenerateSource, error) {
+	cfg := pkgconfig.SourceV2{Type: "generate"}
+	cfg.Mapping, _ = config["mapping"].(string)
+	cfg.Count = intOption(config, "count", 0)
+	cfg.Seed = int64(intOption(config, "seed", 0))
+
+	switch v := config["rate_limit"].(type) {
+	case int:
+		cfg.RateLimit = float64(v)
+	case float64:
+		cfg.RateLimit = v
+	}
+	if i, ok := config["interval"].(string); ok && cfg.RateLimit == 0 {
+		d, err := time.ParseDuration(i)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval: %w", err)
+		}
+		if d > 0 {
+			cfg.RateLimit = float64(time.Second) / float64(d)
+		}
+	}
+
+	if raw, ok := config["schema"]; ok {
+		b, err := json.Marshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema: %w", err)
+		}
+		cfg.Schema = &schema.DataSchema{}
+		if err := json.Unmarshal(b, cfg.Schema); err != nil {
+			return nil, fmt.Errorf("invalid schema: %w", err)
+		}
+	}
+
+	generator, err := source.NewGenerateSource(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GenerateSource{
+		BaseSource: BaseSource{
+			name:       name,
+			typ:        "generate",
+			config:     config,
+			bufferSize: intOption(config, "buffer_size", 1000),
+		},
+		generator: generator,
+	}, nil
+}
+
+func (s *GenerateSource) Start(ctx context.Context, out chan<- *Record) error {
+	defer close(out)
+
+	genCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	records, errs := s.generator.Read(genCtx)
+
+	var offset int64
+	for {
+		// Paused: stop pulling so the generator blocks instead of dropping records
+		for s.IsPaused() {
+			select {
+			case <-time.After(100 * time.Millisecond):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		select {
+		case rec, ok := <-records:
+			if !ok {
+				if err := <-errs; err != nil {
+					return err
+				}
+				return ctx.Err()
+			}
+			offset++
+			s.incrementInput()
+
+			record := &Record{
+				Data: rec.Data,
+				Metadata: RecordMetadata{
+					Source: s.name,
+					Offset: offset,
+				},
+				Timestamp: time.UnixMilli(rec.Metadata.Timestamp),
+			}
+
+			select {
+			case out <- record:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *GenerateSource) Close() error {
+	return s.generator.Close()
+}
+
+// intOption reads an integer option that may be decoded as int or float64
+func intOption(config map[string]any, key string, def int) int {
+	switch v := config[key].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return def
 }
 
 // NewSource creates a source from configuration
@@ -353,7 +554,9 @@ func NewSource(cfg SourceConfig) (Source, error) {
 	case "file":
 		return NewFileSource(cfg.Name, cfg.Config), nil
 	case "http_server":
-		return NewHTTPSource(cfg.Name, cfg.Config), nil
+		return NewHTTPSource(cfg.Name, cfg.Config)
+	case "generate":
+		return NewGenerateSource(cfg.Name, cfg.Config)
 	default:
 		return nil, fmt.Errorf("unknown source type: %s", cfg.Type)
 	}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
 	"github.com/conduix/conduix/pipeline-core/pkg/schema"
 )
 
@@ -310,7 +311,7 @@ func (s *AggregateStage) Process(ctx context.Context, record *Record) (*Record,
 // Used for input validation (after Reader)
 type ValidationStage struct {
 	BaseStage
-	schema     *schema.DataSchema
+	recordValidator
 	dropOnFail bool // true면 검증 실패 시 레코드 드롭, false면 에러 반환
 }
 
@@ -326,6 +327,11 @@ func NewValidationStage(name string, config map[string]any) (*ValidationStage, e
 		s.dropOnFail = drop
 	}
 
+	// coerce 설정 (검증 전에 타입 변환과 기본값 적용)
+	if coerce, ok := config["coerce"].(bool); ok {
+		s.coerce = coerce
+	}
+
 	// 스키마 설정 파싱
 	if schemaConfig, ok := config["schema"].(map[string]any); ok {
 		sch, err := schema.NewDataSchemaFromConfig(schemaConfig)
@@ -337,6 +343,15 @@ func NewValidationStage(name string, config map[string]any) (*ValidationStage, e
 		return nil, fmt.Errorf("schema configuration is required for validation stage")
 	}
 
+	// dead_letter 설정 (검증 실패 레코드를 드롭 대신 별도 싱크로 전송)
+	if dl, ok := config["dead_letter"].(map[string]any); ok {
+		sink, err := newDeadLetterSink(dl)
+		if err != nil {
+			return nil, err
+		}
+		s.deadLetter = sink
+	}
+
 	return s, nil
 }
 
@@ -344,13 +359,12 @@ func NewValidationStage(name string, config map[string]any) (*ValidationStage, e
 func (s *ValidationStage) Process(ctx context.Context, record *Record) (*Record, error) {
 	s.incrementInput()
 
-	if s.schema == nil {
-		s.incrementOutput()
-		return record, nil
-	}
-
-	if err := s.schema.Validate(record.Data); err != nil {
+	coercions, err := s.validate(record)
+	if err != nil {
 		s.incrementError()
+		if s.deadLetter != nil {
+			return nil, s.sendDeadLetter(ctx, s.name, record, coercions, err)
+		}
 		if s.dropOnFail {
 			// 드롭 모드: 검증 실패 레코드 무시
 			return nil, nil
@@ -367,6 +381,176 @@ func (s *ValidationStage) SetSchema(sch *schema.DataSchema) {
 	s.schema = sch
 }
 
+// SetCoerce enables coercion and defaults before validation
+func (s *ValidationStage) SetCoerce(coerce bool) {
+	s.coerce = coerce
+}
+
+// SetDeadLetter routes records that fail validation to sink
+func (s *ValidationStage) SetDeadLetter(sink Sink) {
+	s.deadLetter = sink
+}
+
+// Close closes the dead letter sink, flushing buffered failures
+func (s *ValidationStage) Close() error {
+	if s.deadLetter != nil {
+		return s.deadLetter.Close()
+	}
+	return nil
+}
+
+// DedupStage removes records whose key was already seen within a time window.
+// With keep=first the first occurrence passes immediately and later ones are
+// dropped; with keep=last records are held until the window closes and the
+// last occurrence is released through Drain.
+type DedupStage struct {
+	BaseStage
+	dedup    *dedup.WindowDeduplicator
+	keepLast bool
+}
+
+// NewDedupStage creates a dedup stage from configuration.
+// Config keys: key (e.g. ".order.id" or ".tenant, .order.id"), window (e.g. "10m"),
+// keep (first|last), and for keep=first the backing storage: storage
+// (memory|lru|bloom|redis|sql), max_entries, redis_url, sql_driver, sql_dsn, sql_table_prefix.
+// namespace separates the keys of stages sharing a redis or sql store; it defaults
+// to the stage name, and pipelines set it to the pipeline ID plus the stage name.
+func NewDedupStage(name string, config map[string]any) (*DedupStage, error) {
+	opts := dedup.WindowOptions{}
+	opts.Key, _ = config["key"].(string)
+	opts.Keep, _ = config["keep"].(string)
+	if w, ok := config["window"].(string); ok {
+		d, err := time.ParseDuration(w)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dedup window: %w", err)
+		}
+		opts.Window = d
+	}
+
+	opts.Storage.Storage, _ = config["storage"].(string)
+	opts.Storage.RedisURL, _ = config["redis_url"].(string)
+	opts.Storage.SQLDriver, _ = config["sql_driver"].(string)
+	opts.Storage.SQLDSN, _ = config["sql_dsn"].(string)
+	opts.Storage.SQLTablePrefix, _ = config["sql_table_prefix"].(string)
+	opts.Storage.Namespace, _ = config["namespace"].(string)
+	if opts.Storage.Namespace == "" {
+		opts.Storage.Namespace = name
+	}
+	switch n := config["max_entries"].(type) {
+	case int:
+		opts.Storage.MaxEntries = n
+	case float64:
+		opts.Storage.MaxEntries = int(n)
+	}
+
+	d, err := dedup.NewWindow(opts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dedup stage config: %w", err)
+	}
+	return &DedupStage{
+		BaseStage: BaseStage{name: name, typ: "dedup", config: config},
+		dedup:     d,
+		keepLast:  opts.Keep == dedup.KeepLast,
+	}, nil
+}
+
+// NewDedupStageWithService creates a dedup stage backed by an existing service.
+// The service is not closed with the stage.
+func NewDedupStageWithService(name string, svc dedup.DedupService, keyExpr string, window time.Duration, keep string) (*DedupStage, error) {
+	keys, err := dedup.ParseKeyExpression(keyExpr)
+	if err != nil {
+		return nil, err
+	}
+	d, err := dedup.NewWindowDeduplicator(svc, keys, window, keep)
+	if err != nil {
+		return nil, err
+	}
+	return &DedupStage{
+		BaseStage: BaseStage{name: name, typ: "dedup"},
+		dedup:     d,
+		keepLast:  keep == dedup.KeepLast,
+	}, nil
+}
+
+// Process passes the record on if it is the one to keep, or drops it as a duplicate.
+// With keep=last it holds the record and returns a HeldError.
+func (s *DedupStage) Process(ctx context.Context, record *Record) (*Record, error) {
+	s.incrementInput()
+
+	if s.keepLast {
+		superseded, held := s.dedup.Hold(record.Data, record)
+		if !held {
+			s.incrementOutput()
+			return record, nil
+		}
+		return nil, &HeldError{Superseded: superseded != nil}
+	}
+
+	emit, err := s.dedup.Offer(ctx, record.Data, record)
+	if err != nil {
+		s.incrementError()
+		return nil, err
+	}
+	if !emit {
+		return nil, nil
+	}
+
+	s.incrementOutput()
+	return record, nil
+}
+
+// Commit marks the keys of records passed on since the last Commit or Abandon as
+// processed. It is a no-op for keep=last.
+func (s *DedupStage) Commit(ctx context.Context) error {
+	return s.dedup.Commit(ctx)
+}
+
+// Abandon releases the keys of records passed on since the last Commit or Abandon
+// without marking them, so a redelivered record passes again.
+func (s *DedupStage) Abandon() {
+	s.dedup.Abandon()
+}
+
+// Drain releases held records whose window has closed (all of them when force is set)
+func (s *DedupStage) Drain(force bool) []*Record {
+	released := s.dedup.Release(force)
+	records := make([]*Record, 0, len(released))
+	for _, r := range released {
+		records = append(records, r.(*Record))
+		s.incrementOutput()
+	}
+	return records
+}
+
+// Duplicates returns the number of duplicate records removed
+func (s *DedupStage) Duplicates() int64 {
+	return s.dedup.Duplicates()
+}
+
+// Pending returns the number of records currently held (keep=last)
+func (s *DedupStage) Pending() int {
+	return s.dedup.Pending()
+}
+
+// Close closes the storage created from configuration
+func (s *DedupStage) Close() error {
+	return s.dedup.Close()
+}
+
+// withDedupNamespace returns a copy of a dedup stage config with namespace set
+// to the given value unless the config already names one
+func withDedupNamespace(config map[string]any, namespace string) map[string]any {
+	if _, ok := config["namespace"]; ok {
+		return config
+	}
+	out := make(map[string]any, len(config)+1)
+	for k, v := range config {
+		out[k] = v
+	}
+	out["namespace"] = namespace
+	return out
+}
+
 // NewStage creates a stage from configuration
 func NewStage(cfg StageConfig) (Stage, error) {
 	switch cfg.Type {
@@ -384,6 +568,8 @@ func NewStage(cfg StageConfig) (Stage, error) {
 		return NewAggregateStage(cfg.Name, cfg.Config), nil
 	case "validate":
 		return NewValidationStage(cfg.Name, cfg.Config)
+	case "dedup":
+		return NewDedupStage(cfg.Name, cfg.Config)
 	default:
 		return nil, fmt.Errorf("unknown stage type: %s", cfg.Type)
 	}
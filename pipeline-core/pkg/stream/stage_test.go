@@ -0,0 +1,147 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/dedup"
+)
+
+// sliceSource emits fixed records then closes the channel
+type sliceSource struct {
+	records []*Record
+}
+
+func (s *sliceSource) Name() string { return "slice" }
+func (s *sliceSource) Type() string { return "slice" }
+func (s *sliceSource) Start(ctx context.Context, out chan<- *Record) error {
+	defer close(out)
+	for _, r := range s.records {
+		select {
+		case out <- r:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+func (s *sliceSource) Pause()       {}
+func (s *sliceSource) Resume()      {}
+func (s *sliceSource) Close() error { return nil }
+
+func TestDedupStageKeepFirst(t *testing.T) {
+	svc := dedup.NewMemoryDedupService(time.Hour)
+	defer svc.Close()
+	stage, err := NewDedupStageWithService("dedup", svc, ".user.id, .action", time.Minute, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	inputs := []map[string]any{
+		{"user": map[string]any{"id": 1}, "action": "login"},
+		{"user": map[string]any{"id": "1"}, "action": "login"},
+		{"user": map[string]any{"id": 1}, "action": "logout"},
+	}
+	var passed int
+	for _, data := range inputs {
+		out, err := stage.Process(ctx, &Record{Data: data})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != nil {
+			passed++
+		}
+	}
+	if passed != 2 || stage.Duplicates() != 1 {
+		t.Errorf("passed = %d, duplicates = %d", passed, stage.Duplicates())
+	}
+	if _, output, _ := stage.Stats(); output != 2 {
+		t.Errorf("stage output = %d", output)
+	}
+}
+
+func TestDedupStageKeepLastDrainsOnShutdown(t *testing.T) {
+	stage, err := NewStage(StageConfig{Type: "dedup", Name: "latest", Config: map[string]any{
+		"key":    ".id",
+		"window": "1h",
+		"keep":   "last",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrich := NewEnrichStage("enrich", map[string]any{"fields": map[string]any{"enriched": true}})
+
+	src := &sliceSource{records: []*Record{
+		{Data: map[string]any{"id": "a", "rev": 1}},
+		{Data: map[string]any{"id": "b", "rev": 1}},
+		{Data: map[string]any{"id": "a", "rev": 2}},
+	}}
+	sink := &captureSink{}
+	proc := NewStreamProcessor(ProcessorConfig{Name: "test"}, src, []Stage{stage, enrich}, sink)
+
+	if err := proc.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	proc.wg.Wait() // source finished; held records are drained before the loop exits
+
+	if len(sink.records) != 2 {
+		t.Fatalf("sink records = %d, want 2", len(sink.records))
+	}
+	if got := sink.records[0].Data; got["id"] != "a" || got["rev"] != 2 || got["enriched"] != true {
+		t.Errorf("first record = %v, want last occurrence of a through later stages", got)
+	}
+	if got := sink.records[1].Data; got["id"] != "b" {
+		t.Errorf("second record = %v", got)
+	}
+
+	// Held records are counted when released; only the superseded one is filtered
+	stats := proc.Stats()
+	if ss := stats.StageStats["latest"]; ss.DuplicateCount != 1 || ss.OutputCount != 2 || ss.FilteredCount != 1 || ss.HeldCount != 0 {
+		t.Errorf("dedup stage stats = %+v", ss)
+	}
+	if stats.OutputCount != 2 || stats.FilteredCount != 1 || stats.HeldCount != 0 {
+		t.Errorf("output = %d, filtered = %d, held = %d, want 2, 1, 0", stats.OutputCount, stats.FilteredCount, stats.HeldCount)
+	}
+	_ = stage.Close()
+}
+
+func TestDedupStageKeepLastHeld(t *testing.T) {
+	stage, err := NewDedupStageWithService("latest", nil, ".id", time.Hour, "last")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var superseded int
+	for _, id := range []string{"a", "b", "a"} {
+		out, err := stage.Process(ctx, &Record{Data: map[string]any{"id": id}})
+		var held *HeldError
+		if out != nil || !errors.As(err, &held) {
+			t.Fatalf("Process(%s) = %v, %v, want held", id, out, err)
+		}
+		if held.Superseded {
+			superseded++
+		}
+	}
+	if superseded != 1 || stage.Pending() != 2 || stage.Duplicates() != 1 {
+		t.Errorf("superseded = %d, pending = %d, duplicates = %d", superseded, stage.Pending(), stage.Duplicates())
+	}
+}
+
+func TestNewDedupStageInvalidConfig(t *testing.T) {
+	configs := []map[string]any{
+		{"window": "1m"},
+		{"key": ".id"},
+		{"key": ".id", "window": "soon"},
+		{"key": ".id", "window": "1m", "keep": "middle"},
+		{"key": ".id", "window": "1m", "storage": "disk"},
+	}
+	for _, cfg := range configs {
+		if _, err := NewDedupStage("dedup", cfg); err == nil {
+			t.Errorf("NewDedupStage(%v) expected error", cfg)
+		}
+	}
+}
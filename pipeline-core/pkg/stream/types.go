@@ -35,12 +35,42 @@ type Stage interface {
 	// Process processes a single record.
 	// Returns nil to filter out the record.
 	// Returns error only for unrecoverable errors.
+	// Drainer stages return a *HeldError for records they hold back.
 	Process(ctx context.Context, record *Record) (*Record, error)
 
 	// Close releases any resources
 	Close() error
 }
 
+// Drainer is implemented by stages that hold records back and release them
+// later, such as dedup keeping the last occurrence in a window.
+// The processor drains periodically and on shutdown (force), running released
+// records through the stages after the drained one.
+type Drainer interface {
+	Drain(force bool) []*Record
+}
+
+// HeldError is returned by a Drainer stage that held the record back instead
+// of passing it on. It is not a failure: the record comes out of Drain later.
+// Superseded is set when the record replaced an earlier held one, which is
+// dropped as a duplicate.
+type HeldError struct {
+	Superseded bool
+}
+
+func (e *HeldError) Error() string {
+	return "record held"
+}
+
+// Committer is implemented by stages that need to know whether the records
+// they passed on were delivered, such as dedup keeping the first occurrence.
+// The processor calls Commit once a record is written to the sink or filtered
+// by a later stage, and Abandon when a later stage or the sink fails.
+type Committer interface {
+	Commit(ctx context.Context) error
+	Abandon()
+}
+
 // Source is the interface for data sources.
 // Sources produce records into a channel for efficient batch processing.
 type Source interface {
@@ -91,6 +121,7 @@ type ProcessorStats struct {
 	OutputCount    int64
 	FilteredCount  int64
 	ErrorCount     int64
+	HeldCount      int64 // records currently held back by stages (not yet output or filtered)
 	ProcessingTime time.Duration
 	LastRecord     time.Time
 
@@ -106,6 +137,11 @@ type StageStats struct {
 	FilteredCount int64
 	ErrorCount    int64
 	AvgLatency    time.Duration
+
+	// DuplicateCount is the number of duplicates removed (dedup stages only)
+	DuplicateCount int64
+	// HeldCount is the number of records currently held back (dedup keep=last)
+	HeldCount int64
 }
 
 // SourceConfig is the common configuration for sources
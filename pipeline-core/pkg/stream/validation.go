@@ -0,0 +1,126 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/conduix/conduix/pipeline-core/pkg/schema"
+)
+
+// recordValidator holds the schema checks shared by ValidationStage and
+// ValidatingSink: optional coercion, per-field coercion counts and routing
+// of failed records to a dead letter sink
+type recordValidator struct {
+	schema     *schema.DataSchema
+	coerce     bool // coerce values and apply defaults before validating
+	deadLetter Sink // receives failed records instead of dropping or failing
+
+	coercionCounts map[string]int64
+	coercionMu     sync.Mutex
+}
+
+// validate checks the record against the schema, coercing it in place first
+// when coercion is enabled
+func (v *recordValidator) validate(record *Record) ([]schema.FieldCoercion, error) {
+	if v.schema == nil {
+		return nil, nil
+	}
+	if !v.coerce {
+		return nil, v.schema.Validate(record.Data)
+	}
+
+	coercions, err := v.schema.Coerce(record.Data)
+	if len(coercions) > 0 {
+		v.coercionMu.Lock()
+		if v.coercionCounts == nil {
+			v.coercionCounts = make(map[string]int64)
+		}
+		for _, c := range coercions {
+			v.coercionCounts[c.Field]++
+		}
+		v.coercionMu.Unlock()
+	}
+	return coercions, err
+}
+
+// sendDeadLetter writes the failed record to the dead letter sink
+func (v *recordValidator) sendDeadLetter(ctx context.Context, source string, record *Record, coercions []schema.FieldCoercion, verr error) error {
+	if err := v.deadLetter.Write(ctx, DeadLetterRecord(record, source, verr, coercions)); err != nil {
+		return fmt.Errorf("failed to write dead letter: %w", err)
+	}
+	return nil
+}
+
+// CoercionStats returns the number of coerced or defaulted values per field
+func (v *recordValidator) CoercionStats() map[string]int64 {
+	v.coercionMu.Lock()
+	defer v.coercionMu.Unlock()
+
+	stats := make(map[string]int64, len(v.coercionCounts))
+	for field, n := range v.coercionCounts {
+		stats[field] = n
+	}
+	return stats
+}
+
+// DeadLetterRecord wraps a record that failed validation in an envelope for a
+// dead letter sink: the record data, every field error, the coercions applied
+// before validation and where and when it failed
+func DeadLetterRecord(record *Record, source string, verr error, coercions []schema.FieldCoercion) *Record {
+	var fieldErrors []any
+	var validationErrors *schema.ValidationErrors
+	if errors.As(verr, &validationErrors) {
+		for _, fe := range validationErrors.Errors() {
+			fieldErrors = append(fieldErrors, map[string]any{"field": fe.Field, "message": fe.Message})
+		}
+	} else if verr != nil {
+		fieldErrors = append(fieldErrors, map[string]any{"field": "", "message": verr.Error()})
+	}
+
+	data := map[string]any{
+		"record":    record.Data,
+		"errors":    fieldErrors,
+		"source":    source,
+		"failed_at": time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if len(coercions) > 0 {
+		applied := make([]any, 0, len(coercions))
+		for _, c := range coercions {
+			entry := map[string]any{"field": c.Field, "action": string(c.Action), "to": c.To}
+			if c.From != nil {
+				entry["from"] = c.From
+			}
+			applied = append(applied, entry)
+		}
+		data["coercions"] = applied
+	}
+
+	return &Record{
+		Data:      data,
+		Metadata:  record.Metadata,
+		Timestamp: time.Now(),
+	}
+}
+
+// newDeadLetterSink creates the dead letter sink from a "dead_letter" config
+// map with type, name and config keys
+func newDeadLetterSink(cfg map[string]any) (Sink, error) {
+	sinkCfg := SinkConfig{Name: "dead_letter"}
+	sinkCfg.Type, _ = cfg["type"].(string)
+	if name, ok := cfg["name"].(string); ok && name != "" {
+		sinkCfg.Name = name
+	}
+	sinkCfg.Config, _ = cfg["config"].(map[string]any)
+	if sinkCfg.Type == "" {
+		return nil, fmt.Errorf("dead_letter type is required")
+	}
+
+	sink, err := NewSink(sinkCfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dead_letter sink: %w", err)
+	}
+	return sink, nil
+}
@@ -0,0 +1,98 @@
+package stream
+
+import (
+	"context"
+	"testing"
+)
+
+// captureSink collects written records
+type captureSink struct {
+	records []*Record
+	closed  bool
+}
+
+func (s *captureSink) Name() string { return "capture" }
+func (s *captureSink) Type() string { return "capture" }
+func (s *captureSink) Write(ctx context.Context, record *Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+func (s *captureSink) Flush(ctx context.Context) error { return nil }
+func (s *captureSink) Close() error                    { s.closed = true; return nil }
+
+func TestValidationStageCoerceAndDeadLetter(t *testing.T) {
+	stage, err := NewValidationStage("validate", map[string]any{
+		"coerce": true,
+		"schema": map[string]any{"fields": []any{
+			map[string]any{"name": "id", "type": "integer", "required": true},
+			map[string]any{"name": "status", "type": "string", "default": "new"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dlq := &captureSink{}
+	stage.SetDeadLetter(dlq)
+	ctx := context.Background()
+
+	out, err := stage.Process(ctx, &Record{Data: map[string]any{"id": "42"}})
+	if err != nil || out == nil {
+		t.Fatalf("Process = %v, %v", out, err)
+	}
+	if out.Data["id"] != int64(42) || out.Data["status"] != "new" {
+		t.Errorf("data = %v", out.Data)
+	}
+
+	out, err = stage.Process(ctx, &Record{Data: map[string]any{"id": "abc"}})
+	if err != nil || out != nil {
+		t.Fatalf("Process = %v, %v", out, err)
+	}
+	if len(dlq.records) != 1 {
+		t.Fatalf("dead letters = %d", len(dlq.records))
+	}
+	letter := dlq.records[0].Data
+	fieldErrors, _ := letter["errors"].([]any)
+	if letter["source"] != "validate" || len(fieldErrors) != 1 || fieldErrors[0].(map[string]any)["field"] != "id" {
+		t.Errorf("dead letter = %v", letter)
+	}
+	if coercions, _ := letter["coercions"].([]any); len(coercions) != 1 {
+		t.Errorf("dead letter coercions = %v", letter["coercions"])
+	}
+
+	stats := stage.CoercionStats()
+	if stats["id"] != 1 || stats["status"] != 2 {
+		t.Errorf("coercion stats = %v", stats)
+	}
+
+	if err := stage.Close(); err != nil || !dlq.closed {
+		t.Errorf("Close = %v, closed = %v", err, dlq.closed)
+	}
+}
+
+func TestValidatingSinkDeadLetter(t *testing.T) {
+	stage, err := NewValidationStage("v", map[string]any{"schema": map[string]any{"fields": []any{
+		map[string]any{"name": "id", "type": "integer", "required": true},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inner, dlq := &captureSink{}, &captureSink{}
+	sink := NewValidatingSink(inner, stage.schema, false)
+	sink.SetDeadLetter(dlq)
+
+	ctx := context.Background()
+	if err := sink.Write(ctx, &Record{Data: map[string]any{"id": float64(1)}}); err != nil {
+		t.Fatal(err)
+	}
+	// coerce가 꺼져 있으므로 "2"는 검증 실패
+	if err := sink.Write(ctx, &Record{Data: map[string]any{"id": "2"}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(inner.records) != 1 || len(dlq.records) != 1 {
+		t.Errorf("inner = %d, dead letters = %d", len(inner.records), len(dlq.records))
+	}
+	if valid, invalid := sink.ValidationStats(); valid != 1 || invalid != 1 {
+		t.Errorf("stats = %d, %d", valid, invalid)
+	}
+}
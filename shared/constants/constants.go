@@ -20,12 +20,14 @@ const (
 
 // Redis 키 접두사
 const (
-	RedisKeyPipelineState      = "pipeline:%s:state"
-	RedisKeyPipelineCheckpoint = "pipeline:%s:checkpoint"
-	RedisKeyPipelineMetrics    = "pipeline:%s:metrics"
-	RedisKeyAgentHeartbeat     = "agent:%s:heartbeat"
-	RedisKeyAgentPipelines     = "agent:%s:pipelines"
-	RedisKeySession            = "session:%s"
+	RedisKeyPipelineState       = "pipeline:%s:state"
+	RedisKeyPipelineCheckpoint  = "pipeline:%s:checkpoint"
+	RedisKeyPipelineCheckpoints = "pipeline:%s:checkpoints"
+	RedisKeyCheckpointRestore   = "pipeline:%s:checkpoint:restore"
+	RedisKeyPipelineMetrics     = "pipeline:%s:metrics"
+	RedisKeyAgentHeartbeat      = "agent:%s:heartbeat"
+	RedisKeyAgentPipelines      = "agent:%s:pipelines"
+	RedisKeySession             = "session:%s"
 )
 
 // Redis Pub/Sub 채널
@@ -0,0 +1,88 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/conduix/conduix/shared/constants"
+	"github.com/conduix/conduix/shared/types"
+)
+
+// MaxCheckpointHistory 파이프라인별 보관하는 체크포인트 이력 수
+const MaxCheckpointHistory = 100
+
+// CheckpointStore 파이프라인 체크포인트 저장소
+// 현재 체크포인트(문자열 키)와 이력(최신이 앞인 리스트)을 관리한다.
+// 체크포인트 쓰기는 control-plane과 에이전트 모두 Save를 거치므로 파이프라인이 만든 체크포인트와
+// 되감기 대상이 같은 이력에 남는다
+type CheckpointStore struct {
+	client *ResilientClient
+}
+
+// NewCheckpointStore 체크포인트 저장소 생성
+func NewCheckpointStore(client *ResilientClient) *CheckpointStore {
+	return &CheckpointStore{client: client}
+}
+
+// Save 현재 체크포인트를 교체하고 이력에 추가 (TTL 없음)
+func (s *CheckpointStore) Save(ctx context.Context, pipelineID string, checkpoint *types.Checkpoint) error {
+	if err := s.client.Set(ctx, fmt.Sprintf(constants.RedisKeyPipelineCheckpoint, pipelineID), checkpoint, 0); err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	key := fmt.Sprintf(constants.RedisKeyPipelineCheckpoints, pipelineID)
+	if err := s.client.PushCapped(ctx, key, checkpoint, MaxCheckpointHistory); err != nil {
+		return fmt.Errorf("failed to append checkpoint history: %w", err)
+	}
+	return nil
+}
+
+// Load 현재 체크포인트 조회
+func (s *CheckpointStore) Load(ctx context.Context, pipelineID string) (*types.Checkpoint, error) {
+	data, err := s.client.Get(ctx, fmt.Sprintf(constants.RedisKeyPipelineCheckpoint, pipelineID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint: %w", err)
+	}
+
+	var checkpoint types.Checkpoint
+	if err := json.Unmarshal([]byte(data), &checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+	return &checkpoint, nil
+}
+
+// History 체크포인트 이력 조회 (오래된 순)
+func (s *CheckpointStore) History(ctx context.Context, pipelineID string) ([]types.Checkpoint, error) {
+	items, err := s.client.LRange(ctx, fmt.Sprintf(constants.RedisKeyPipelineCheckpoints, pipelineID), 0, -1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint history: %w", err)
+	}
+
+	history := make([]types.Checkpoint, len(items))
+	for i, item := range items {
+		// 리스트는 최신이 앞이므로 뒤집어서 채움
+		if err := json.Unmarshal([]byte(item), &history[len(items)-1-i]); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal checkpoint history: %w", err)
+		}
+	}
+	return history, nil
+}
+
+// SaveRestore 체크포인트 복원 결과 저장
+func (s *CheckpointStore) SaveRestore(ctx context.Context, restore *types.CheckpointRestore) error {
+	return s.client.Set(ctx, fmt.Sprintf(constants.RedisKeyCheckpointRestore, restore.PipelineID), restore, 0)
+}
+
+// LoadRestore 마지막 체크포인트 복원 결과 조회
+func (s *CheckpointStore) LoadRestore(ctx context.Context, pipelineID string) (*types.CheckpointRestore, error) {
+	data, err := s.client.Get(ctx, fmt.Sprintf(constants.RedisKeyCheckpointRestore, pipelineID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkpoint restore: %w", err)
+	}
+
+	var restore types.CheckpointRestore
+	if err := json.Unmarshal([]byte(data), &restore); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal checkpoint restore: %w", err)
+	}
+	return &restore, nil
+}
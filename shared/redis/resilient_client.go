@@ -508,6 +508,62 @@ func (rc *ResilientClient) Del(ctx context.Context, keys ...string) error {
 	return nil
 }
 
+// PushCapped 리스트 앞에 값을 추가하고 최근 maxLen개만 유지 (LPUSH + LTRIM 트랜잭션)
+// 읽고 다시 쓰지 않으므로 여러 writer가 동시에 추가해도 항목이 유실되지 않는다
+func (rc *ResilientClient) PushCapped(ctx context.Context, key string, value interface{}, maxLen int64) error {
+	rc.metrics.mu.Lock()
+	rc.metrics.TotalRequests++
+	rc.metrics.mu.Unlock()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	if !rc.canExecute() || rc.GetConnectionState() != StateConnected {
+		return fmt.Errorf("redis not available")
+	}
+
+	start := time.Now()
+	_, err = rc.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, data)
+		pipe.LTrim(ctx, key, 0, maxLen-1)
+		return nil
+	})
+	rc.recordLatency(time.Since(start))
+
+	if err != nil {
+		rc.recordFailure(err)
+		return fmt.Errorf("redis push failed: %w", err)
+	}
+
+	rc.recordSuccess()
+	return nil
+}
+
+// LRange 리스트 범위 조회 (로컬 캐시 폴백 없음)
+func (rc *ResilientClient) LRange(ctx context.Context, key string, start, stop int64) ([]string, error) {
+	rc.metrics.mu.Lock()
+	rc.metrics.TotalRequests++
+	rc.metrics.mu.Unlock()
+
+	if !rc.canExecute() || rc.GetConnectionState() != StateConnected {
+		return nil, fmt.Errorf("redis not available")
+	}
+
+	begin := time.Now()
+	result, err := rc.client.LRange(ctx, key, start, stop).Result()
+	rc.recordLatency(time.Since(begin))
+
+	if err != nil {
+		rc.recordFailure(err)
+		return nil, fmt.Errorf("redis lrange failed: %w", err)
+	}
+
+	rc.recordSuccess()
+	return result, nil
+}
+
 // Publish 메시지 발행
 func (rc *ResilientClient) Publish(ctx context.Context, channel string, message interface{}) error {
 	rc.metrics.mu.Lock()
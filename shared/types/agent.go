@@ -66,6 +66,8 @@ const (
 	CommandStopWorkflow   CommandType = "stop_workflow"
 	CommandPauseWorkflow  CommandType = "pause_workflow"
 	CommandResumeWorkflow CommandType = "resume_workflow"
+	// 체크포인트 되감기 명령 (페이로드: CheckpointRewindCommand)
+	CommandRewindCheckpoint CommandType = "rewind_checkpoint"
 )
 
 // AgentCommandResponse 명령 응답
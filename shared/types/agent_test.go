@@ -38,6 +38,7 @@ func TestCommandType(t *testing.T) {
 		{"resume", CommandResumePipeline, "resume_pipeline"},
 		{"update_config", CommandUpdateConfig, "update_config"},
 		{"shutdown", CommandShutdown, "shutdown"},
+		{"rewind_checkpoint", CommandRewindCheckpoint, "rewind_checkpoint"},
 	}
 
 	for _, tt := range tests {
@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
 
 // CheckpointStorage 체크포인트 저장소 타입
 type CheckpointStorage string
@@ -56,3 +61,305 @@ type CheckpointRestore struct {
 	Success       bool      `json:"success"`
 	Error         string    `json:"error,omitempty"`
 }
+
+// CheckpointRewindMode 체크포인트 되감기 방식
+type CheckpointRewindMode string
+
+const (
+	// RewindToTimestamp 지정 시각 이전의 마지막 체크포인트로 되감기
+	RewindToTimestamp CheckpointRewindMode = "timestamp"
+	// RewindToOffset 명시한 Kafka/파일 오프셋으로 이동
+	RewindToOffset CheckpointRewindMode = "offset"
+	// RewindToEarliest 소스의 처음부터 다시 읽기
+	RewindToEarliest CheckpointRewindMode = "earliest"
+	// RewindToLatest 소스의 끝으로 이동 (기존 데이터 건너뜀)
+	RewindToLatest CheckpointRewindMode = "latest"
+)
+
+// SourceOffsets 소스별 오프셋 정보
+// Checkpoint.Offsets는 소스 이름을 키로 이 구조를 값으로 저장한다
+type SourceOffsets struct {
+	Source   string         `json:"source"`
+	Kafka    []KafkaOffset  `json:"kafka,omitempty"`
+	File     []FileOffset   `json:"file,omitempty"`
+	Position map[string]any `json:"position,omitempty"` // 기타 소스 위치 (binlog, last_id 등)
+	Reset    string         `json:"reset,omitempty"`    // earliest, latest (오프셋 대신 시작 위치 지정)
+}
+
+// CheckpointRewindRequest 체크포인트 되감기 요청
+type CheckpointRewindRequest struct {
+	Mode         CheckpointRewindMode `json:"mode" binding:"required,oneof=timestamp offset earliest latest"`
+	Timestamp    *time.Time           `json:"timestamp,omitempty"`     // timestamp 모드
+	Source       string               `json:"source,omitempty"`        // 대상 소스 (빈 경우 전체)
+	KafkaOffsets []KafkaOffset        `json:"kafka_offsets,omitempty"` // offset 모드
+	FileOffsets  []FileOffset         `json:"file_offsets,omitempty"`  // offset 모드
+}
+
+// CheckpointRewindCommand 에이전트로 전송되는 되감기 명령 페이로드
+type CheckpointRewindCommand struct {
+	PipelineID  string                  `json:"pipeline_id"`
+	Request     CheckpointRewindRequest `json:"request"`
+	Target      *Checkpoint             `json:"target"`
+	RequestedBy string                  `json:"requested_by,omitempty"`
+	RequestedAt time.Time               `json:"requested_at"`
+}
+
+// SourceOffsets 체크포인트의 소스별 오프셋 목록 반환 (소스 이름순)
+func (c *Checkpoint) SourceOffsets() []SourceOffsets {
+	names := make([]string, 0, len(c.Offsets))
+	for name := range c.Offsets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]SourceOffsets, 0, len(names))
+	for _, name := range names {
+		so := SourceOffsets{Source: name}
+		switch v := c.Offsets[name].(type) {
+		case SourceOffsets:
+			so = v
+		case *SourceOffsets:
+			so = *v
+		default:
+			// JSON 역직렬화된 map 또는 구형 스칼라 값
+			data, err := json.Marshal(v)
+			if err != nil || json.Unmarshal(data, &so) != nil {
+				so.Position = map[string]any{"value": v}
+			}
+		}
+		so.Source = name
+		result = append(result, so)
+	}
+	return result
+}
+
+// SetSourceOffsets 소스별 오프셋 저장
+func (c *Checkpoint) SetSourceOffsets(offsets []SourceOffsets) {
+	c.Offsets = make(map[string]any, len(offsets))
+	for _, so := range offsets {
+		c.Offsets[so.Source] = so
+	}
+}
+
+// SourceCheckpoint 재시작 시 소스 설정의 checkpoint로 넘길 값
+// Reset이 지정된 소스는 {"reset": earliest|latest}만, 그 외에는 Position에 되감기 오프셋
+// (kafka_offsets, file_offsets)을 더해 반환한다. 저장된 위치가 없으면 nil
+func (c *Checkpoint) SourceCheckpoint(source string) map[string]any {
+	for _, so := range c.SourceOffsets() {
+		if so.Source != source {
+			continue
+		}
+		if so.Reset != "" {
+			return map[string]any{"reset": so.Reset}
+		}
+		if len(so.Position) == 0 && len(so.Kafka) == 0 && len(so.File) == 0 {
+			return nil
+		}
+		checkpoint := make(map[string]any, len(so.Position)+2)
+		for k, v := range so.Position {
+			checkpoint[k] = v
+		}
+		if len(so.Kafka) > 0 {
+			checkpoint["kafka_offsets"] = so.Kafka
+		}
+		if len(so.File) > 0 {
+			checkpoint["file_offsets"] = so.File
+		}
+		return checkpoint
+	}
+	return nil
+}
+
+// SetSourceCheckpoint 소스가 보고한 체크포인트를 Position으로 저장
+// 소스가 새 위치를 보고했으므로 되감기로 지정된 Reset과 오프셋은 해제된다
+func (c *Checkpoint) SetSourceCheckpoint(source string, data map[string]any) {
+	offsets := c.SourceOffsets()
+	updated := SourceOffsets{Source: source, Position: data}
+	for i := range offsets {
+		if offsets[i].Source == source {
+			offsets[i] = updated
+			c.SetSourceOffsets(offsets)
+			return
+		}
+	}
+	c.SetSourceOffsets(append(offsets, updated))
+}
+
+// ResolveRewind 되감기 요청을 적용한 새 체크포인트 생성
+// current는 현재 체크포인트(없으면 nil), history는 과거 체크포인트 목록
+func ResolveRewind(pipelineID string, current *Checkpoint, history []Checkpoint, req *CheckpointRewindRequest) (*Checkpoint, error) {
+	base := &Checkpoint{PipelineID: pipelineID}
+	if current != nil {
+		base = current
+	}
+
+	var offsets []SourceOffsets
+	restoredFrom := time.Now()
+
+	switch req.Mode {
+	case RewindToTimestamp:
+		if req.Timestamp == nil {
+			return nil, fmt.Errorf("timestamp is required for timestamp rewind")
+		}
+		var found *Checkpoint
+		for i := range history {
+			cp := &history[i]
+			if cp.Timestamp.After(*req.Timestamp) {
+				continue
+			}
+			if found == nil || cp.Timestamp.After(found.Timestamp) {
+				found = cp
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("no checkpoint found at or before %s", req.Timestamp.Format(time.RFC3339))
+		}
+		restoredFrom = found.Timestamp
+		offsets = mergeSourceOffsets(base.SourceOffsets(), found.SourceOffsets(), req.Source)
+
+	case RewindToOffset:
+		if len(req.KafkaOffsets) == 0 && len(req.FileOffsets) == 0 {
+			return nil, fmt.Errorf("kafka_offsets or file_offsets is required for offset rewind")
+		}
+		offsets = base.SourceOffsets()
+		if req.Source == "" && len(offsets) != 1 {
+			return nil, fmt.Errorf("source is required when the checkpoint has %d sources", len(offsets))
+		}
+		name := req.Source
+		if name == "" {
+			name = offsets[0].Source
+		}
+		idx := -1
+		for i := range offsets {
+			if offsets[i].Source == name {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			offsets = append(offsets, SourceOffsets{Source: name})
+			idx = len(offsets) - 1
+		}
+		offsets[idx].Reset = ""
+		offsets[idx].Kafka = upsertKafkaOffsets(offsets[idx].Kafka, req.KafkaOffsets)
+		offsets[idx].File = upsertFileOffsets(offsets[idx].File, req.FileOffsets)
+
+	case RewindToEarliest, RewindToLatest:
+		offsets = base.SourceOffsets()
+		if req.Source != "" && !hasSource(offsets, req.Source) {
+			offsets = append(offsets, SourceOffsets{Source: req.Source})
+		}
+		for i := range offsets {
+			if req.Source != "" && offsets[i].Source != req.Source {
+				continue
+			}
+			offsets[i] = SourceOffsets{Source: offsets[i].Source, Reset: string(req.Mode)}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported rewind mode: %s", req.Mode)
+	}
+
+	target := &Checkpoint{
+		PipelineID:     pipelineID,
+		ActorPath:      base.ActorPath,
+		ProcessedCount: base.ProcessedCount,
+		State:          base.State,
+		Timestamp:      time.Now(),
+		Version:        base.Version + 1,
+		Metadata: map[string]string{
+			"rewind_mode":          string(req.Mode),
+			"rewind_restored_from": restoredFrom.Format(time.RFC3339Nano),
+		},
+	}
+	target.SetSourceOffsets(offsets)
+	return target, nil
+}
+
+// SkippedEvents 체크포인트 교체로 건너뛰게 되는 Kafka 이벤트 수
+// 뒤로 되감는 경우(재처리)는 0으로 계산
+func SkippedEvents(prev, next *Checkpoint) int64 {
+	if prev == nil || next == nil {
+		return 0
+	}
+
+	before := make(map[string]int64)
+	for _, so := range prev.SourceOffsets() {
+		for _, ko := range so.Kafka {
+			before[fmt.Sprintf("%s/%s-%d", so.Source, ko.Topic, ko.Partition)] = ko.Offset
+		}
+	}
+
+	var skipped int64
+	for _, so := range next.SourceOffsets() {
+		for _, ko := range so.Kafka {
+			if old, ok := before[fmt.Sprintf("%s/%s-%d", so.Source, ko.Topic, ko.Partition)]; ok && ko.Offset > old {
+				skipped += ko.Offset - old
+			}
+		}
+	}
+	return skipped
+}
+
+func mergeSourceOffsets(current, restored []SourceOffsets, source string) []SourceOffsets {
+	if source == "" {
+		return restored
+	}
+	result := make([]SourceOffsets, 0, len(current)+1)
+	for _, so := range current {
+		if so.Source != source {
+			result = append(result, so)
+		}
+	}
+	for _, so := range restored {
+		if so.Source == source {
+			result = append(result, so)
+		}
+	}
+	return result
+}
+
+func upsertKafkaOffsets(existing, updates []KafkaOffset) []KafkaOffset {
+	result := append([]KafkaOffset(nil), existing...)
+	for _, u := range updates {
+		replaced := false
+		for i := range result {
+			if result[i].Topic == u.Topic && result[i].Partition == u.Partition {
+				result[i] = u
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+func upsertFileOffsets(existing, updates []FileOffset) []FileOffset {
+	result := append([]FileOffset(nil), existing...)
+	for _, u := range updates {
+		replaced := false
+		for i := range result {
+			if result[i].Path == u.Path {
+				result[i] = u
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+func hasSource(offsets []SourceOffsets, source string) bool {
+	for _, so := range offsets {
+		if so.Source == source {
+			return true
+		}
+	}
+	return false
+}
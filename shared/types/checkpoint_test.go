@@ -0,0 +1,193 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func newTestCheckpoint(ts time.Time, offset int64) Checkpoint {
+	cp := Checkpoint{PipelineID: "p1", Timestamp: ts, Version: 1}
+	cp.SetSourceOffsets([]SourceOffsets{
+		{Source: "orders", Kafka: []KafkaOffset{{Topic: "orders", Partition: 0, Offset: offset}}},
+		{Source: "logs", File: []FileOffset{{Path: "/var/log/app.log", ByteOffset: offset * 10}}},
+	})
+	return cp
+}
+
+func TestCheckpointSourceOffsetsJSON(t *testing.T) {
+	cp := newTestCheckpoint(time.Now(), 42)
+
+	data, err := json.Marshal(cp)
+	if err != nil {
+		t.Fatalf("Failed to marshal Checkpoint: %v", err)
+	}
+
+	var decoded Checkpoint
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal Checkpoint: %v", err)
+	}
+
+	offsets := decoded.SourceOffsets()
+	if len(offsets) != 2 {
+		t.Fatalf("expected 2 sources, got %d", len(offsets))
+	}
+	// 이름순 정렬
+	if offsets[0].Source != "logs" || offsets[1].Source != "orders" {
+		t.Errorf("source order mismatch: %s, %s", offsets[0].Source, offsets[1].Source)
+	}
+	if offsets[1].Kafka[0].Offset != 42 {
+		t.Errorf("kafka offset mismatch: got %d", offsets[1].Kafka[0].Offset)
+	}
+	if offsets[0].File[0].ByteOffset != 420 {
+		t.Errorf("file offset mismatch: got %d", offsets[0].File[0].ByteOffset)
+	}
+}
+
+func TestCheckpointSourceOffsetsLegacyValue(t *testing.T) {
+	cp := Checkpoint{Offsets: map[string]any{"sql": float64(7)}}
+
+	offsets := cp.SourceOffsets()
+	if len(offsets) != 1 || offsets[0].Position["value"] != float64(7) {
+		t.Errorf("legacy offset mismatch: %+v", offsets)
+	}
+}
+
+func TestResolveRewind(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []Checkpoint{
+		newTestCheckpoint(base, 10),
+		newTestCheckpoint(base.Add(time.Hour), 20),
+		newTestCheckpoint(base.Add(2*time.Hour), 30),
+	}
+	current := newTestCheckpoint(base.Add(3*time.Hour), 40)
+
+	t.Run("timestamp", func(t *testing.T) {
+		ts := base.Add(90 * time.Minute)
+		target, err := ResolveRewind("p1", &current, history, &CheckpointRewindRequest{Mode: RewindToTimestamp, Timestamp: &ts})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := target.SourceOffsets()[1].Kafka[0].Offset; got != 20 {
+			t.Errorf("expected offset 20, got %d", got)
+		}
+		if target.Version != current.Version+1 {
+			t.Errorf("version mismatch: got %d", target.Version)
+		}
+	})
+
+	t.Run("timestamp single source", func(t *testing.T) {
+		ts := base
+		target, err := ResolveRewind("p1", &current, history, &CheckpointRewindRequest{Mode: RewindToTimestamp, Timestamp: &ts, Source: "orders"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		offsets := target.SourceOffsets()
+		if offsets[0].File[0].ByteOffset != 400 {
+			t.Errorf("untouched source changed: %d", offsets[0].File[0].ByteOffset)
+		}
+		if offsets[1].Kafka[0].Offset != 10 {
+			t.Errorf("expected offset 10, got %d", offsets[1].Kafka[0].Offset)
+		}
+	})
+
+	t.Run("timestamp before history", func(t *testing.T) {
+		ts := base.Add(-time.Hour)
+		if _, err := ResolveRewind("p1", &current, history, &CheckpointRewindRequest{Mode: RewindToTimestamp, Timestamp: &ts}); err == nil {
+			t.Error("expected error for timestamp before history")
+		}
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		req := &CheckpointRewindRequest{
+			Mode:   RewindToOffset,
+			Source: "orders",
+			KafkaOffsets: []KafkaOffset{
+				{Topic: "orders", Partition: 0, Offset: 5},
+				{Topic: "orders", Partition: 1, Offset: 8},
+			},
+		}
+		target, err := ResolveRewind("p1", &current, history, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		kafka := target.SourceOffsets()[1].Kafka
+		if len(kafka) != 2 || kafka[0].Offset != 5 || kafka[1].Offset != 8 {
+			t.Errorf("kafka offsets mismatch: %+v", kafka)
+		}
+	})
+
+	t.Run("offset requires source", func(t *testing.T) {
+		req := &CheckpointRewindRequest{Mode: RewindToOffset, KafkaOffsets: []KafkaOffset{{Topic: "orders"}}}
+		if _, err := ResolveRewind("p1", &current, history, req); err == nil {
+			t.Error("expected error when source is ambiguous")
+		}
+	})
+
+	t.Run("earliest", func(t *testing.T) {
+		target, err := ResolveRewind("p1", &current, nil, &CheckpointRewindRequest{Mode: RewindToEarliest})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, so := range target.SourceOffsets() {
+			if so.Reset != "earliest" || len(so.Kafka) != 0 || len(so.File) != 0 {
+				t.Errorf("source %s not reset: %+v", so.Source, so)
+			}
+		}
+	})
+
+	t.Run("latest without current", func(t *testing.T) {
+		target, err := ResolveRewind("p1", nil, nil, &CheckpointRewindRequest{Mode: RewindToLatest, Source: "orders"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		offsets := target.SourceOffsets()
+		if len(offsets) != 1 || offsets[0].Reset != "latest" {
+			t.Errorf("latest reset mismatch: %+v", offsets)
+		}
+	})
+}
+
+func TestSkippedEvents(t *testing.T) {
+	prev := newTestCheckpoint(time.Now(), 100)
+	forward := newTestCheckpoint(time.Now(), 150)
+	backward := newTestCheckpoint(time.Now(), 50)
+
+	if got := SkippedEvents(&prev, &forward); got != 50 {
+		t.Errorf("expected 50 skipped, got %d", got)
+	}
+	if got := SkippedEvents(&prev, &backward); got != 0 {
+		t.Errorf("expected 0 skipped, got %d", got)
+	}
+}
+
+func TestCheckpointSourceCheckpoint(t *testing.T) {
+	cp := newTestCheckpoint(time.Now(), 42)
+
+	orders := cp.SourceCheckpoint("orders")
+	if offsets, ok := orders["kafka_offsets"].([]KafkaOffset); !ok || offsets[0].Offset != 42 {
+		t.Errorf("orders checkpoint = %v", orders)
+	}
+	if cp.SourceCheckpoint("missing") != nil {
+		t.Error("expected nil for unknown source")
+	}
+
+	// 되감기 Reset은 소스 위치보다 우선
+	reset, _ := ResolveRewind("p1", &cp, nil, &CheckpointRewindRequest{Mode: RewindToEarliest, Source: "orders"})
+	if got := reset.SourceCheckpoint("orders"); len(got) != 1 || got["reset"] != "earliest" {
+		t.Errorf("reset checkpoint = %v", got)
+	}
+
+	// 소스가 새 위치를 보고하면 Reset 해제
+	reset.SetSourceCheckpoint("orders", map[string]any{"offset": float64(7)})
+	reset.SetSourceCheckpoint("events", map[string]any{"lsn": "0/16B3748"})
+	if got := reset.SourceCheckpoint("orders"); got["reset"] != nil || got["offset"] != float64(7) {
+		t.Errorf("orders after save = %v", got)
+	}
+	if got := reset.SourceCheckpoint("events"); got["lsn"] != "0/16B3748" {
+		t.Errorf("events after save = %v", got)
+	}
+	if got := reset.SourceCheckpoint("logs"); got["file_offsets"] == nil {
+		t.Errorf("other sources should be kept: %v", got)
+	}
+}
@@ -95,7 +95,8 @@ type DataType struct {
 	IDFields []string `json:"id_fields,omitempty"` // 복합키 지원 (예: ["user_id"], ["order_id", "item_id"])
 
 	// 스키마 정보 (선택적)
-	Schema *DataTypeSchema `json:"schema,omitempty"`
+	Schema        *DataTypeSchema `json:"schema,omitempty"`
+	SchemaVersion int             `json:"schema_version,omitempty"` // 스키마가 바뀔 때마다 증가
 
 	// 저장소 설정
 	Storage *DataTypeStorage `json:"storage,omitempty"`
@@ -121,6 +122,9 @@ type DataTypeSchema struct {
 
 	// 필드 목록 (간단한 정의용)
 	Fields []DataTypeField `json:"fields,omitempty" yaml:"fields,omitempty"`
+
+	// 스키마 변경 시 호환성 규칙 (none, backward, forward, full, 기본값: backward)
+	Compatibility string `json:"compatibility,omitempty" yaml:"compatibility,omitempty"`
 }
 
 // DataTypeField 데이터 유형 필드
@@ -129,6 +133,7 @@ type DataTypeField struct {
 	Type        string `json:"type" yaml:"type"` // string, int, float, bool, datetime, json
 	Required    bool   `json:"required,omitempty" yaml:"required,omitempty"`
 	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Default     any    `json:"default,omitempty" yaml:"default,omitempty"` // 누락/null일 때 채울 값
 }
 
 // DataTypeStorage 데이터 유형 저장소 설정
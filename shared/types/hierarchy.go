@@ -85,6 +85,9 @@ type WorkflowPipeline struct {
 	TargetDataTypeID  *string            `json:"target_data_type_id,omitempty"` // 확장용 DataType ID (부모 출력 조회)
 	ExpansionMode     ExpansionMode      `json:"expansion_mode,omitempty"`      // 자식 파이프라인 확장 모드
 	ParameterBindings []ParameterBinding `json:"parameter_bindings,omitempty"`  // 부모→자식 파라미터 매핑
+
+	// 실행 시점의 대상 DataType 스키마 버전 (control-plane이 채움)
+	SchemaVersion int `json:"schema_version,omitempty"`
 }
 
 // WorkflowSource 워크플로우 내 소스 설정
@@ -108,7 +111,7 @@ type PartitionConfig struct {
 // (filter, remap, aggregate, elasticsearch, kafka, trigger 등)
 type Stage struct {
 	Name   string         `json:"name"`
-	Type   string         `json:"type"` // filter, remap, sample, aggregate, elasticsearch, kafka, trigger, etc.
+	Type   string         `json:"type"` // filter, remap, sample, aggregate, dedup, elasticsearch, kafka, trigger, etc.
 	Config map[string]any `json:"config"`
 }
 
@@ -179,20 +182,22 @@ type WorkflowExecution struct {
 
 // PipelineExecutionResult 개별 파이프라인 실행 결과
 type PipelineExecutionResult struct {
-	PipelineID       string              `json:"pipeline_id"`
-	PipelineName     string              `json:"pipeline_name"`
-	Status           string              `json:"status"`
-	StartedAt        time.Time           `json:"started_at"`
-	CompletedAt      time.Time           `json:"completed_at,omitempty"`
-	RecordsRead      int64               `json:"records_read"`      // 수집량 (backward compat)
-	RecordsWritten   int64               `json:"records_written"`   // 처리량 (backward compat)
-	RecordsProcessed int64               `json:"records_processed"` // 처리량
-	RecordsFailed    int64               `json:"records_failed"`    // 실패량
-	ErrorCount       int64               `json:"error_count"`       // 총 에러 (backward compat)
-	ErrorMessage     string              `json:"error_message,omitempty"`
-	Offset           int64               `json:"offset,omitempty"`      // 실시간용 오프셋
-	Checkpoint       map[string]any      `json:"checkpoint,omitempty"` // 체크포인트 (오프셋 포함 가능)
-	Statistics       *PipelineStatistics `json:"statistics,omitempty"` // 상세 통계
+	PipelineID        string              `json:"pipeline_id"`
+	PipelineName      string              `json:"pipeline_name"`
+	Status            string              `json:"status"`
+	StartedAt         time.Time           `json:"started_at"`
+	CompletedAt       time.Time           `json:"completed_at,omitempty"`
+	RecordsRead       int64               `json:"records_read"`                 // 수집량 (backward compat)
+	RecordsWritten    int64               `json:"records_written"`              // 처리량 (backward compat)
+	RecordsProcessed  int64               `json:"records_processed"`            // 처리량
+	RecordsFailed     int64               `json:"records_failed"`               // 실패량
+	RecordsDuplicated int64               `json:"records_duplicated,omitempty"` // dedup Stage가 제거한 중복 수
+	ErrorCount        int64               `json:"error_count"`                  // 총 에러 (backward compat)
+	ErrorMessage      string              `json:"error_message,omitempty"`
+	Offset            int64               `json:"offset,omitempty"`         // 실시간용 오프셋
+	Checkpoint        map[string]any      `json:"checkpoint,omitempty"`     // 체크포인트 (오프셋 포함 가능)
+	Statistics        *PipelineStatistics `json:"statistics,omitempty"`     // 상세 통계
+	SchemaVersion     int                 `json:"schema_version,omitempty"` // 데이터를 만든 DataType 스키마 버전
 }
 
 // Permission 권한 설정